@@ -3,62 +3,256 @@
 
 package examplepb // import "github.com/infobloxopen/protoc-gen-atlas-validate/example/examplepb"
 
+import bytes "bytes"
 import context "context"
 import fmt "fmt"
 import json "encoding/json"
+import regexp "regexp"
+import time "time"
+import url "net/url"
 import runtime1 "github.com/infobloxopen/protoc-gen-atlas-validate/runtime"
+import cel "github.com/infobloxopen/protoc-gen-atlas-validate/runtime/cel"
 import external "github.com/infobloxopen/protoc-gen-atlas-validate/example/external"
 import proto "github.com/gogo/protobuf/proto"
 import math "math"
 import _ "github.com/golang/protobuf/ptypes/any"
+import _ "github.com/golang/protobuf/ptypes/duration"
 import _ "github.com/golang/protobuf/ptypes/empty"
+import _ "github.com/golang/protobuf/ptypes/struct"
 import _ "github.com/golang/protobuf/ptypes/timestamp"
 import _ "github.com/golang/protobuf/ptypes/wrappers"
 import _ "github.com/infobloxopen/protoc-gen-atlas-validate/example/external"
 import _ "github.com/infobloxopen/protoc-gen-atlas-validate/options"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
+import _ "google.golang.org/genproto/protobuf/field_mask"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+var validate_Enum_Policy_Tier = &runtime1.Enum{
+	Name:     "Tier",
+	Names:    map[string]int32{"TIER_FREE": 0, "TIER_PRO": 1},
+	Prefix:   "TIER_",
+	Variants: false,
+}
+
+var validate_Enum_Role = &runtime1.Enum{
+	Name:     "Role",
+	Names:    map[string]int32{"ROLE_UNSPECIFIED": 0, "ROLE_ADMIN": 1, "ROLE_MEMBER": 2},
+	Prefix:   "ROLE_",
+	Variants: false,
+}
+
+var validate_Enum_Status = &runtime1.Enum{
+	Name:     "Status",
+	Names:    map[string]int32{"STATUS_UNKNOWN": 0, "STATUS_ACTIVE": 1, "STATUS_INACTIVE": 2},
+	Prefix:   "STATUS_",
+	Variants: true,
+}
+
 // validate_Users_Create_0 is an entrypoint for validating "POST" HTTP request
 // that match *.pb.gw.go/pattern_Users_Create_0.
 func validate_Users_Create_0(ctx context.Context, r json.RawMessage) (err error) {
 	return validate_Object_User(ctx, r, "")
 }
 
+// validate_response_Users_Create_0 is an entrypoint for validating a response body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Users_Create_0.
+func validate_response_Users_Create_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// default_Users_Create_0 injects default values into a body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Users_Create_0.
+func default_Users_Create_0(ctx context.Context, r json.RawMessage) (json.RawMessage, error) {
+	return default_Object_User(ctx, r, "")
+}
+
+// validate_form_Users_Create_0 is an entrypoint for validating a form-urlencoded body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Users_Create_0.
+func validate_form_Users_Create_0(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_User); err != nil {
+		return err
+	}
+	return validate_required_Object_User(ctx, runtime1.FormObject(form), "")
+}
+
 // validate_Users_Update_0 is an entrypoint for validating "PUT" HTTP request
 // that match *.pb.gw.go/pattern_Users_Update_0.
 func validate_Users_Update_0(ctx context.Context, r json.RawMessage) (err error) {
 	return validate_Object_User(ctx, r, "")
 }
 
+// validate_response_Users_Update_0 is an entrypoint for validating a response body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Users_Update_0.
+func validate_response_Users_Update_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// default_Users_Update_0 injects default values into a body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Users_Update_0.
+func default_Users_Update_0(ctx context.Context, r json.RawMessage) (json.RawMessage, error) {
+	return default_Object_User(ctx, r, "")
+}
+
+// validate_form_Users_Update_0 is an entrypoint for validating a form-urlencoded body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Users_Update_0.
+func validate_form_Users_Update_0(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_User); err != nil {
+		return err
+	}
+	return validate_required_Object_User(ctx, runtime1.FormObject(form), "")
+}
+
 // validate_Users_Update_1 is an entrypoint for validating "PATCH" HTTP request
 // that match *.pb.gw.go/pattern_Users_Update_1.
 func validate_Users_Update_1(ctx context.Context, r json.RawMessage) (err error) {
 	return validate_Object_User(ctx, r, "")
 }
 
+// validate_response_Users_Update_1 is an entrypoint for validating a response body of "PATCH" HTTP request
+// that match *.pb.gw.go/pattern_Users_Update_1.
+func validate_response_Users_Update_1(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// default_Users_Update_1 injects default values into a body of "PATCH" HTTP request
+// that match *.pb.gw.go/pattern_Users_Update_1.
+func default_Users_Update_1(ctx context.Context, r json.RawMessage) (json.RawMessage, error) {
+	return default_Object_User(ctx, r, "")
+}
+
+// validate_form_Users_Update_1 is an entrypoint for validating a form-urlencoded body of "PATCH" HTTP request
+// that match *.pb.gw.go/pattern_Users_Update_1.
+func validate_form_Users_Update_1(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_User); err != nil {
+		return err
+	}
+	return validate_required_Object_User(ctx, runtime1.FormObject(form), "")
+}
+
+// validate_Users_Get_0 is an entrypoint for validating "GET" HTTP request
+// that match *.pb.gw.go/pattern_Users_Get_0.
+func validate_Users_Get_0(ctx context.Context, r json.RawMessage) (err error) {
+	if len(r) != 0 && !runtime1.IsEmptyObject(r) {
+		return fmt.Errorf("body is not allowed")
+	}
+	return nil
+}
+
+// validate_response_Users_Get_0 is an entrypoint for validating a response body of "GET" HTTP request
+// that match *.pb.gw.go/pattern_Users_Get_0.
+func validate_response_Users_Get_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_User(ctx, r, "")
+}
+
+// validate_Users_Replace_0 is an entrypoint for validating "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Users_Replace_0.
+func validate_Users_Replace_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_UpdateUserRequest(ctx, r, "")
+}
+
+// validate_response_Users_Replace_0 is an entrypoint for validating a response body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Users_Replace_0.
+func validate_response_Users_Replace_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// default_Users_Replace_0 injects default values into a body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Users_Replace_0.
+func default_Users_Replace_0(ctx context.Context, r json.RawMessage) (json.RawMessage, error) {
+	return default_Object_UpdateUserRequest(ctx, r, "")
+}
+
+// validate_form_Users_Replace_0 is an entrypoint for validating a form-urlencoded body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Users_Replace_0.
+func validate_form_Users_Replace_0(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_UpdateUserRequest); err != nil {
+		return err
+	}
+	return validate_required_Object_UpdateUserRequest(ctx, runtime1.FormObject(form), "")
+}
+
+// validate_Users_Replace_1 is an entrypoint for validating "PATCH" HTTP request
+// that match *.pb.gw.go/pattern_Users_Replace_1.
+func validate_Users_Replace_1(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_User(ctx, r, "")
+}
+
+// validate_response_Users_Replace_1 is an entrypoint for validating a response body of "PATCH" HTTP request
+// that match *.pb.gw.go/pattern_Users_Replace_1.
+func validate_response_Users_Replace_1(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// default_Users_Replace_1 injects default values into a body of "PATCH" HTTP request
+// that match *.pb.gw.go/pattern_Users_Replace_1.
+func default_Users_Replace_1(ctx context.Context, r json.RawMessage) (json.RawMessage, error) {
+	return default_Object_User(ctx, r, "")
+}
+
+// validate_form_Users_Replace_1 is an entrypoint for validating a form-urlencoded body of "PATCH" HTTP request
+// that match *.pb.gw.go/pattern_Users_Replace_1.
+func validate_form_Users_Replace_1(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_User); err != nil {
+		return err
+	}
+	return validate_required_Object_User(ctx, runtime1.FormObject(form), "")
+}
+
 // validate_Users_List_0 is an entrypoint for validating "GET" HTTP request
 // that match *.pb.gw.go/pattern_Users_List_0.
 func validate_Users_List_0(ctx context.Context, r json.RawMessage) (err error) {
-	if len(r) != 0 {
+	if len(r) != 0 && !runtime1.IsEmptyObject(r) {
 		return fmt.Errorf("body is not allowed")
 	}
 	return nil
 }
 
+// validate_response_Users_List_0 is an entrypoint for validating a response body of "GET" HTTP request
+// that match *.pb.gw.go/pattern_Users_List_0.
+func validate_response_Users_List_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
 // validate_Users_List_1 is an entrypoint for validating "GET" HTTP request
 // that match *.pb.gw.go/pattern_Users_List_1.
 func validate_Users_List_1(ctx context.Context, r json.RawMessage) (err error) {
-	if len(r) != 0 {
+	if len(r) != 0 && !runtime1.IsEmptyObject(r) {
+		return fmt.Errorf("body is not allowed")
+	}
+	return nil
+}
+
+// validate_response_Users_List_1 is an entrypoint for validating a response body of "GET" HTTP request
+// that match *.pb.gw.go/pattern_Users_List_1.
+func validate_response_Users_List_1(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// validate_Users_Search_0 is an entrypoint for validating "GET" HTTP request
+// that match *.pb.gw.go/pattern_Users_Search_0.
+func validate_Users_Search_0(ctx context.Context, r json.RawMessage) (err error) {
+	if len(r) != 0 && !runtime1.IsEmptyObject(r) {
 		return fmt.Errorf("body is not allowed")
 	}
 	return nil
 }
 
+// validate_response_Users_Search_0 is an entrypoint for validating a response body of "GET" HTTP request
+// that match *.pb.gw.go/pattern_Users_Search_0.
+func validate_response_Users_Search_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// validate_query_Users_Search_0 is an entrypoint for validating query parameters of "GET" HTTP request
+// that match *.pb.gw.go/pattern_Users_Search_0.
+func validate_query_Users_Search_0(ctx context.Context, q url.Values) error {
+	return runtime1.ValidateQuery(ctx, q, validate_Query_Object_ListUsersRequest)
+}
+
 // validate_Users_UpdateExternalUser_0 is an entrypoint for validating "PUT" HTTP request
 // that match *.pb.gw.go/pattern_Users_UpdateExternalUser_0.
 func validate_Users_UpdateExternalUser_0(ctx context.Context, r json.RawMessage) (err error) {
@@ -70,6 +264,12 @@ func validate_Users_UpdateExternalUser_0(ctx context.Context, r json.RawMessage)
 	return nil
 }
 
+// validate_response_Users_UpdateExternalUser_0 is an entrypoint for validating a response body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Users_UpdateExternalUser_0.
+func validate_response_Users_UpdateExternalUser_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
 // validate_Users_UpdateExternalUser2_0 is an entrypoint for validating "PUT" HTTP request
 // that match *.pb.gw.go/pattern_Users_UpdateExternalUser2_0.
 func validate_Users_UpdateExternalUser2_0(ctx context.Context, r json.RawMessage) (err error) {
@@ -81,66 +281,372 @@ func validate_Users_UpdateExternalUser2_0(ctx context.Context, r json.RawMessage
 	return nil
 }
 
+// validate_response_Users_UpdateExternalUser2_0 is an entrypoint for validating a response body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Users_UpdateExternalUser2_0.
+func validate_response_Users_UpdateExternalUser2_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
 // validate_Profiles_Create_0 is an entrypoint for validating "POST" HTTP request
 // that match *.pb.gw.go/pattern_Profiles_Create_0.
 func validate_Profiles_Create_0(ctx context.Context, r json.RawMessage) (err error) {
 	return validate_Object_Profile(ctx, r, "")
 }
 
+// validate_response_Profiles_Create_0 is an entrypoint for validating a response body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Profiles_Create_0.
+func validate_response_Profiles_Create_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// default_Profiles_Create_0 injects default values into a body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Profiles_Create_0.
+func default_Profiles_Create_0(ctx context.Context, r json.RawMessage) (json.RawMessage, error) {
+	return default_Object_Profile(ctx, r, "")
+}
+
+// validate_form_Profiles_Create_0 is an entrypoint for validating a form-urlencoded body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Profiles_Create_0.
+func validate_form_Profiles_Create_0(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_Profile); err != nil {
+		return err
+	}
+	return validate_required_Object_Profile(ctx, runtime1.FormObject(form), "")
+}
+
 // validate_Profiles_Update_0 is an entrypoint for validating "PUT" HTTP request
 // that match *.pb.gw.go/pattern_Profiles_Update_0.
 func validate_Profiles_Update_0(ctx context.Context, r json.RawMessage) (err error) {
 	return validate_Object_Profile(ctx, r, "")
 }
 
+// validate_response_Profiles_Update_0 is an entrypoint for validating a response body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Profiles_Update_0.
+func validate_response_Profiles_Update_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// default_Profiles_Update_0 injects default values into a body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Profiles_Update_0.
+func default_Profiles_Update_0(ctx context.Context, r json.RawMessage) (json.RawMessage, error) {
+	return default_Object_Profile(ctx, r, "")
+}
+
+// validate_form_Profiles_Update_0 is an entrypoint for validating a form-urlencoded body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Profiles_Update_0.
+func validate_form_Profiles_Update_0(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_Profile); err != nil {
+		return err
+	}
+	return validate_required_Object_Profile(ctx, runtime1.FormObject(form), "")
+}
+
 // validate_Groups_Create_0 is an entrypoint for validating "POST" HTTP request
 // that match *.pb.gw.go/pattern_Groups_Create_0.
 func validate_Groups_Create_0(ctx context.Context, r json.RawMessage) (err error) {
 	return validate_Object_Group(ctx, r, "")
 }
 
+// validate_response_Groups_Create_0 is an entrypoint for validating a response body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Groups_Create_0.
+func validate_response_Groups_Create_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// validate_form_Groups_Create_0 is an entrypoint for validating a form-urlencoded body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Groups_Create_0.
+func validate_form_Groups_Create_0(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_Group); err != nil {
+		return err
+	}
+	return validate_required_Object_Group(ctx, runtime1.FormObject(form), "")
+}
+
 // validate_Groups_Update_0 is an entrypoint for validating "PUT" HTTP request
 // that match *.pb.gw.go/pattern_Groups_Update_0.
 func validate_Groups_Update_0(ctx context.Context, r json.RawMessage) (err error) {
+	ctx = runtime1.WithMaxTotalElements(ctx, 64)
 	return validate_Object_Group(ctx, r, "")
 }
 
+// validate_response_Groups_Update_0 is an entrypoint for validating a response body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Groups_Update_0.
+func validate_response_Groups_Update_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// validate_form_Groups_Update_0 is an entrypoint for validating a form-urlencoded body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Groups_Update_0.
+func validate_form_Groups_Update_0(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_Group); err != nil {
+		return err
+	}
+	return validate_required_Object_Group(ctx, runtime1.FormObject(form), "")
+}
+
+// validate_Groups_Search_0 is an entrypoint for validating "GET" HTTP request
+// that match *.pb.gw.go/pattern_Groups_Search_0.
+func validate_Groups_Search_0(ctx context.Context, r json.RawMessage) (err error) {
+	if len(r) != 0 && !runtime1.IsEmptyObject(r) {
+		return fmt.Errorf("body is not allowed")
+	}
+	return nil
+}
+
+// validate_response_Groups_Search_0 is an entrypoint for validating a response body of "GET" HTTP request
+// that match *.pb.gw.go/pattern_Groups_Search_0.
+func validate_response_Groups_Search_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// validate_query_Groups_Search_0 is an entrypoint for validating query parameters of "GET" HTTP request
+// that match *.pb.gw.go/pattern_Groups_Search_0.
+func validate_query_Groups_Search_0(ctx context.Context, q url.Values) error {
+	return runtime1.ValidateQuery(ctx, q, validate_Query_Object_ListUsersRequest)
+}
+
 // validate_Groups_ValidatedList_0 is an entrypoint for validating "GET" HTTP request
 // that match *.pb.gw.go/pattern_Groups_ValidatedList_0.
 func validate_Groups_ValidatedList_0(ctx context.Context, r json.RawMessage) (err error) {
-	if len(r) != 0 {
+	if len(r) != 0 && !runtime1.IsEmptyObject(r) {
 		return fmt.Errorf("body is not allowed")
 	}
 	return nil
 }
 
+// validate_response_Groups_ValidatedList_0 is an entrypoint for validating a response body of "GET" HTTP request
+// that match *.pb.gw.go/pattern_Groups_ValidatedList_0.
+func validate_response_Groups_ValidatedList_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
 // validate_Groups_ValidatedList_1 is an entrypoint for validating "GET" HTTP request
 // that match *.pb.gw.go/pattern_Groups_ValidatedList_1.
 func validate_Groups_ValidatedList_1(ctx context.Context, r json.RawMessage) (err error) {
-	if len(r) != 0 {
+	if len(r) != 0 && !runtime1.IsEmptyObject(r) {
 		return fmt.Errorf("body is not allowed")
 	}
 	return nil
 }
 
+// validate_response_Groups_ValidatedList_1 is an entrypoint for validating a response body of "GET" HTTP request
+// that match *.pb.gw.go/pattern_Groups_ValidatedList_1.
+func validate_response_Groups_ValidatedList_1(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
 // validate_Groups_ValidateWKT_0 is an entrypoint for validating "PUT" HTTP request
 // that match *.pb.gw.go/pattern_Groups_ValidateWKT_0.
 func validate_Groups_ValidateWKT_0(ctx context.Context, r json.RawMessage) (err error) {
 	return nil
 }
 
+// validate_response_Groups_ValidateWKT_0 is an entrypoint for validating a response body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Groups_ValidateWKT_0.
+func validate_response_Groups_ValidateWKT_0(ctx context.Context, r json.RawMessage) (err error) {
+	return nil
+}
+
 // validate_Groups_ValidateWKT_1 is an entrypoint for validating "PUT" HTTP request
 // that match *.pb.gw.go/pattern_Groups_ValidateWKT_1.
 func validate_Groups_ValidateWKT_1(ctx context.Context, r json.RawMessage) (err error) {
 	return nil
 }
 
+// validate_response_Groups_ValidateWKT_1 is an entrypoint for validating a response body of "PUT" HTTP request
+// that match *.pb.gw.go/pattern_Groups_ValidateWKT_1.
+func validate_response_Groups_ValidateWKT_1(ctx context.Context, r json.RawMessage) (err error) {
+	return nil
+}
+
+// validate_Compat_CreateAddressOrGroup_0 is an entrypoint for validating "POST" HTTP request
+// that match *.pb.gw.go/pattern_Compat_CreateAddressOrGroup_0.
+func validate_Compat_CreateAddressOrGroup_0(ctx context.Context, r json.RawMessage) (err error) {
+	return runtime1.MatchSchemas(true, []string{"examplepb.Address", "examplepb.Group"}, []error{
+		validate_Object_Address(ctx, r, ""),
+		validate_Object_Group(ctx, r, ""),
+	})
+}
+
+// validate_response_Compat_CreateAddressOrGroup_0 is an entrypoint for validating a response body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Compat_CreateAddressOrGroup_0.
+func validate_response_Compat_CreateAddressOrGroup_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// validate_Compat_CreateProfileOrGroup_0 is an entrypoint for validating "POST" HTTP request
+// that match *.pb.gw.go/pattern_Compat_CreateProfileOrGroup_0.
+func validate_Compat_CreateProfileOrGroup_0(ctx context.Context, r json.RawMessage) (err error) {
+	return runtime1.MatchSchemas(false, []string{"examplepb.Profile", "examplepb.Group"}, []error{
+		validate_Object_Profile(ctx, r, ""),
+		validate_Object_Group(ctx, r, ""),
+	})
+}
+
+// validate_response_Compat_CreateProfileOrGroup_0 is an entrypoint for validating a response body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Compat_CreateProfileOrGroup_0.
+func validate_response_Compat_CreateProfileOrGroup_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// validate_Tables_Import_0 is an entrypoint for validating "POST" HTTP request
+// that match *.pb.gw.go/pattern_Tables_Import_0.
+func validate_Tables_Import_0(ctx context.Context, r json.RawMessage) (err error) {
+	ctx = runtime1.WithMaxTotalElements(ctx, 8)
+	return validate_Object_Table(ctx, r, "")
+}
+
+// validate_response_Tables_Import_0 is an entrypoint for validating a response body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Tables_Import_0.
+func validate_response_Tables_Import_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// validate_form_Tables_Import_0 is an entrypoint for validating a form-urlencoded body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Tables_Import_0.
+func validate_form_Tables_Import_0(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_Table); err != nil {
+		return err
+	}
+	return validate_required_Object_Table(ctx, runtime1.FormObject(form), "")
+}
+
+// validate_Configs_Apply_0 is an entrypoint for validating "POST" HTTP request
+// that match *.pb.gw.go/pattern_Configs_Apply_0.
+func validate_Configs_Apply_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_RawConfig(ctx, r, "")
+}
+
+// validate_response_Configs_Apply_0 is an entrypoint for validating a response body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Configs_Apply_0.
+func validate_response_Configs_Apply_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// validate_form_Configs_Apply_0 is an entrypoint for validating a form-urlencoded body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Configs_Apply_0.
+func validate_form_Configs_Apply_0(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_RawConfig); err != nil {
+		return err
+	}
+	return validate_required_Object_RawConfig(ctx, runtime1.FormObject(form), "")
+}
+
+// validate_Configs_Apply_1 is an entrypoint for validating "POST" HTTP request
+// that match *.pb.gw.go/pattern_Configs_Apply_1.
+func validate_Configs_Apply_1(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_RawConfig(ctx, r, "")
+}
+
+// validate_response_Configs_Apply_1 is an entrypoint for validating a response body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Configs_Apply_1.
+func validate_response_Configs_Apply_1(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// validate_form_Configs_Apply_1 is an entrypoint for validating a form-urlencoded body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Configs_Apply_1.
+func validate_form_Configs_Apply_1(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_RawConfig); err != nil {
+		return err
+	}
+	return validate_required_Object_RawConfig(ctx, runtime1.FormObject(form), "")
+}
+
+// validate_Queries_Run_0 is an entrypoint for validating "SEARCH" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_0.
+func validate_Queries_Run_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_SearchQuery(ctx, r, "")
+}
+
+// validate_response_Queries_Run_0 is an entrypoint for validating a response body of "SEARCH" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_0.
+func validate_response_Queries_Run_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// default_Queries_Run_0 injects default values into a body of "SEARCH" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_0.
+func default_Queries_Run_0(ctx context.Context, r json.RawMessage) (json.RawMessage, error) {
+	return default_Object_SearchQuery(ctx, r, "")
+}
+
+// validate_form_Queries_Run_0 is an entrypoint for validating a form-urlencoded body of "SEARCH" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_0.
+func validate_form_Queries_Run_0(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_SearchQuery); err != nil {
+		return err
+	}
+	return validate_required_Object_SearchQuery(ctx, runtime1.FormObject(form), "")
+}
+
+// validate_Queries_Run_1 is an entrypoint for validating "POST" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_1.
+func validate_Queries_Run_1(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_SearchQuery(ctx, r, "")
+}
+
+// validate_response_Queries_Run_1 is an entrypoint for validating a response body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_1.
+func validate_response_Queries_Run_1(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// default_Queries_Run_1 injects default values into a body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_1.
+func default_Queries_Run_1(ctx context.Context, r json.RawMessage) (json.RawMessage, error) {
+	return default_Object_SearchQuery(ctx, r, "")
+}
+
+// validate_form_Queries_Run_1 is an entrypoint for validating a form-urlencoded body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_1.
+func validate_form_Queries_Run_1(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_SearchQuery); err != nil {
+		return err
+	}
+	return validate_required_Object_SearchQuery(ctx, runtime1.FormObject(form), "")
+}
+
+// validate_Queries_Run_2 is an entrypoint for validating "LINK" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_2.
+func validate_Queries_Run_2(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_SearchQuery(ctx, r, "")
+}
+
+// validate_response_Queries_Run_2 is an entrypoint for validating a response body of "LINK" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_2.
+func validate_response_Queries_Run_2(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse(ctx, r, "")
+}
+
+// default_Queries_Run_2 injects default values into a body of "LINK" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_2.
+func default_Queries_Run_2(ctx context.Context, r json.RawMessage) (json.RawMessage, error) {
+	return default_Object_SearchQuery(ctx, r, "")
+}
+
+// validate_form_Queries_Run_2 is an entrypoint for validating a form-urlencoded body of "LINK" HTTP request
+// that match *.pb.gw.go/pattern_Queries_Run_2.
+func validate_form_Queries_Run_2(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_SearchQuery); err != nil {
+		return err
+	}
+	return validate_required_Object_SearchQuery(ctx, runtime1.FormObject(form), "")
+}
+
 // validate_Object_User function validates a JSON for a given object.
 func validate_Object_User(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&User{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -149,6 +655,9 @@ func validate_Object_User(ctx context.Context, r json.RawMessage, path string) (
 	if err = json.Unmarshal(r, &v); err != nil {
 		return fmt.Errorf("invalid value for %q: expected object.", path)
 	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
 
 	if err = validate_required_Object_User(ctx, v, path); err != nil {
 		return err
@@ -159,13 +668,31 @@ func validate_Object_User(ctx context.Context, r json.RawMessage, path string) (
 	for k, _ := range v {
 		switch k {
 		case "id":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
 			method := runtime1.HTTPMethodFromContext(ctx)
-			if method == "POST" {
-				return fmt.Errorf("field %q is unsupported for %q operation.", k, method)
+			if runtime1.RuleEnabled(ctx, "examplepb.User.id.deny") && (method == "POST") {
+				return fmt.Errorf("field %q is unsupported for %q operation; allowed: [LINK, PATCH, PUT, SEARCH].", k, method)
+			}
+			if err = runtime1.ValidatePathVariable(ctx, v[k], runtime1.JoinPath(path, k), "payload.id"); runtime1.RuleEnabled(ctx, "examplepb.User.id.path_variable") && err != nil {
+				return err
+			}
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int32"); err != nil {
+				return err
 			}
 		case "name":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
 		case "profile":
-			if v[k] == nil {
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
 				continue
 			}
 			vv := v[k]
@@ -174,7 +701,8 @@ func validate_Object_User(ctx context.Context, r json.RawMessage, path string) (
 				return err
 			}
 		case "address":
-			if v[k] == nil {
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
 				continue
 			}
 			vv := v[k]
@@ -183,6 +711,7 @@ func validate_Object_User(ctx context.Context, r json.RawMessage, path string) (
 				return err
 			}
 		case "groups":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
 			if v[k] == nil {
 				continue
 			}
@@ -191,13 +720,17 @@ func validate_Object_User(ctx context.Context, r json.RawMessage, path string) (
 			if err = json.Unmarshal(v[k], &vArr); err != nil {
 				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
 			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
 			for i, vv := range vArr {
-				vvPath := fmt.Sprintf("%s.[%d]", vArrPath, i)
+				vvPath := runtime1.JoinIndex(vArrPath, i)
 				if err = validate_Object_Group(ctx, vv, vvPath); err != nil {
 					return err
 				}
 			}
 		case "parents":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
 			if v[k] == nil {
 				continue
 			}
@@ -206,14 +739,18 @@ func validate_Object_User(ctx context.Context, r json.RawMessage, path string) (
 			if err = json.Unmarshal(v[k], &vArr); err != nil {
 				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
 			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
 			for i, vv := range vArr {
-				vvPath := fmt.Sprintf("%s.[%d]", vArrPath, i)
+				vvPath := runtime1.JoinIndex(vArrPath, i)
 				if err = validate_Object_User_Parent(ctx, vv, vvPath); err != nil {
 					return err
 				}
 			}
 		case "external_user":
-			if v[k] == nil {
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
 				continue
 			}
 			vv := v[k]
@@ -228,6 +765,7 @@ func validate_Object_User(ctx context.Context, r json.RawMessage, path string) (
 				return err
 			}
 		case "empty_list":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
 			if v[k] == nil {
 				continue
 			}
@@ -236,23 +774,137 @@ func validate_Object_User(ctx context.Context, r json.RawMessage, path string) (
 			if err = json.Unmarshal(v[k], &vArr); err != nil {
 				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
 			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateWKT(vv, runtime1.JoinIndex(vArrPath, i), "empty"); err != nil {
+					return err
+				}
+			}
 		case "timestamp":
-		default:
-			if !allowUnknown {
-				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateWKT(v[k], runtime1.JoinPath(path, k), "timestamp"); err != nil {
+				return err
 			}
-		}
-	}
-	return nil
-}
-
-// AtlasValidateJSON function validates a JSON for object User.
-func (_ *User) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
-	if hook, ok := interface{}(&User{}).(interface {
-		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
-	}); ok {
-		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
-			return err
+			if err = runtime1.ValidateMaxFutureSkew(v[k], runtime1.JoinPath(path, k), time.Duration(300000000000)); runtime1.RuleEnabled(ctx, "examplepb.User.timestamp.max_future_skew") && err != nil {
+				return err
+			}
+		case "primary_group":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			vv := v[k]
+			vvPath := runtime1.JoinPath(path, k)
+			if err = validate_Object_Group(ctx, vv, vvPath); err != nil {
+				return err
+			}
+		case "external_contacts":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vMap map[string]json.RawMessage
+			vMapPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vMap); err != nil {
+				return fmt.Errorf("invalid value for %q: expected map.", vMapPath)
+			}
+			if err = runtime1.ValidateUniqueKeys(v[k], vMapPath, runtime1.JoinPath); err != nil {
+				return err
+			}
+			validator, ok := interface{}(&external.ExternalUser{}).(interface {
+				AtlasValidateJSON(context.Context, json.RawMessage, string) error
+			})
+			for kk, vv := range vMap {
+				vvPath := runtime1.JoinPath(vMapPath, kk)
+				if !ok {
+					continue
+				}
+				if err = validator.AtlasValidateJSON(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
+		case "external_kind":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateRegisteredEnum(v[k], runtime1.JoinPath(path, k), "external.Kind"); err != nil {
+				return err
+			}
+		case "external_roles":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateRegisteredEnum(vv, runtime1.JoinIndex(vArrPath, i), "external.ExternalUser_Role"); err != nil {
+					return err
+				}
+			}
+		case "external_kinds":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vMap map[string]json.RawMessage
+			vMapPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vMap); err != nil {
+				return fmt.Errorf("invalid value for %q: expected map.", vMapPath)
+			}
+			if err = runtime1.ValidateUniqueKeys(v[k], vMapPath, runtime1.JoinPath); err != nil {
+				return err
+			}
+			for kk, vv := range vMap {
+				vvPath := runtime1.JoinPath(vMapPath, kk)
+				if err = runtime1.ValidateRegisteredEnum(vv, vvPath, "external.Kind"); err != nil {
+					return err
+				}
+			}
+		case "created_by":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			method := runtime1.HTTPMethodFromContext(ctx)
+			if runtime1.RuleEnabled(ctx, "examplepb.User.created_by.read_only") && (method == "LINK" || method == "PATCH" || method == "POST" || method == "PUT" || method == "SEARCH") {
+				return fmt.Errorf("field %q is read-only", runtime1.JoinPath(path, k))
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object User.
+func (_ *User) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&User{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
 		}
 	}
 	return validate_Object_User(ctx, r, path)
@@ -261,19 +913,113 @@ func (_ *User) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path st
 func validate_required_Object_User(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
-	if _, ok := v["name"]; !ok {
-		path = runtime1.JoinPath(path, "name")
-		return fmt.Errorf("field %q is required for %q operation.", path, method)
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	if _, ok := v["name"]; runtime1.RuleEnabled(ctx, "examplepb.User.name.required") && !ok && !marshaled && (method == "PATCH" || method == "POST" || method == "PUT") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "name"), method)
 	}
 	return nil
 }
 
+// default_Object_User function injects default values of absent fields into a JSON for a given object.
+func default_Object_User(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(r, &v); err != nil || v == nil {
+		return r, nil
+	}
+
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	changed := false
+
+	if vv, ok := v["profile"]; ok {
+		vvPath := runtime1.JoinPath(path, "profile")
+		nv, err := default_Object_Profile(ctx, vv, vvPath)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(nv, vv) {
+			v["profile"], changed = nv, true
+		}
+	}
+
+	if !changed {
+		return r, nil
+	}
+	return json.Marshal(v)
+}
+
+// validate_Query_Object_User function validates a query parameter for a given object.
+func validate_Query_Object_User(ctx context.Context, fieldPath []string, values []string, key string) error {
+	switch fieldPath[0] {
+	case "id":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "int32", false)
+	case "name":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "profile":
+		if len(fieldPath) == 1 {
+			return runtime1.QueryParameterError(ctx, key, ": expected a nested field.")
+		}
+		return validate_Query_Object_Profile(ctx, fieldPath[1:], values, key)
+	case "address":
+		if len(fieldPath) == 1 {
+			return runtime1.QueryParameterError(ctx, key, ": expected a nested field.")
+		}
+		return validate_Query_Object_Address(ctx, fieldPath[1:], values, key)
+	case "groups":
+		return nil
+	case "parents":
+		return nil
+	case "external_user", "externalUser":
+		return nil
+	case "empty_list", "emptyList":
+		return nil
+	case "timestamp":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "timestamp", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryMaxFutureSkew(ctx, key, values, time.Duration(300000000000))
+	case "primary_group", "primaryGroup":
+		if len(fieldPath) == 1 {
+			return runtime1.QueryParameterError(ctx, key, ": expected a nested field.")
+		}
+		return validate_Query_Object_Group(ctx, fieldPath[1:], values, key)
+	case "external_contacts", "externalContacts":
+		return runtime1.ValidateQueryMapEntry(ctx, key, fieldPath, values, "", "")
+	case "external_kind", "externalKind":
+		return runtime1.ValidateQueryEnum(ctx, key, fieldPath, values, false, func(r json.RawMessage) error {
+			return runtime1.ValidateRegisteredEnum(r, key, "external.Kind")
+		})
+	case "external_roles", "externalRoles":
+		return runtime1.ValidateQueryEnum(ctx, key, fieldPath, values, true, func(r json.RawMessage) error {
+			return runtime1.ValidateRegisteredEnum(r, key, "external.ExternalUser_Role")
+		})
+	case "external_kinds", "externalKinds":
+		if err := runtime1.ValidateQueryMapEntry(ctx, key, fieldPath, values, "", ""); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryEnum(ctx, key, fieldPath, values[1:], false, func(r json.RawMessage) error {
+			return runtime1.ValidateRegisteredEnum(r, key, "external.Kind")
+		})
+	case "created_by", "createdBy":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	}
+	return runtime1.ValidateUnknownQueryParameter(ctx, key)
+}
+
 // validate_Object_User_Parent function validates a JSON for a given object.
 func validate_Object_User_Parent(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&User_Parent{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -282,6 +1028,9 @@ func validate_Object_User_Parent(ctx context.Context, r json.RawMessage, path st
 	if err = json.Unmarshal(r, &v); err != nil {
 		return fmt.Errorf("invalid value for %q: expected object.", path)
 	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
 
 	if err = validate_required_Object_User_Parent(ctx, v, path); err != nil {
 		return err
@@ -292,7 +1041,19 @@ func validate_Object_User_Parent(ctx context.Context, r json.RawMessage, path st
 	for k, _ := range v {
 		switch k {
 		case "name":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
 			if !allowUnknown {
 				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
 			}
@@ -306,7 +1067,11 @@ func (_ *User_Parent) AtlasValidateJSON(ctx context.Context, r json.RawMessage,
 	if hook, ok := interface{}(&User_Parent{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -316,15 +1081,24 @@ func (_ *User_Parent) AtlasValidateJSON(ctx context.Context, r json.RawMessage,
 func validate_required_Object_User_Parent(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
 	return nil
 }
 
 // validate_Object_Address function validates a JSON for a given object.
 func validate_Object_Address(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&Address{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -333,6 +1107,9 @@ func validate_Object_Address(ctx context.Context, r json.RawMessage, path string
 	if err = json.Unmarshal(r, &v); err != nil {
 		return fmt.Errorf("invalid value for %q: expected object.", path)
 	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
 
 	if err = validate_required_Object_Address(ctx, v, path); err != nil {
 		return err
@@ -343,15 +1120,103 @@ func validate_Object_Address(ctx context.Context, r json.RawMessage, path string
 	for k, _ := range v {
 		switch k {
 		case "country":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
 		case "state":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
 			method := runtime1.HTTPMethodFromContext(ctx)
-			if method == "PATCH" || method == "POST" || method == "PUT" {
-				return fmt.Errorf("field %q is unsupported for %q operation.", k, method)
+			if runtime1.RuleEnabled(ctx, "examplepb.Address.state.deny") && (method == "PATCH" || method == "POST" || method == "PUT") {
+				return fmt.Errorf("field %q is unsupported for %q operation; allowed: [LINK, SEARCH].", k, method)
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
 			}
 		case "city":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
 		case "zip":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "region":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateInSet(v[k], runtime1.JoinPath(path, k), "regions"); runtime1.RuleEnabled(ctx, "examplepb.Address.region.in_set") && err != nil {
+				return err
+			}
+		case "languages":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateScalar(vv, runtime1.JoinIndex(vArrPath, i), "string"); err != nil {
+					return err
+				}
+				if err = runtime1.ValidateInSet(vv, runtime1.JoinIndex(vArrPath, i), "languages"); runtime1.RuleEnabled(ctx, "examplepb.Address.languages.in_set") && err != nil {
+					return err
+				}
+			}
 		case "tags":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vMap map[string]json.RawMessage
+			vMapPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vMap); err != nil {
+				return fmt.Errorf("invalid value for %q: expected map.", vMapPath)
+			}
+			if err = runtime1.ValidateUniqueKeys(v[k], vMapPath, runtime1.JoinPath); err != nil {
+				return err
+			}
+			for kk, vv := range vMap {
+				vvPath := runtime1.JoinPath(vMapPath, kk)
+				if err = runtime1.ValidateScalar(vv, vvPath, "string"); err != nil {
+					return err
+				}
+			}
+		case "_links", "_etag":
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
 			if !allowUnknown {
 				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
 			}
@@ -365,7 +1230,11 @@ func (_ *Address) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path
 	if hook, ok := interface{}(&Address{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -375,15 +1244,63 @@ func (_ *Address) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path
 func validate_required_Object_Address(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
 	return nil
 }
 
+// validate_Query_Object_Address function validates a query parameter for a given object.
+func validate_Query_Object_Address(ctx context.Context, fieldPath []string, values []string, key string) error {
+	switch fieldPath[0] {
+	case "country":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "state":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "city":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "zip":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "region":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidateInSet(r, path, "regions"); runtime1.RuleEnabled(ctx, "examplepb.Address.region.in_set") && err != nil {
+				return err
+			}
+			return nil
+		})
+	case "languages":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", true); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidateInSet(r, path, "languages"); runtime1.RuleEnabled(ctx, "examplepb.Address.languages.in_set") && err != nil {
+				return err
+			}
+			return nil
+		})
+	case "tags":
+		return runtime1.ValidateQueryMapEntry(ctx, key, fieldPath, values, "", "")
+	}
+	return runtime1.ValidateUnknownQueryParameter(ctx, key)
+}
+
+var cel_Object_Group_0 = cel.MustCompile("!has(this.notes) || !has(this.name) || this.notes != this.name")
+
 // validate_Object_Group function validates a JSON for a given object.
 func validate_Object_Group(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&Group{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -392,6 +1309,13 @@ func validate_Object_Group(ctx context.Context, r json.RawMessage, path string)
 	if err = json.Unmarshal(r, &v); err != nil {
 		return fmt.Errorf("invalid value for %q: expected object.", path)
 	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if len(v) > 5 {
+		return fmt.Errorf("object %q has too many fields", path)
+	}
 
 	if err = validate_required_Object_Group(ctx, v, path); err != nil {
 		return err
@@ -402,14 +1326,82 @@ func validate_Object_Group(ctx context.Context, r json.RawMessage, path string)
 	for k, _ := range v {
 		switch k {
 		case "id":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int32"); err != nil {
+				return err
+			}
 		case "name":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
 		case "notes":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if runtime1.RuleEnabled(ctx, "examplepb.Group.notes.max_field_bytes") && len(v[k]) > 64 {
+				return fmt.Errorf("field %q is too large", runtime1.JoinPath(path, k))
+			}
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "tags":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateScalar(vv, runtime1.JoinIndex(vArrPath, i), "string"); err != nil {
+					return err
+				}
+				if err = runtime1.ValidateMaxLength(vv, runtime1.JoinIndex(vArrPath, i), 8, "string"); runtime1.RuleEnabled(ctx, "examplepb.Group.tags.max_length") && err != nil {
+					return err
+				}
+			}
+		case "avatar":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "bytes"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateBase64(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMaxLength(v[k], runtime1.JoinPath(path, k), 4, "bytes"); runtime1.RuleEnabled(ctx, "examplepb.Group.avatar.max_length") && err != nil {
+				return err
+			}
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
 			if !allowUnknown {
 				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
 			}
 		}
 	}
+	if err = cel.Validate(cel_Object_Group_0, r, path, "notes must differ from name."); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -418,7 +1410,11 @@ func (_ *Group) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path s
 	if hook, ok := interface{}(&Group{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -428,23 +1424,63 @@ func (_ *Group) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path s
 func validate_required_Object_Group(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
-	if _, ok := v["id"]; !ok && (method == "PATCH" || method == "PUT") {
-		path = runtime1.JoinPath(path, "id")
-		return fmt.Errorf("field %q is required for %q operation.", path, method)
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	if _, ok := v["id"]; runtime1.RuleEnabled(ctx, "examplepb.Group.id.required") && !ok && !marshaled && (method == "PATCH" || method == "PUT") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "id"), method)
 	}
-	if _, ok := v["name"]; !ok && (method == "POST") {
-		path = runtime1.JoinPath(path, "name")
-		return fmt.Errorf("field %q is required for %q operation.", path, method)
+	if _, ok := v["name"]; runtime1.RuleEnabled(ctx, "examplepb.Group.name.required") && !ok && !marshaled && (method == "POST") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "name"), method)
 	}
 	return nil
 }
 
-// validate_Object_CreateUserRequest function validates a JSON for a given object.
-func validate_Object_CreateUserRequest(ctx context.Context, r json.RawMessage, path string) (err error) {
-	if hook, ok := interface{}(&CreateUserRequest{}).(interface {
+// validate_Query_Object_Group function validates a query parameter for a given object.
+func validate_Query_Object_Group(ctx context.Context, fieldPath []string, values []string, key string) error {
+	switch fieldPath[0] {
+	case "id":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "int32", false)
+	case "name":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "notes":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "tags":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", true); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidateMaxLength(r, path, 8, "string"); runtime1.RuleEnabled(ctx, "examplepb.Group.tags.max_length") && err != nil {
+				return err
+			}
+			return nil
+		})
+	case "avatar":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "bytes", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidateMaxLength(r, path, 4, "bytes"); runtime1.RuleEnabled(ctx, "examplepb.Group.avatar.max_length") && err != nil {
+				return err
+			}
+			return nil
+		})
+	}
+	return runtime1.ValidateUnknownQueryParameter(ctx, key)
+}
+
+// validate_Object_Policy function validates a JSON for a given object.
+func validate_Object_Policy(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Policy{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -453,8 +1489,11 @@ func validate_Object_CreateUserRequest(ctx context.Context, r json.RawMessage, p
 	if err = json.Unmarshal(r, &v); err != nil {
 		return fmt.Errorf("invalid value for %q: expected object.", path)
 	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
 
-	if err = validate_required_Object_CreateUserRequest(ctx, v, path); err != nil {
+	if err = validate_required_Object_Policy(ctx, v, path); err != nil {
 		return err
 	}
 
@@ -462,16 +1501,2145 @@ func validate_Object_CreateUserRequest(ctx context.Context, r json.RawMessage, p
 
 	for k, _ := range v {
 		switch k {
-		case "payload":
+		case "rules":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
 			if v[k] == nil {
 				continue
 			}
-			vv := v[k]
-			vvPath := runtime1.JoinPath(path, k)
-			if err = validate_Object_User(ctx, vv, vvPath); err != nil {
-				return err
-			}
-		default:
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				vvPath := runtime1.JoinIndex(vArrPath, i)
+				if err = validate_Object_Policy_Rule(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Policy.
+func (_ *Policy) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Policy{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Policy(ctx, r, path)
+}
+
+func validate_required_Object_Policy(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+var regexp_examplepb_Policy_Rule_labels_key = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// validate_Object_Policy_Rule function validates a JSON for a given object.
+func validate_Object_Policy_Rule(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Policy_Rule{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Policy_Rule(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "labels":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vMap map[string]json.RawMessage
+			vMapPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vMap); err != nil {
+				return fmt.Errorf("invalid value for %q: expected map.", vMapPath)
+			}
+			if err = runtime1.ValidateUniqueKeys(v[k], vMapPath, runtime1.JoinPath); err != nil {
+				return err
+			}
+			for kk, vv := range vMap {
+				vvPath := runtime1.JoinPath(vMapPath, kk)
+				if err = runtime1.ValidateMapKeyPattern(kk, vMapPath, regexp_examplepb_Policy_Rule_labels_key); runtime1.RuleEnabled(ctx, "examplepb.Policy.Rule.labels.key_pattern") && err != nil {
+					return err
+				}
+				if err = runtime1.ValidateScalar(vv, vvPath, "string"); err != nil {
+					return err
+				}
+			}
+		case "groups":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vMap map[string]json.RawMessage
+			vMapPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vMap); err != nil {
+				return fmt.Errorf("invalid value for %q: expected map.", vMapPath)
+			}
+			if err = runtime1.ValidateUniqueKeys(v[k], vMapPath, runtime1.JoinPath); err != nil {
+				return err
+			}
+			for kk, vv := range vMap {
+				vvPath := runtime1.JoinPath(vMapPath, kk)
+				if err = runtime1.ValidateMapKey(kk, vvPath, "int32"); err != nil {
+					return err
+				}
+				if err = validate_Object_Group(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
+		case "tiers":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vMap map[string]json.RawMessage
+			vMapPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vMap); err != nil {
+				return fmt.Errorf("invalid value for %q: expected map.", vMapPath)
+			}
+			if err = runtime1.ValidateUniqueKeys(v[k], vMapPath, runtime1.JoinPath); err != nil {
+				return err
+			}
+			for kk, vv := range vMap {
+				vvPath := runtime1.JoinPath(vMapPath, kk)
+				if err = runtime1.ValidateEnum(vv, vvPath, validate_Enum_Policy_Tier); err != nil {
+					return err
+				}
+			}
+		case "conditions":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vMap map[string]json.RawMessage
+			vMapPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vMap); err != nil {
+				return fmt.Errorf("invalid value for %q: expected map.", vMapPath)
+			}
+			if err = runtime1.ValidateUniqueKeys(v[k], vMapPath, runtime1.JoinPath); err != nil {
+				return err
+			}
+			for kk, vv := range vMap {
+				vvPath := runtime1.JoinPath(vMapPath, kk)
+				if err = validate_Object_Policy_Rule_Condition(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Policy_Rule.
+func (_ *Policy_Rule) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Policy_Rule{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Policy_Rule(ctx, r, path)
+}
+
+func validate_required_Object_Policy_Rule(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+// validate_Object_Policy_Rule_Condition function validates a JSON for a given object.
+func validate_Object_Policy_Rule_Condition(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Policy_Rule_Condition{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Policy_Rule_Condition(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "expression":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Policy_Rule_Condition.
+func (_ *Policy_Rule_Condition) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Policy_Rule_Condition{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Policy_Rule_Condition(ctx, r, path)
+}
+
+func validate_required_Object_Policy_Rule_Condition(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	if _, ok := v["expression"]; runtime1.RuleEnabled(ctx, "examplepb.Policy.Rule.Condition.expression.required") && !ok && !marshaled && (method == "POST") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "expression"), method)
+	}
+	return nil
+}
+
+// validate_Object_Table function validates a JSON for a given object.
+func validate_Object_Table(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Table{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Table(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "rows":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				vvPath := runtime1.JoinIndex(vArrPath, i)
+				if err = validate_Object_Table_Row(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Table.
+func (_ *Table) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Table{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Table(ctx, r, path)
+}
+
+func validate_required_Object_Table(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+// validate_Query_Object_Table function validates a query parameter for a given object.
+func validate_Query_Object_Table(ctx context.Context, fieldPath []string, values []string, key string) error {
+	switch fieldPath[0] {
+	case "rows":
+		return nil
+	}
+	return runtime1.ValidateUnknownQueryParameter(ctx, key)
+}
+
+// validate_Object_Table_Cell function validates a JSON for a given object.
+func validate_Object_Table_Cell(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Table_Cell{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Table_Cell(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "value":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "span":
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int32"); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Table_Cell.
+func (_ *Table_Cell) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Table_Cell{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Table_Cell(ctx, r, path)
+}
+
+func validate_required_Object_Table_Cell(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+// validate_Object_Table_Row function validates a JSON for a given object.
+func validate_Object_Table_Row(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Table_Row{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Table_Row(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "cells":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				vvPath := runtime1.JoinIndex(vArrPath, i)
+				if err = validate_Object_Table_Cell(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Table_Row.
+func (_ *Table_Row) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Table_Row{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Table_Row(ctx, r, path)
+}
+
+func validate_required_Object_Table_Row(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+// validate_Object_Measurement function validates a JSON for a given object.
+func validate_Object_Measurement(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Measurement{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Measurement(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "age":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int32"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMin(v[k], runtime1.JoinPath(path, k), "int32", 0); runtime1.RuleEnabled(ctx, "examplepb.Measurement.age.min") && err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMax(v[k], runtime1.JoinPath(path, k), "int32", 150); runtime1.RuleEnabled(ctx, "examplepb.Measurement.age.max") && err != nil {
+				return err
+			}
+		case "offset":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int64"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMin(v[k], runtime1.JoinPath(path, k), "int64", -9.007199254740992e+15); runtime1.RuleEnabled(ctx, "examplepb.Measurement.offset.min") && err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMax(v[k], runtime1.JoinPath(path, k), "int64", 9.007199254740992e+15); runtime1.RuleEnabled(ctx, "examplepb.Measurement.offset.max") && err != nil {
+				return err
+			}
+		case "size":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "uint64"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMin(v[k], runtime1.JoinPath(path, k), "uint64", 1); runtime1.RuleEnabled(ctx, "examplepb.Measurement.size.min") && err != nil {
+				return err
+			}
+		case "weights":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateScalar(vv, runtime1.JoinIndex(vArrPath, i), "float"); err != nil {
+					return err
+				}
+				if err = runtime1.ValidateMin(vv, runtime1.JoinIndex(vArrPath, i), "float", 0); runtime1.RuleEnabled(ctx, "examplepb.Measurement.weights.min") && err != nil {
+					return err
+				}
+				if err = runtime1.ValidateMax(vv, runtime1.JoinIndex(vArrPath, i), "float", 0.5); runtime1.RuleEnabled(ctx, "examplepb.Measurement.weights.max") && err != nil {
+					return err
+				}
+			}
+		case "limit":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int32"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMin(v[k], runtime1.JoinPath(path, k), "int32", 1); runtime1.RuleEnabled(ctx, "examplepb.Measurement.limit.min") && err != nil {
+				return err
+			}
+		case "aliases":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateScalar(vv, runtime1.JoinIndex(vArrPath, i), "string"); err != nil {
+					return err
+				}
+			}
+		case "active":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "bool"); err != nil {
+				return err
+			}
+		case "interval":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateWKT(v[k], runtime1.JoinPath(path, k), "duration"); err != nil {
+				return err
+			}
+		case "attrs":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateWKT(v[k], runtime1.JoinPath(path, k), "struct"); err != nil {
+				return err
+			}
+		case "times":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateWKT(vv, runtime1.JoinIndex(vArrPath, i), "timestamp"); err != nil {
+					return err
+				}
+			}
+		case "verified":
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "bool"); err != nil {
+				return err
+			}
+		case "unit":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "checksum":
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "bytes"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateBase64(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+		case "ratio":
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "double"); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Measurement.
+func (_ *Measurement) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Measurement{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Measurement(ctx, r, path)
+}
+
+func validate_required_Object_Measurement(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+// validate_Object_Node function validates a JSON for a given object.
+func validate_Object_Node(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Node{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Node(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "name":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "children":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				vvPath := runtime1.JoinIndex(vArrPath, i)
+				if err = validate_Object_Node(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
+		case "links":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vMap map[string]json.RawMessage
+			vMapPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vMap); err != nil {
+				return fmt.Errorf("invalid value for %q: expected map.", vMapPath)
+			}
+			if err = runtime1.ValidateUniqueKeys(v[k], vMapPath, runtime1.JoinPath); err != nil {
+				return err
+			}
+			for kk, vv := range vMap {
+				vvPath := runtime1.JoinPath(vMapPath, kk)
+				if err = validate_Object_Node(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
+		case "extension":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			ctx := context.WithValue(ctx, runtime1.AllowUnknownContextKey, true)
+			vv := v[k]
+			vvPath := runtime1.JoinPath(path, k)
+			if err = validate_Object_Node(ctx, vv, vvPath); err != nil {
+				return err
+			}
+		case "plugins":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vMap map[string]json.RawMessage
+			vMapPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vMap); err != nil {
+				return fmt.Errorf("invalid value for %q: expected map.", vMapPath)
+			}
+			if err = runtime1.ValidateUniqueKeys(v[k], vMapPath, runtime1.JoinPath); err != nil {
+				return err
+			}
+			ctx := context.WithValue(ctx, runtime1.AllowUnknownContextKey, true)
+			for kk, vv := range vMap {
+				vvPath := runtime1.JoinPath(vMapPath, kk)
+				if err = validate_Object_Node(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
+		case "spec":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			ctx := context.WithValue(ctx, runtime1.AllowUnknownContextKey, false)
+			vv := v[k]
+			vvPath := runtime1.JoinPath(path, k)
+			if err = validate_Object_Node(ctx, vv, vvPath); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Node.
+func (_ *Node) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Node{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Node(ctx, r, path)
+}
+
+func validate_required_Object_Node(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+// validate_Object_RawConfig function validates a JSON for a given object.
+func validate_Object_RawConfig(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&RawConfig{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_RawConfig(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "version":
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int32"); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object RawConfig.
+func (_ *RawConfig) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&RawConfig{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_RawConfig(ctx, r, path)
+}
+
+func validate_required_Object_RawConfig(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+// validate_Query_Object_RawConfig function validates a query parameter for a given object.
+func validate_Query_Object_RawConfig(ctx context.Context, fieldPath []string, values []string, key string) error {
+	switch fieldPath[0] {
+	case "version":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "int32", false)
+	}
+	return runtime1.ValidateUnknownQueryParameter(ctx, key)
+}
+
+// validate_Object_SearchQuery function validates a JSON for a given object.
+func validate_Object_SearchQuery(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&SearchQuery{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_SearchQuery(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "query":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "refresh":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			method := runtime1.HTTPMethodFromContext(ctx)
+			if runtime1.RuleEnabled(ctx, "examplepb.SearchQuery.refresh.deny") && (method == "LINK" || method == "SEARCH") {
+				return fmt.Errorf("field %q is unsupported for %q operation; allowed: [PATCH, POST, PUT].", k, method)
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "bool"); err != nil {
+				return err
+			}
+		case "owner":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "created_by":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			method := runtime1.HTTPMethodFromContext(ctx)
+			if runtime1.RuleEnabled(ctx, "examplepb.SearchQuery.created_by.read_only") && (method == "LINK" || method == "PATCH" || method == "POST" || method == "PUT" || method == "SEARCH") {
+				return fmt.Errorf("field %q is read-only", runtime1.JoinPath(path, k))
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "locale":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateInSet(v[k], runtime1.JoinPath(path, k), "locales"); runtime1.RuleEnabled(ctx, "examplepb.SearchQuery.locale.in_set") && err != nil {
+				return err
+			}
+		case "refresh_schedule":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateFormat(v[k], runtime1.JoinPath(path, k), "cron"); runtime1.RuleEnabled(ctx, "examplepb.SearchQuery.refresh_schedule.format") && err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object SearchQuery.
+func (_ *SearchQuery) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&SearchQuery{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_SearchQuery(ctx, r, path)
+}
+
+func validate_required_Object_SearchQuery(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	if _, ok := v["owner"]; runtime1.RuleEnabled(ctx, "examplepb.SearchQuery.owner.required") && !ok && !marshaled && (method == "PATCH" || method == "POST" || method == "PUT") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "owner"), method)
+	}
+	if _, ok := v["query"]; runtime1.RuleEnabled(ctx, "examplepb.SearchQuery.query.required") && !ok && !marshaled && (method == "LINK" || method == "POST" || method == "SEARCH") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "query"), method)
+	}
+	return nil
+}
+
+// default_Object_SearchQuery function injects default values of absent fields into a JSON for a given object.
+func default_Object_SearchQuery(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(r, &v); err != nil || v == nil {
+		return r, nil
+	}
+
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	changed := false
+
+	if _, ok := v["locale"]; !ok {
+		if err := runtime1.ValidateInSet(json.RawMessage("\"en\""), runtime1.JoinPath(path, "locale"), "locales"); err != nil {
+			return nil, err
+		}
+		v["locale"] = json.RawMessage("\"en\"")
+		changed = true
+	}
+	if _, ok := v["refresh_schedule"]; !ok {
+		v["refresh_schedule"] = json.RawMessage("\"0 * * * *\"")
+		changed = true
+	}
+
+	if !changed {
+		return r, nil
+	}
+	return json.Marshal(v)
+}
+
+// validate_Query_Object_SearchQuery function validates a query parameter for a given object.
+func validate_Query_Object_SearchQuery(ctx context.Context, fieldPath []string, values []string, key string) error {
+	switch fieldPath[0] {
+	case "query":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "refresh":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "bool", false)
+	case "owner":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "created_by", "createdBy":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "locale":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidateInSet(r, path, "locales"); runtime1.RuleEnabled(ctx, "examplepb.SearchQuery.locale.in_set") && err != nil {
+				return err
+			}
+			return nil
+		})
+	case "refresh_schedule", "refreshSchedule":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidateFormat(r, path, "cron"); runtime1.RuleEnabled(ctx, "examplepb.SearchQuery.refresh_schedule.format") && err != nil {
+				return err
+			}
+			return nil
+		})
+	}
+	return runtime1.ValidateUnknownQueryParameter(ctx, key)
+}
+
+// validate_Object_Schedule function validates a JSON for a given object.
+func validate_Object_Schedule(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Schedule{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Schedule(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "start":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "end":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "timezone":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "days":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			if runtime1.RuleEnabled(ctx, "examplepb.Schedule.days.min_items") && vArr != nil && len(vArr) < 1 {
+				return fmt.Errorf("field %q must have at least %d items", vArrPath, 1)
+			}
+			if runtime1.RuleEnabled(ctx, "examplepb.Schedule.days.max_items") && len(vArr) > 7 {
+				return fmt.Errorf("field %q must have at most %d items", vArrPath, 7)
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateScalar(vv, runtime1.JoinIndex(vArrPath, i), "string"); err != nil {
+					return err
+				}
+			}
+		case "exceptions":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			if runtime1.RuleEnabled(ctx, "examplepb.Schedule.exceptions.max_items") && len(vArr) > 2 {
+				return fmt.Errorf("field %q must have at most %d items", vArrPath, 2)
+			}
+			for i, vv := range vArr {
+				vvPath := runtime1.JoinIndex(vArrPath, i)
+				if err = validate_Object_Schedule(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Schedule.
+func (_ *Schedule) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Schedule{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Schedule(ctx, r, path)
+}
+
+func validate_required_Object_Schedule(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	if _, ok := v["start"]; ok {
+		if _, ok := v["end"]; runtime1.RuleEnabled(ctx, "examplepb.Schedule.end.required_if") && !ok && !marshaled {
+			return fmt.Errorf("field %q is required when %q is set", runtime1.JoinPath(path, "end"), runtime1.JoinPath(path, "start"))
+		}
+	}
+	if _, ok := v["start"]; ok {
+		if _, ok := v["timezone"]; runtime1.RuleEnabled(ctx, "examplepb.Schedule.timezone.required_if") && !ok && !marshaled && (method == "POST") {
+			return fmt.Errorf("field %q is required when %q is set", runtime1.JoinPath(path, "timezone"), runtime1.JoinPath(path, "start"))
+		}
+	}
+	return nil
+}
+
+// validate_Object_Notifications function validates a JSON for a given object.
+func validate_Object_Notifications(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Notifications{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Notifications(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "channels":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				vvPath := runtime1.JoinIndex(vArrPath, i)
+				if err = validate_Object_Notifications_Channel(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Notifications.
+func (_ *Notifications) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Notifications{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Notifications(ctx, r, path)
+}
+
+func validate_required_Object_Notifications(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+// validate_Object_Notifications_Channel function validates a JSON for a given object.
+func validate_Object_Notifications_Channel(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Notifications_Channel{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Notifications_Channel(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "type":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "address":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "url":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "secret":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Notifications_Channel.
+func (_ *Notifications_Channel) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Notifications_Channel{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Notifications_Channel(ctx, r, path)
+}
+
+func validate_required_Object_Notifications_Channel(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	switch runtime1.DiscriminatorValue(v["type"]) {
+	case "email":
+		if _, ok := v["address"]; runtime1.RuleEnabled(ctx, "examplepb.Notifications.Channel.address.required_for_type") && !ok && !marshaled {
+			return fmt.Errorf("field %q is required for type %q at %q", "address", "email", path)
+		}
+	case "webhook":
+		if _, ok := v["url"]; runtime1.RuleEnabled(ctx, "examplepb.Notifications.Channel.url.required_for_type") && !ok && !marshaled {
+			return fmt.Errorf("field %q is required for type %q at %q", "url", "webhook", path)
+		}
+		if _, ok := v["secret"]; runtime1.RuleEnabled(ctx, "examplepb.Notifications.Channel.secret.required_for_type") && !ok && !marshaled {
+			return fmt.Errorf("field %q is required for type %q at %q", "secret", "webhook", path)
+		}
+	}
+	return nil
+}
+
+// validate_Object_Contact function validates a JSON for a given object.
+func validate_Object_Contact(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Contact{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Contact(ctx, v, path); err != nil {
+		return err
+	}
+
+	if runtime1.CountSet(v, "email", "phone") > 1 {
+		return fmt.Errorf("only one of %q may be set", runtime1.JoinPath(path, "method"))
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "email":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			vv := v[k]
+			vvPath := runtime1.JoinPath(path, k)
+			if err = validate_Object_Contact_Email(ctx, vv, vvPath); err != nil {
+				return err
+			}
+		case "phone":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			vv := v[k]
+			vvPath := runtime1.JoinPath(path, k)
+			if err = validate_Object_Contact_Phone(ctx, vv, vvPath); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Contact.
+func (_ *Contact) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Contact{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Contact(ctx, r, path)
+}
+
+func validate_required_Object_Contact(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+// validate_Object_Contact_Email function validates a JSON for a given object.
+func validate_Object_Contact_Email(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Contact_Email{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Contact_Email(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "address":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Contact_Email.
+func (_ *Contact_Email) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Contact_Email{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Contact_Email(ctx, r, path)
+}
+
+func validate_required_Object_Contact_Email(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	if _, ok := v["address"]; runtime1.RuleEnabled(ctx, "examplepb.Contact.Email.address.required") && !ok && !marshaled && (method == "POST") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "address"), method)
+	}
+	return nil
+}
+
+// validate_Object_Contact_Phone function validates a JSON for a given object.
+func validate_Object_Contact_Phone(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Contact_Phone{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Contact_Phone(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "number":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "extension":
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int32"); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Contact_Phone.
+func (_ *Contact_Phone) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Contact_Phone{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Contact_Phone(ctx, r, path)
+}
+
+func validate_required_Object_Contact_Phone(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	if _, ok := v["number"]; runtime1.RuleEnabled(ctx, "examplepb.Contact.Phone.number.required") && !ok && !marshaled && (method == "POST") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "number"), method)
+	}
+	return nil
+}
+
+// validate_Object_ContactInfo function validates a JSON for a given object.
+func validate_Object_ContactInfo(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ContactInfo{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_ContactInfo(ctx, v, path); err != nil {
+		return err
+	}
+
+	if runtime1.RuleEnabled(ctx, "examplepb.ContactInfo.name.mutually_exclusive") && runtime1.CountSet(v, "name", "company") > 1 {
+		return fmt.Errorf("fields %v are mutually exclusive", []string{runtime1.JoinPath(path, "name"), runtime1.JoinPath(path, "company")})
+	}
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "name":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "phone":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "email":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "company":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "address":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if runtime1.RuleEnabled(ctx, "examplepb.ContactInfo.address.non_nullable") && string(v[k]) == "null" {
+				return fmt.Errorf("field %q may not be null", runtime1.JoinPath(path, k))
+			}
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			vv := v[k]
+			vvPath := runtime1.JoinPath(path, k)
+			if err = validate_Object_Address(ctx, vv, vvPath); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object ContactInfo.
+func (_ *ContactInfo) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&ContactInfo{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_ContactInfo(ctx, r, path)
+}
+
+func validate_required_Object_ContactInfo(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	if runtime1.RuleEnabled(ctx, "examplepb.ContactInfo.phone.at_least_one_of") && v["phone"] == nil && v["email"] == nil && !marshaled && (method == "POST" || method == "PUT") {
+		return fmt.Errorf("at least one of %v is required", []string{"phone", "email"})
+	}
+	return nil
+}
+
+// validate_Object_Settings function validates a JSON for a given object.
+func validate_Object_Settings(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&Settings{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_Settings(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "theme":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "time_zone":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "update_mask":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateWKT(v[k], runtime1.JoinPath(path, k), "field_mask"); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object Settings.
+func (_ *Settings) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&Settings{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_Settings(ctx, r, path)
+}
+
+func validate_required_Object_Settings(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	var mask runtime1.UpdateMask
+	if method == "PATCH" {
+		mask = runtime1.ParseUpdateMask(v["update_mask"])
+	}
+	if _, ok := v["theme"]; runtime1.RuleEnabled(ctx, "examplepb.Settings.theme.required") && !ok && !marshaled && !mask.Excludes("theme") && (method == "PATCH" || method == "POST") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "theme"), method)
+	}
+	if _, ok := v["time_zone"]; runtime1.RuleEnabled(ctx, "examplepb.Settings.time_zone.required") && !ok && !marshaled && !mask.Excludes("time_zone", "timeZone") && (method == "PATCH" || method == "PUT") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "time_zone"), method)
+	}
+	return nil
+}
+
+// validate_Object_CreateUserRequest function validates a JSON for a given object.
+func validate_Object_CreateUserRequest(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&CreateUserRequest{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_CreateUserRequest(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "payload":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			vv := v[k]
+			vvPath := runtime1.JoinPath(path, k)
+			if err = validate_Object_User(ctx, vv, vvPath); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
 			if !allowUnknown {
 				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
 			}
@@ -485,7 +3653,11 @@ func (_ *CreateUserRequest) AtlasValidateJSON(ctx context.Context, r json.RawMes
 	if hook, ok := interface{}(&CreateUserRequest{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -495,15 +3667,52 @@ func (_ *CreateUserRequest) AtlasValidateJSON(ctx context.Context, r json.RawMes
 func validate_required_Object_CreateUserRequest(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
 	return nil
 }
 
+// default_Object_CreateUserRequest function injects default values of absent fields into a JSON for a given object.
+func default_Object_CreateUserRequest(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(r, &v); err != nil || v == nil {
+		return r, nil
+	}
+
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	changed := false
+
+	if vv, ok := v["payload"]; ok {
+		vvPath := runtime1.JoinPath(path, "payload")
+		nv, err := default_Object_User(ctx, vv, vvPath)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(nv, vv) {
+			v["payload"], changed = nv, true
+		}
+	}
+
+	if !changed {
+		return r, nil
+	}
+	return json.Marshal(v)
+}
+
 // validate_Object_UpdateUserRequest function validates a JSON for a given object.
 func validate_Object_UpdateUserRequest(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&UpdateUserRequest{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -512,6 +3721,9 @@ func validate_Object_UpdateUserRequest(ctx context.Context, r json.RawMessage, p
 	if err = json.Unmarshal(r, &v); err != nil {
 		return fmt.Errorf("invalid value for %q: expected object.", path)
 	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
 
 	if err = validate_required_Object_UpdateUserRequest(ctx, v, path); err != nil {
 		return err
@@ -522,15 +3734,338 @@ func validate_Object_UpdateUserRequest(ctx context.Context, r json.RawMessage, p
 	for k, _ := range v {
 		switch k {
 		case "payload":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			vv := v[k]
+			vvPath := runtime1.JoinPath(path, k)
+			if err = validate_Object_User(ctx, vv, vvPath); err != nil {
+				return err
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object UpdateUserRequest.
+func (_ *UpdateUserRequest) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&UpdateUserRequest{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_UpdateUserRequest(ctx, r, path)
+}
+
+func validate_required_Object_UpdateUserRequest(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+// default_Object_UpdateUserRequest function injects default values of absent fields into a JSON for a given object.
+func default_Object_UpdateUserRequest(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(r, &v); err != nil || v == nil {
+		return r, nil
+	}
+
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	changed := false
+
+	if vv, ok := v["payload"]; ok {
+		vvPath := runtime1.JoinPath(path, "payload")
+		nv, err := default_Object_User(ctx, vv, vvPath)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(nv, vv) {
+			v["payload"], changed = nv, true
+		}
+	}
+
+	if !changed {
+		return r, nil
+	}
+	return json.Marshal(v)
+}
+
+// validate_Query_Object_UpdateUserRequest function validates a query parameter for a given object.
+func validate_Query_Object_UpdateUserRequest(ctx context.Context, fieldPath []string, values []string, key string) error {
+	switch fieldPath[0] {
+	case "payload":
+		if len(fieldPath) == 1 {
+			return runtime1.QueryParameterError(ctx, key, ": expected a nested field.")
+		}
+		return validate_Query_Object_User(ctx, fieldPath[1:], values, key)
+	}
+	return runtime1.ValidateUnknownQueryParameter(ctx, key)
+}
+
+// validate_Object_EmptyRequest function validates a JSON for a given object.
+func validate_Object_EmptyRequest(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&EmptyRequest{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_EmptyRequest(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if !allowUnknown {
+				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+			}
+		}
+	}
+	return nil
+}
+
+// AtlasValidateJSON function validates a JSON for object EmptyRequest.
+func (_ *EmptyRequest) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&EmptyRequest{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_EmptyRequest(ctx, r, path)
+}
+
+func validate_required_Object_EmptyRequest(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	return nil
+}
+
+var regexp_examplepb_ListUsersRequest_filter = regexp.MustCompile("^[a-z_]+(==|!=).+$")
+
+// validate_Object_ListUsersRequest function validates a JSON for a given object.
+func validate_Object_ListUsersRequest(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ListUsersRequest{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if err = validate_required_Object_ListUsersRequest(ctx, v, path); err != nil {
+		return err
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for k, _ := range v {
+		switch k {
+		case "page_size":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int32"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMin(v[k], runtime1.JoinPath(path, k), "int32", 1); runtime1.RuleEnabled(ctx, "examplepb.ListUsersRequest.page_size.min") && err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMax(v[k], runtime1.JoinPath(path, k), "int32", 100); runtime1.RuleEnabled(ctx, "examplepb.ListUsersRequest.page_size.max") && err != nil {
+				return err
+			}
+		case "filter":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidatePattern(v[k], runtime1.JoinPath(path, k), regexp_examplepb_ListUsersRequest_filter); runtime1.RuleEnabled(ctx, "examplepb.ListUsersRequest.filter.pattern") && err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMaxLength(v[k], runtime1.JoinPath(path, k), 32, "string"); runtime1.RuleEnabled(ctx, "examplepb.ListUsersRequest.filter.max_length") && err != nil {
+				return err
+			}
+		case "ids":
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateNotBoolean(vv, runtime1.JoinIndex(vArrPath, i)); err != nil {
+					return err
+				}
+				if err = runtime1.ValidateScalar(vv, runtime1.JoinIndex(vArrPath, i), "int64"); err != nil {
+					return err
+				}
+			}
+		case "created_before":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateWKT(v[k], runtime1.JoinPath(path, k), "timestamp"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMaxFutureSkew(v[k], runtime1.JoinPath(path, k), time.Duration(3600000000000)); runtime1.RuleEnabled(ctx, "examplepb.ListUsersRequest.created_before.max_future_skew") && err != nil {
+				return err
+			}
+		case "address":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			vv := v[k]
+			vvPath := runtime1.JoinPath(path, k)
+			if err = validate_Object_Address(ctx, vv, vvPath); err != nil {
+				return err
+			}
+		case "active":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "bool"); err != nil {
+				return err
+			}
+		case "statuses":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateEnum(vv, runtime1.JoinIndex(vArrPath, i), validate_Enum_Status); err != nil {
+					return err
+				}
+			}
+		case "ranks":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vMap map[string]json.RawMessage
+			vMapPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vMap); err != nil {
+				return fmt.Errorf("invalid value for %q: expected map.", vMapPath)
+			}
+			if err = runtime1.ValidateUniqueKeys(v[k], vMapPath, runtime1.JoinPath); err != nil {
+				return err
+			}
+			for kk, vv := range vMap {
+				vvPath := runtime1.JoinPath(vMapPath, kk)
+				if err = runtime1.ValidateMapKey(kk, vvPath, "int32"); err != nil {
+					return err
+				}
+				if err = runtime1.ValidateEnum(vv, vvPath, validate_Enum_Status); err != nil {
+					return err
+				}
+			}
+		case "addresses":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
 			if v[k] == nil {
 				continue
 			}
-			vv := v[k]
-			vvPath := runtime1.JoinPath(path, k)
-			if err = validate_Object_User(ctx, vv, vvPath); err != nil {
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
 				return err
 			}
+			for i, vv := range vArr {
+				vvPath := runtime1.JoinIndex(vArrPath, i)
+				if err = validate_Object_Address(ctx, vv, vvPath); err != nil {
+					return err
+				}
+			}
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
 			if !allowUnknown {
 				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
 			}
@@ -539,80 +4074,131 @@ func validate_Object_UpdateUserRequest(ctx context.Context, r json.RawMessage, p
 	return nil
 }
 
-// AtlasValidateJSON function validates a JSON for object UpdateUserRequest.
-func (_ *UpdateUserRequest) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
-	if hook, ok := interface{}(&UpdateUserRequest{}).(interface {
+// AtlasValidateJSON function validates a JSON for object ListUsersRequest.
+func (_ *ListUsersRequest) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&ListUsersRequest{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
-	return validate_Object_UpdateUserRequest(ctx, r, path)
+	return validate_Object_ListUsersRequest(ctx, r, path)
 }
 
-func validate_required_Object_UpdateUserRequest(ctx context.Context, v map[string]json.RawMessage, path string) error {
+func validate_required_Object_ListUsersRequest(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
 	return nil
 }
 
-// validate_Object_EmptyRequest function validates a JSON for a given object.
-func validate_Object_EmptyRequest(ctx context.Context, r json.RawMessage, path string) (err error) {
-	if hook, ok := interface{}(&EmptyRequest{}).(interface {
-		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
-	}); ok {
-		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
-			return err
-		}
-	}
-
+// default_Object_ListUsersRequest function injects default values of absent fields into a JSON for a given object.
+func default_Object_ListUsersRequest(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
 	var v map[string]json.RawMessage
-	if err = json.Unmarshal(r, &v); err != nil {
-		return fmt.Errorf("invalid value for %q: expected object.", path)
-	}
-
-	if err = validate_required_Object_EmptyRequest(ctx, v, path); err != nil {
-		return err
+	if err := json.Unmarshal(r, &v); err != nil || v == nil {
+		return r, nil
 	}
 
-	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	changed := false
 
-	for k, _ := range v {
-		switch k {
-		default:
-			if !allowUnknown {
-				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
-			}
+	if vv, ok := v["statuses"]; ok {
+		if nv, ok := runtime1.CanonicalEnum(vv, validate_Enum_Status); ok {
+			v["statuses"], changed = nv, true
 		}
 	}
-	return nil
+	if vv, ok := v["ranks"]; ok {
+		if nv, ok := runtime1.CanonicalEnum(vv, validate_Enum_Status); ok {
+			v["ranks"], changed = nv, true
+		}
+	}
+
+	if !changed {
+		return r, nil
+	}
+	return json.Marshal(v)
 }
 
-// AtlasValidateJSON function validates a JSON for object EmptyRequest.
-func (_ *EmptyRequest) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
-	if hook, ok := interface{}(&EmptyRequest{}).(interface {
-		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
-	}); ok {
-		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+// validate_Query_Object_ListUsersRequest function validates a query parameter for a given object.
+func validate_Query_Object_ListUsersRequest(ctx context.Context, fieldPath []string, values []string, key string) error {
+	switch fieldPath[0] {
+	case "page_size", "pageSize":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "int32", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidateMin(r, path, "int32", 1); runtime1.RuleEnabled(ctx, "examplepb.ListUsersRequest.page_size.min") && err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMax(r, path, "int32", 100); runtime1.RuleEnabled(ctx, "examplepb.ListUsersRequest.page_size.max") && err != nil {
+				return err
+			}
+			return nil
+		})
+	case "filter":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidatePattern(r, path, regexp_examplepb_ListUsersRequest_filter); runtime1.RuleEnabled(ctx, "examplepb.ListUsersRequest.filter.pattern") && err != nil {
+				return err
+			}
+			if err = runtime1.ValidateMaxLength(r, path, 32, "string"); runtime1.RuleEnabled(ctx, "examplepb.ListUsersRequest.filter.max_length") && err != nil {
+				return err
+			}
+			return nil
+		})
+	case "ids":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "int64", true)
+	case "created_before", "createdBefore":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "timestamp", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryMaxFutureSkew(ctx, key, values, time.Duration(3600000000000))
+	case "address":
+		if len(fieldPath) == 1 {
+			return runtime1.QueryParameterError(ctx, key, ": expected a nested field.")
+		}
+		return validate_Query_Object_Address(ctx, fieldPath[1:], values, key)
+	case "active":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "bool", false)
+	case "statuses":
+		return runtime1.ValidateQueryEnum(ctx, key, fieldPath, values, true, func(r json.RawMessage) error {
+			return runtime1.ValidateEnum(r, key, validate_Enum_Status)
+		})
+	case "ranks":
+		if err := runtime1.ValidateQueryMapEntry(ctx, key, fieldPath, values, "int32", ""); err != nil {
 			return err
 		}
+		return runtime1.ValidateQueryEnum(ctx, key, fieldPath, values[1:], false, func(r json.RawMessage) error {
+			return runtime1.ValidateEnum(r, key, validate_Enum_Status)
+		})
+	case "addresses":
+		return nil
 	}
-	return validate_Object_EmptyRequest(ctx, r, path)
-}
-
-func validate_required_Object_EmptyRequest(ctx context.Context, v map[string]json.RawMessage, path string) error {
-	method := runtime1.HTTPMethodFromContext(ctx)
-	_ = method
-	return nil
+	return runtime1.ValidateUnknownQueryParameter(ctx, key)
 }
 
 // validate_Object_EmptyResponse function validates a JSON for a given object.
 func validate_Object_EmptyResponse(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&EmptyResponse{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -621,6 +4207,9 @@ func validate_Object_EmptyResponse(ctx context.Context, r json.RawMessage, path
 	if err = json.Unmarshal(r, &v); err != nil {
 		return fmt.Errorf("invalid value for %q: expected object.", path)
 	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
 
 	if err = validate_required_Object_EmptyResponse(ctx, v, path); err != nil {
 		return err
@@ -631,6 +4220,12 @@ func validate_Object_EmptyResponse(ctx context.Context, r json.RawMessage, path
 	for k, _ := range v {
 		switch k {
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
 			if !allowUnknown {
 				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
 			}
@@ -644,7 +4239,11 @@ func (_ *EmptyResponse) AtlasValidateJSON(ctx context.Context, r json.RawMessage
 	if hook, ok := interface{}(&EmptyResponse{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -654,15 +4253,26 @@ func (_ *EmptyResponse) AtlasValidateJSON(ctx context.Context, r json.RawMessage
 func validate_required_Object_EmptyResponse(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
 	return nil
 }
 
+var regexp_examplepb_Profile_email = regexp.MustCompile("^[^@]+@[^@]+$")
+
 // validate_Object_Profile function validates a JSON for a given object.
 func validate_Object_Profile(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&Profile{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -671,6 +4281,9 @@ func validate_Object_Profile(ctx context.Context, r json.RawMessage, path string
 	if err = json.Unmarshal(r, &v); err != nil {
 		return fmt.Errorf("invalid value for %q: expected object.", path)
 	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
 
 	if err = validate_required_Object_Profile(ctx, v, path); err != nil {
 		return err
@@ -681,13 +4294,148 @@ func validate_Object_Profile(ctx context.Context, r json.RawMessage, path string
 	for k, _ := range v {
 		switch k {
 		case "id":
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int32"); err != nil {
+				return err
+			}
 		case "name":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
 			method := runtime1.HTTPMethodFromContext(ctx)
-			if method == "PATCH" || method == "PUT" {
-				return fmt.Errorf("field %q is unsupported for %q operation.", k, method)
+			if runtime1.RuleEnabled(ctx, "examplepb.Profile.name.deny") && (method == "PATCH" || method == "PUT") {
+				return fmt.Errorf("field %q is unsupported for %q operation; allowed: [LINK, POST, SEARCH].", k, method)
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
 			}
 		case "notes":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "status":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.ValidateEnum(v[k], runtime1.JoinPath(path, k), validate_Enum_Status); err != nil {
+				return err
+			}
+		case "roles":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateEnum(vv, runtime1.JoinIndex(vArrPath, i), validate_Enum_Role); err != nil {
+					return err
+				}
+			}
+		case "statuses":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vMap map[string]json.RawMessage
+			vMapPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vMap); err != nil {
+				return fmt.Errorf("invalid value for %q: expected map.", vMapPath)
+			}
+			if err = runtime1.ValidateUniqueKeys(v[k], vMapPath, runtime1.JoinPath); err != nil {
+				return err
+			}
+			for kk, vv := range vMap {
+				vvPath := runtime1.JoinPath(vMapPath, kk)
+				if err = runtime1.ValidateEnum(vv, vvPath, validate_Enum_Status); err != nil {
+					return err
+				}
+			}
+		case "digest_schedule":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateFormat(v[k], runtime1.JoinPath(path, k), "cron"); runtime1.RuleEnabled(ctx, "examplepb.Profile.digest_schedule.format") && err != nil {
+				return err
+			}
+		case "reminders":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if v[k] == nil || string(v[k]) == "null" {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPath(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				return fmt.Errorf("invalid value for %q: expected array.", vArrPath)
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				return err
+			}
+			for i, vv := range vArr {
+				if err = runtime1.ValidateScalar(vv, runtime1.JoinIndex(vArrPath, i), "string"); err != nil {
+					return err
+				}
+				if err = runtime1.ValidateFormat(vv, runtime1.JoinIndex(vArrPath, i), "cron_seconds"); runtime1.RuleEnabled(ctx, "examplepb.Profile.reminders.format") && err != nil {
+					return err
+				}
+			}
+		case "color":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateFormat(v[k], runtime1.JoinPath(path, k), "hex_color"); runtime1.RuleEnabled(ctx, "examplepb.Profile.color.format") && err != nil {
+				return err
+			}
+		case "device_mac":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateFormat(v[k], runtime1.JoinPath(path, k), "mac"); runtime1.RuleEnabled(ctx, "examplepb.Profile.device_mac.format") && err != nil {
+				return err
+			}
+		case "email":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+			if err = runtime1.ValidatePattern(v[k], runtime1.JoinPath(path, k), regexp_examplepb_Profile_email); runtime1.RuleEnabled(ctx, "examplepb.Profile.email.pattern") && err != nil {
+				return err
+			}
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
 			if !allowUnknown {
 				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
 			}
@@ -701,7 +4449,11 @@ func (_ *Profile) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path
 	if hook, ok := interface{}(&Profile{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -711,15 +4463,134 @@ func (_ *Profile) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path
 func validate_required_Object_Profile(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
 	return nil
 }
 
+// default_Object_Profile function injects default values of absent fields into a JSON for a given object.
+func default_Object_Profile(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(r, &v); err != nil || v == nil {
+		return r, nil
+	}
+
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	changed := false
+
+	if _, ok := v["notes"]; !ok {
+		v["notes"] = json.RawMessage("\"n/a\"")
+		changed = true
+	}
+	if vv, ok := v["status"]; ok {
+		if nv, ok := runtime1.CanonicalEnum(vv, validate_Enum_Status); ok {
+			v["status"], changed = nv, true
+		}
+	}
+	if vv, ok := v["statuses"]; ok {
+		if nv, ok := runtime1.CanonicalEnum(vv, validate_Enum_Status); ok {
+			v["statuses"], changed = nv, true
+		}
+	}
+
+	if !changed {
+		return r, nil
+	}
+	return json.Marshal(v)
+}
+
+// validate_Query_Object_Profile function validates a query parameter for a given object.
+func validate_Query_Object_Profile(ctx context.Context, fieldPath []string, values []string, key string) error {
+	switch fieldPath[0] {
+	case "id":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "int32", false)
+	case "name":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "notes":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "status":
+		return runtime1.ValidateQueryEnum(ctx, key, fieldPath, values, false, func(r json.RawMessage) error {
+			return runtime1.ValidateEnum(r, key, validate_Enum_Status)
+		})
+	case "roles":
+		return runtime1.ValidateQueryEnum(ctx, key, fieldPath, values, true, func(r json.RawMessage) error {
+			return runtime1.ValidateEnum(r, key, validate_Enum_Role)
+		})
+	case "statuses":
+		if err := runtime1.ValidateQueryMapEntry(ctx, key, fieldPath, values, "", ""); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryEnum(ctx, key, fieldPath, values[1:], false, func(r json.RawMessage) error {
+			return runtime1.ValidateEnum(r, key, validate_Enum_Status)
+		})
+	case "digest_schedule", "digestSchedule":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidateFormat(r, path, "cron"); runtime1.RuleEnabled(ctx, "examplepb.Profile.digest_schedule.format") && err != nil {
+				return err
+			}
+			return nil
+		})
+	case "reminders":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", true); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidateFormat(r, path, "cron_seconds"); runtime1.RuleEnabled(ctx, "examplepb.Profile.reminders.format") && err != nil {
+				return err
+			}
+			return nil
+		})
+	case "color":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidateFormat(r, path, "hex_color"); runtime1.RuleEnabled(ctx, "examplepb.Profile.color.format") && err != nil {
+				return err
+			}
+			return nil
+		})
+	case "device_mac", "deviceMac":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidateFormat(r, path, "mac"); runtime1.RuleEnabled(ctx, "examplepb.Profile.device_mac.format") && err != nil {
+				return err
+			}
+			return nil
+		})
+	case "email":
+		if err := runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false); err != nil {
+			return err
+		}
+		return runtime1.ValidateQueryConstraints(ctx, key, values, func(r json.RawMessage, path string) (err error) {
+			if err = runtime1.ValidatePattern(r, path, regexp_examplepb_Profile_email); runtime1.RuleEnabled(ctx, "examplepb.Profile.email.pattern") && err != nil {
+				return err
+			}
+			return nil
+		})
+	}
+	return runtime1.ValidateUnknownQueryParameter(ctx, key)
+}
+
 // validate_Object_UpdateProfileRequest function validates a JSON for a given object.
 func validate_Object_UpdateProfileRequest(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&UpdateProfileRequest{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -728,6 +4599,9 @@ func validate_Object_UpdateProfileRequest(ctx context.Context, r json.RawMessage
 	if err = json.Unmarshal(r, &v); err != nil {
 		return fmt.Errorf("invalid value for %q: expected object.", path)
 	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
 
 	if err = validate_required_Object_UpdateProfileRequest(ctx, v, path); err != nil {
 		return err
@@ -738,7 +4612,8 @@ func validate_Object_UpdateProfileRequest(ctx context.Context, r json.RawMessage
 	for k, _ := range v {
 		switch k {
 		case "payload":
-			if v[k] == nil {
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
 				continue
 			}
 			vv := v[k]
@@ -747,6 +4622,12 @@ func validate_Object_UpdateProfileRequest(ctx context.Context, r json.RawMessage
 				return err
 			}
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
 			if !allowUnknown {
 				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
 			}
@@ -760,7 +4641,11 @@ func (_ *UpdateProfileRequest) AtlasValidateJSON(ctx context.Context, r json.Raw
 	if hook, ok := interface{}(&UpdateProfileRequest{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -770,5 +4655,35 @@ func (_ *UpdateProfileRequest) AtlasValidateJSON(ctx context.Context, r json.Raw
 func validate_required_Object_UpdateProfileRequest(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
 	return nil
 }
+
+// default_Object_UpdateProfileRequest function injects default values of absent fields into a JSON for a given object.
+func default_Object_UpdateProfileRequest(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(r, &v); err != nil || v == nil {
+		return r, nil
+	}
+
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	changed := false
+
+	if vv, ok := v["payload"]; ok {
+		vvPath := runtime1.JoinPath(path, "payload")
+		nv, err := default_Object_Profile(ctx, vv, vvPath)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(nv, vv) {
+			v["payload"], changed = nv, true
+		}
+	}
+
+	if !changed {
+		return r, nil
+	}
+	return json.Marshal(v)
+}
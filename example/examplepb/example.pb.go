@@ -8,19 +8,33 @@ It is generated from these files:
 	example/examplepb/example.proto
 	example/examplepb/examplepb.proto
 	example/examplepb/example_multi.proto
+	example/examplepb/example_proto2.proto
 
 It has these top-level messages:
 	User
 	Address
 	Group
+	Policy
+	Table
+	Measurement
+	Node
+	RawConfig
+	SearchQuery
+	Schedule
+	Notifications
+	Contact
+	ContactInfo
+	Settings
 	CreateUserRequest
 	UpdateUserRequest
 	EmptyRequest
+	ListUsersRequest
 	EmptyResponse
 	Profile
 	UpdateProfileRequest
 	User2
 	EmptyResponse2
+	Credentials
 */
 package examplepb
 
@@ -32,6 +46,9 @@ import google_protobuf1 "github.com/golang/protobuf/ptypes/timestamp"
 import google_protobuf2 "github.com/golang/protobuf/ptypes/empty"
 import google_protobuf3 "github.com/golang/protobuf/ptypes/any"
 import google_protobuf4 "github.com/golang/protobuf/ptypes/wrappers"
+import google_protobuf5 "github.com/golang/protobuf/ptypes/duration"
+import google_protobuf6 "github.com/golang/protobuf/ptypes/struct"
+import google_protobuf7 "google.golang.org/genproto/protobuf/field_mask"
 import _ "github.com/infobloxopen/protoc-gen-atlas-validate/options"
 import external "github.com/infobloxopen/protoc-gen-atlas-validate/example/external"
 
@@ -51,180 +68,1048 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Status int32
+
+const (
+	Status_STATUS_UNKNOWN  Status = 0
+	Status_STATUS_ACTIVE   Status = 1
+	Status_STATUS_INACTIVE Status = 2
+)
+
+var Status_name = map[int32]string{
+	0: "STATUS_UNKNOWN",
+	1: "STATUS_ACTIVE",
+	2: "STATUS_INACTIVE",
+}
+var Status_value = map[string]int32{
+	"STATUS_UNKNOWN":  0,
+	"STATUS_ACTIVE":   1,
+	"STATUS_INACTIVE": 2,
+}
+
+func (x Status) String() string {
+	return proto.EnumName(Status_name, int32(x))
+}
+func (Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED Role = 0
+	Role_ROLE_ADMIN       Role = 1
+	Role_ROLE_MEMBER      Role = 2
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_ADMIN",
+	2: "ROLE_MEMBER",
+}
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED": 0,
+	"ROLE_ADMIN":       1,
+	"ROLE_MEMBER":      2,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+func (Role) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+type Policy_Tier int32
+
+const (
+	Policy_TIER_FREE Policy_Tier = 0
+	Policy_TIER_PRO  Policy_Tier = 1
+)
+
+var Policy_Tier_name = map[int32]string{
+	0: "TIER_FREE",
+	1: "TIER_PRO",
+}
+var Policy_Tier_value = map[string]int32{
+	"TIER_FREE": 0,
+	"TIER_PRO":  1,
+}
+
+func (x Policy_Tier) String() string {
+	return proto.EnumName(Policy_Tier_name, int32(x))
+}
+func (Policy_Tier) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3, 0} }
+
 type User struct {
-	Id           int32                       `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	Name         string                      `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	Profile      *Profile                    `protobuf:"bytes,3,opt,name=profile" json:"profile,omitempty"`
-	Address      *Address                    `protobuf:"bytes,4,opt,name=address" json:"address,omitempty"`
-	Groups       []*Group                    `protobuf:"bytes,5,rep,name=groups" json:"groups,omitempty"`
-	Parents      []*User_Parent              `protobuf:"bytes,6,rep,name=parents" json:"parents,omitempty"`
-	ExternalUser *external.ExternalUser      `protobuf:"bytes,7,opt,name=external_user,json=externalUser" json:"external_user,omitempty"`
-	EmptyList    []*google_protobuf2.Empty   `protobuf:"bytes,8,rep,name=empty_list,json=emptyList" json:"empty_list,omitempty"`
-	Timestamp    *google_protobuf1.Timestamp `protobuf:"bytes,9,opt,name=timestamp" json:"timestamp,omitempty"`
+	Id               int32                             `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name             string                            `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Profile          *Profile                          `protobuf:"bytes,3,opt,name=profile" json:"profile,omitempty"`
+	Address          *Address                          `protobuf:"bytes,4,opt,name=address" json:"address,omitempty"`
+	Groups           []*Group                          `protobuf:"bytes,5,rep,name=groups" json:"groups,omitempty"`
+	Parents          []*User_Parent                    `protobuf:"bytes,6,rep,name=parents" json:"parents,omitempty"`
+	ExternalUser     *external.ExternalUser            `protobuf:"bytes,7,opt,name=external_user,json=externalUser" json:"external_user,omitempty"`
+	EmptyList        []*google_protobuf2.Empty         `protobuf:"bytes,8,rep,name=empty_list,json=emptyList" json:"empty_list,omitempty"`
+	Timestamp        *google_protobuf1.Timestamp       `protobuf:"bytes,9,opt,name=timestamp" json:"timestamp,omitempty"`
+	PrimaryGroup     *Group                            `protobuf:"bytes,10,opt,name=primary_group,json=primaryGroup" json:"primary_group,omitempty"`
+	ExternalContacts map[string]*external.ExternalUser `protobuf:"bytes,11,rep,name=external_contacts,json=externalContacts" json:"external_contacts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalKind     external.Kind                     `protobuf:"varint,12,opt,name=external_kind,json=externalKind,enum=external.Kind" json:"external_kind,omitempty"`
+	ExternalRoles    []external.ExternalUser_Role      `protobuf:"varint,13,rep,packed,name=external_roles,json=externalRoles,enum=external.ExternalUser_Role" json:"external_roles,omitempty"`
+	ExternalKinds    map[string]external.Kind          `protobuf:"bytes,14,rep,name=external_kinds,json=externalKinds" json:"external_kinds,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=external.Kind"`
+	CreatedBy        string                            `protobuf:"bytes,15,opt,name=created_by,json=createdBy" json:"created_by,omitempty"`
+}
+
+func (m *User) Reset()                    { *m = User{} }
+func (m *User) String() string            { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()               {}
+func (*User) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *User) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *User) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *User) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *User) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *User) GetGroups() []*Group {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *User) GetParents() []*User_Parent {
+	if m != nil {
+		return m.Parents
+	}
+	return nil
+}
+
+func (m *User) GetExternalUser() *external.ExternalUser {
+	if m != nil {
+		return m.ExternalUser
+	}
+	return nil
+}
+
+func (m *User) GetEmptyList() []*google_protobuf2.Empty {
+	if m != nil {
+		return m.EmptyList
+	}
+	return nil
+}
+
+func (m *User) GetTimestamp() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *User) GetPrimaryGroup() *Group {
+	if m != nil {
+		return m.PrimaryGroup
+	}
+	return nil
+}
+
+func (m *User) GetExternalContacts() map[string]*external.ExternalUser {
+	if m != nil {
+		return m.ExternalContacts
+	}
+	return nil
+}
+
+func (m *User) GetExternalKind() external.Kind {
+	if m != nil {
+		return m.ExternalKind
+	}
+	return external.Kind_KIND_PERSON
+}
+
+func (m *User) GetExternalRoles() []external.ExternalUser_Role {
+	if m != nil {
+		return m.ExternalRoles
+	}
+	return nil
+}
+
+func (m *User) GetExternalKinds() map[string]external.Kind {
+	if m != nil {
+		return m.ExternalKinds
+	}
+	return nil
+}
+
+func (m *User) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+type User_Parent struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *User_Parent) Reset()                    { *m = User_Parent{} }
+func (m *User_Parent) String() string            { return proto.CompactTextString(m) }
+func (*User_Parent) ProtoMessage()               {}
+func (*User_Parent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+func (m *User_Parent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type Address struct {
+	Country   string            `protobuf:"bytes,1,opt,name=country" json:"country,omitempty"`
+	State     string            `protobuf:"bytes,2,opt,name=state" json:"state,omitempty"`
+	City      string            `protobuf:"bytes,3,opt,name=city" json:"city,omitempty"`
+	Zip       string            `protobuf:"bytes,4,opt,name=zip" json:"zip,omitempty"`
+	Region    string            `protobuf:"bytes,5,opt,name=region" json:"region,omitempty"`
+	Languages []string          `protobuf:"bytes,6,rep,name=languages" json:"languages,omitempty"`
+	Tags      map[string]string `protobuf:"bytes,10,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *Address) Reset()                    { *m = Address{} }
+func (m *Address) String() string            { return proto.CompactTextString(m) }
+func (*Address) ProtoMessage()               {}
+func (*Address) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Address) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *Address) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Address) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
+}
+
+func (m *Address) GetZip() string {
+	if m != nil {
+		return m.Zip
+	}
+	return ""
+}
+
+func (m *Address) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *Address) GetLanguages() []string {
+	if m != nil {
+		return m.Languages
+	}
+	return nil
+}
+
+func (m *Address) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type Group struct {
+	Id     int32    `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name   string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Notes  string   `protobuf:"bytes,3,opt,name=notes" json:"notes,omitempty"`
+	Tags   []string `protobuf:"bytes,4,rep,name=tags" json:"tags,omitempty"`
+	Avatar []byte   `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty"`
+}
+
+func (m *Group) Reset()                    { *m = Group{} }
+func (m *Group) String() string            { return proto.CompactTextString(m) }
+func (*Group) ProtoMessage()               {}
+func (*Group) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Group) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Group) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Group) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *Group) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Group) GetAvatar() []byte {
+	if m != nil {
+		return m.Avatar
+	}
+	return nil
+}
+
+type Policy struct {
+	Rules []*Policy_Rule `protobuf:"bytes,1,rep,name=rules" json:"rules,omitempty"`
+}
+
+func (m *Policy) Reset()                    { *m = Policy{} }
+func (m *Policy) String() string            { return proto.CompactTextString(m) }
+func (*Policy) ProtoMessage()               {}
+func (*Policy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Policy) GetRules() []*Policy_Rule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type Policy_Rule struct {
+	Labels     map[string]string                 `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Groups     map[int32]*Group                  `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tiers      map[string]Policy_Tier            `protobuf:"bytes,3,rep,name=tiers" json:"tiers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=examplepb.Policy_Tier"`
+	Conditions map[string]*Policy_Rule_Condition `protobuf:"bytes,4,rep,name=conditions" json:"conditions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *Policy_Rule) Reset()                    { *m = Policy_Rule{} }
+func (m *Policy_Rule) String() string            { return proto.CompactTextString(m) }
+func (*Policy_Rule) ProtoMessage()               {}
+func (*Policy_Rule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3, 0} }
+
+func (m *Policy_Rule) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Policy_Rule) GetGroups() map[int32]*Group {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *Policy_Rule) GetTiers() map[string]Policy_Tier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+func (m *Policy_Rule) GetConditions() map[string]*Policy_Rule_Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+type Policy_Rule_Condition struct {
+	Expression string `protobuf:"bytes,1,opt,name=expression" json:"expression,omitempty"`
+}
+
+func (m *Policy_Rule_Condition) Reset()                    { *m = Policy_Rule_Condition{} }
+func (m *Policy_Rule_Condition) String() string            { return proto.CompactTextString(m) }
+func (*Policy_Rule_Condition) ProtoMessage()               {}
+func (*Policy_Rule_Condition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3, 0, 4} }
+
+func (m *Policy_Rule_Condition) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+type Table struct {
+	Rows []*Table_Row `protobuf:"bytes,1,rep,name=rows" json:"rows,omitempty"`
+}
+
+func (m *Table) Reset()                    { *m = Table{} }
+func (m *Table) String() string            { return proto.CompactTextString(m) }
+func (*Table) ProtoMessage()               {}
+func (*Table) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Table) GetRows() []*Table_Row {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+type Table_Cell struct {
+	Value string `protobuf:"bytes,1,opt,name=value" json:"value,omitempty"`
+	Span  int32  `protobuf:"varint,2,opt,name=span" json:"span,omitempty"`
+}
+
+func (m *Table_Cell) Reset()                    { *m = Table_Cell{} }
+func (m *Table_Cell) String() string            { return proto.CompactTextString(m) }
+func (*Table_Cell) ProtoMessage()               {}
+func (*Table_Cell) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4, 0} }
+
+func (m *Table_Cell) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Table_Cell) GetSpan() int32 {
+	if m != nil {
+		return m.Span
+	}
+	return 0
+}
+
+type Table_Row struct {
+	Cells []*Table_Cell `protobuf:"bytes,1,rep,name=cells" json:"cells,omitempty"`
+}
+
+func (m *Table_Row) Reset()                    { *m = Table_Row{} }
+func (m *Table_Row) String() string            { return proto.CompactTextString(m) }
+func (*Table_Row) ProtoMessage()               {}
+func (*Table_Row) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4, 1} }
+
+func (m *Table_Row) GetCells() []*Table_Cell {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type Measurement struct {
+	Age      int32                           `protobuf:"varint,1,opt,name=age" json:"age,omitempty"`
+	Offset   int64                           `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	Size     uint64                          `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+	Weights  []float32                       `protobuf:"fixed32,4,rep,packed,name=weights" json:"weights,omitempty"`
+	Limit    *google_protobuf4.Int32Value    `protobuf:"bytes,5,opt,name=limit" json:"limit,omitempty"`
+	Aliases  []*google_protobuf4.StringValue `protobuf:"bytes,6,rep,name=aliases" json:"aliases,omitempty"`
+	Active   *google_protobuf4.BoolValue     `protobuf:"bytes,7,opt,name=active" json:"active,omitempty"`
+	Interval *google_protobuf5.Duration      `protobuf:"bytes,8,opt,name=interval" json:"interval,omitempty"`
+	Attrs    *google_protobuf6.Struct        `protobuf:"bytes,9,opt,name=attrs" json:"attrs,omitempty"`
+	Times    []*google_protobuf1.Timestamp   `protobuf:"bytes,10,rep,name=times" json:"times,omitempty"`
+	Verified bool                            `protobuf:"varint,11,opt,name=verified" json:"verified,omitempty"`
+	Unit     string                          `protobuf:"bytes,12,opt,name=unit" json:"unit,omitempty"`
+	Checksum []byte                          `protobuf:"bytes,13,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Ratio    float64                         `protobuf:"fixed64,14,opt,name=ratio" json:"ratio,omitempty"`
+}
+
+func (m *Measurement) Reset()                    { *m = Measurement{} }
+func (m *Measurement) String() string            { return proto.CompactTextString(m) }
+func (*Measurement) ProtoMessage()               {}
+func (*Measurement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *Measurement) GetAge() int32 {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+func (m *Measurement) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Measurement) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Measurement) GetWeights() []float32 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *Measurement) GetLimit() *google_protobuf4.Int32Value {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *Measurement) GetAliases() []*google_protobuf4.StringValue {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *Measurement) GetActive() *google_protobuf4.BoolValue {
+	if m != nil {
+		return m.Active
+	}
+	return nil
+}
+
+func (m *Measurement) GetInterval() *google_protobuf5.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *Measurement) GetAttrs() *google_protobuf6.Struct {
+	if m != nil {
+		return m.Attrs
+	}
+	return nil
+}
+
+func (m *Measurement) GetTimes() []*google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Times
+	}
+	return nil
+}
+
+func (m *Measurement) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *Measurement) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *Measurement) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *Measurement) GetRatio() float64 {
+	if m != nil {
+		return m.Ratio
+	}
+	return 0
+}
+
+type Node struct {
+	Name      string           `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Children  []*Node          `protobuf:"bytes,2,rep,name=children" json:"children,omitempty"`
+	Links     map[string]*Node `protobuf:"bytes,3,rep,name=links" json:"links,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Extension *Node            `protobuf:"bytes,4,opt,name=extension" json:"extension,omitempty"`
+	Plugins   map[string]*Node `protobuf:"bytes,5,rep,name=plugins" json:"plugins,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Spec      *Node            `protobuf:"bytes,6,opt,name=spec" json:"spec,omitempty"`
+}
+
+func (m *Node) Reset()                    { *m = Node{} }
+func (m *Node) String() string            { return proto.CompactTextString(m) }
+func (*Node) ProtoMessage()               {}
+func (*Node) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *Node) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Node) GetChildren() []*Node {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+func (m *Node) GetLinks() map[string]*Node {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *Node) GetExtension() *Node {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *Node) GetPlugins() map[string]*Node {
+	if m != nil {
+		return m.Plugins
+	}
+	return nil
+}
+
+func (m *Node) GetSpec() *Node {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+type RawConfig struct {
+	Version int32 `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *RawConfig) Reset()                    { *m = RawConfig{} }
+func (m *RawConfig) String() string            { return proto.CompactTextString(m) }
+func (*RawConfig) ProtoMessage()               {}
+func (*RawConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *RawConfig) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type SearchQuery struct {
+	Query           string `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
+	Refresh         bool   `protobuf:"varint,2,opt,name=refresh" json:"refresh,omitempty"`
+	Owner           string `protobuf:"bytes,3,opt,name=owner" json:"owner,omitempty"`
+	CreatedBy       string `protobuf:"bytes,4,opt,name=created_by,json=createdBy" json:"created_by,omitempty"`
+	Locale          string `protobuf:"bytes,5,opt,name=locale" json:"locale,omitempty"`
+	RefreshSchedule string `protobuf:"bytes,6,opt,name=refresh_schedule,json=refreshSchedule" json:"refresh_schedule,omitempty"`
+}
+
+func (m *SearchQuery) Reset()                    { *m = SearchQuery{} }
+func (m *SearchQuery) String() string            { return proto.CompactTextString(m) }
+func (*SearchQuery) ProtoMessage()               {}
+func (*SearchQuery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *SearchQuery) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchQuery) GetRefresh() bool {
+	if m != nil {
+		return m.Refresh
+	}
+	return false
+}
+
+func (m *SearchQuery) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *SearchQuery) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+func (m *SearchQuery) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *SearchQuery) GetRefreshSchedule() string {
+	if m != nil {
+		return m.RefreshSchedule
+	}
+	return ""
+}
+
+type Schedule struct {
+	Start      string      `protobuf:"bytes,1,opt,name=start" json:"start,omitempty"`
+	End        string      `protobuf:"bytes,2,opt,name=end" json:"end,omitempty"`
+	Timezone   string      `protobuf:"bytes,3,opt,name=timezone" json:"timezone,omitempty"`
+	Days       []string    `protobuf:"bytes,4,rep,name=days" json:"days,omitempty"`
+	Exceptions []*Schedule `protobuf:"bytes,5,rep,name=exceptions" json:"exceptions,omitempty"`
+}
+
+func (m *Schedule) Reset()                    { *m = Schedule{} }
+func (m *Schedule) String() string            { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()               {}
+func (*Schedule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *Schedule) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *Schedule) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *Schedule) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *Schedule) GetDays() []string {
+	if m != nil {
+		return m.Days
+	}
+	return nil
 }
 
-func (m *User) Reset()                    { *m = User{} }
-func (m *User) String() string            { return proto.CompactTextString(m) }
-func (*User) ProtoMessage()               {}
-func (*User) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (m *Schedule) GetExceptions() []*Schedule {
+	if m != nil {
+		return m.Exceptions
+	}
+	return nil
+}
 
-func (m *User) GetId() int32 {
+type Notifications struct {
+	Channels []*Notifications_Channel `protobuf:"bytes,1,rep,name=channels" json:"channels,omitempty"`
+}
+
+func (m *Notifications) Reset()                    { *m = Notifications{} }
+func (m *Notifications) String() string            { return proto.CompactTextString(m) }
+func (*Notifications) ProtoMessage()               {}
+func (*Notifications) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *Notifications) GetChannels() []*Notifications_Channel {
 	if m != nil {
-		return m.Id
+		return m.Channels
 	}
-	return 0
+	return nil
 }
 
-func (m *User) GetName() string {
+type Notifications_Channel struct {
+	Type    string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	Url     string `protobuf:"bytes,3,opt,name=url" json:"url,omitempty"`
+	Secret  string `protobuf:"bytes,4,opt,name=secret" json:"secret,omitempty"`
+}
+
+func (m *Notifications_Channel) Reset()                    { *m = Notifications_Channel{} }
+func (m *Notifications_Channel) String() string            { return proto.CompactTextString(m) }
+func (*Notifications_Channel) ProtoMessage()               {}
+func (*Notifications_Channel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10, 0} }
+
+func (m *Notifications_Channel) GetType() string {
 	if m != nil {
-		return m.Name
+		return m.Type
 	}
 	return ""
 }
 
-func (m *User) GetProfile() *Profile {
+func (m *Notifications_Channel) GetAddress() string {
 	if m != nil {
-		return m.Profile
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func (m *User) GetAddress() *Address {
+func (m *Notifications_Channel) GetUrl() string {
 	if m != nil {
-		return m.Address
+		return m.Url
 	}
-	return nil
+	return ""
 }
 
-func (m *User) GetGroups() []*Group {
+func (m *Notifications_Channel) GetSecret() string {
 	if m != nil {
-		return m.Groups
+		return m.Secret
 	}
-	return nil
+	return ""
 }
 
-func (m *User) GetParents() []*User_Parent {
+type Contact struct {
+	// Types that are valid to be assigned to Method:
+	//	*Contact_Email_
+	//	*Contact_Phone_
+	Method isContact_Method `protobuf_oneof:"method"`
+}
+
+func (m *Contact) Reset()                    { *m = Contact{} }
+func (m *Contact) String() string            { return proto.CompactTextString(m) }
+func (*Contact) ProtoMessage()               {}
+func (*Contact) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+type isContact_Method interface{ isContact_Method() }
+
+type Contact_Email_ struct {
+	Email *Contact_Email `protobuf:"bytes,1,opt,name=email,oneof"`
+}
+type Contact_Phone_ struct {
+	Phone *Contact_Phone `protobuf:"bytes,2,opt,name=phone,oneof"`
+}
+
+func (*Contact_Email_) isContact_Method() {}
+func (*Contact_Phone_) isContact_Method() {}
+
+func (m *Contact) GetMethod() isContact_Method {
 	if m != nil {
-		return m.Parents
+		return m.Method
 	}
 	return nil
 }
 
-func (m *User) GetExternalUser() *external.ExternalUser {
-	if m != nil {
-		return m.ExternalUser
+func (m *Contact) GetEmail() *Contact_Email {
+	if x, ok := m.GetMethod().(*Contact_Email_); ok {
+		return x.Email
 	}
 	return nil
 }
 
-func (m *User) GetEmptyList() []*google_protobuf2.Empty {
-	if m != nil {
-		return m.EmptyList
+func (m *Contact) GetPhone() *Contact_Phone {
+	if x, ok := m.GetMethod().(*Contact_Phone_); ok {
+		return x.Phone
 	}
 	return nil
 }
 
-func (m *User) GetTimestamp() *google_protobuf1.Timestamp {
-	if m != nil {
-		return m.Timestamp
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Contact) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Contact_OneofMarshaler, _Contact_OneofUnmarshaler, _Contact_OneofSizer, []interface{}{
+		(*Contact_Email_)(nil),
+		(*Contact_Phone_)(nil),
+	}
+}
+
+func _Contact_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Contact)
+	// method
+	switch x := m.Method.(type) {
+	case *Contact_Email_:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Email); err != nil {
+			return err
+		}
+	case *Contact_Phone_:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Phone); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Contact.Method has unexpected type %T", x)
 	}
 	return nil
 }
 
-type User_Parent struct {
-	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+func _Contact_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Contact)
+	switch tag {
+	case 1: // method.email
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Contact_Email)
+		err := b.DecodeMessage(msg)
+		m.Method = &Contact_Email_{msg}
+		return true, err
+	case 2: // method.phone
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Contact_Phone)
+		err := b.DecodeMessage(msg)
+		m.Method = &Contact_Phone_{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Contact_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Contact)
+	// method
+	switch x := m.Method.(type) {
+	case *Contact_Email_:
+		s := proto.Size(x.Email)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Contact_Phone_:
+		s := proto.Size(x.Phone)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Contact_Email struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *Contact_Email) Reset()                    { *m = Contact_Email{} }
+func (m *Contact_Email) String() string            { return proto.CompactTextString(m) }
+func (*Contact_Email) ProtoMessage()               {}
+func (*Contact_Email) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11, 0} }
+
+func (m *Contact_Email) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *User_Parent) Reset()                    { *m = User_Parent{} }
-func (m *User_Parent) String() string            { return proto.CompactTextString(m) }
-func (*User_Parent) ProtoMessage()               {}
-func (*User_Parent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+type Contact_Phone struct {
+	Number    string `protobuf:"bytes,1,opt,name=number" json:"number,omitempty"`
+	Extension int32  `protobuf:"varint,2,opt,name=extension" json:"extension,omitempty"`
+}
 
-func (m *User_Parent) GetName() string {
+func (m *Contact_Phone) Reset()                    { *m = Contact_Phone{} }
+func (m *Contact_Phone) String() string            { return proto.CompactTextString(m) }
+func (*Contact_Phone) ProtoMessage()               {}
+func (*Contact_Phone) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11, 1} }
+
+func (m *Contact_Phone) GetNumber() string {
 	if m != nil {
-		return m.Name
+		return m.Number
 	}
 	return ""
 }
 
-type Address struct {
-	Country string            `protobuf:"bytes,1,opt,name=country" json:"country,omitempty"`
-	State   string            `protobuf:"bytes,2,opt,name=state" json:"state,omitempty"`
-	City    string            `protobuf:"bytes,3,opt,name=city" json:"city,omitempty"`
-	Zip     string            `protobuf:"bytes,4,opt,name=zip" json:"zip,omitempty"`
-	Tags    map[string]string `protobuf:"bytes,10,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (m *Contact_Phone) GetExtension() int32 {
+	if m != nil {
+		return m.Extension
+	}
+	return 0
 }
 
-func (m *Address) Reset()                    { *m = Address{} }
-func (m *Address) String() string            { return proto.CompactTextString(m) }
-func (*Address) ProtoMessage()               {}
-func (*Address) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+type ContactInfo struct {
+	Name    string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Phone   string   `protobuf:"bytes,2,opt,name=phone" json:"phone,omitempty"`
+	Email   string   `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
+	Company string   `protobuf:"bytes,4,opt,name=company" json:"company,omitempty"`
+	Address *Address `protobuf:"bytes,5,opt,name=address" json:"address,omitempty"`
+}
 
-func (m *Address) GetCountry() string {
+func (m *ContactInfo) Reset()                    { *m = ContactInfo{} }
+func (m *ContactInfo) String() string            { return proto.CompactTextString(m) }
+func (*ContactInfo) ProtoMessage()               {}
+func (*ContactInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *ContactInfo) GetName() string {
 	if m != nil {
-		return m.Country
+		return m.Name
 	}
 	return ""
 }
 
-func (m *Address) GetState() string {
+func (m *ContactInfo) GetPhone() string {
 	if m != nil {
-		return m.State
+		return m.Phone
 	}
 	return ""
 }
 
-func (m *Address) GetCity() string {
+func (m *ContactInfo) GetEmail() string {
 	if m != nil {
-		return m.City
+		return m.Email
 	}
 	return ""
 }
 
-func (m *Address) GetZip() string {
+func (m *ContactInfo) GetCompany() string {
 	if m != nil {
-		return m.Zip
+		return m.Company
 	}
 	return ""
 }
 
-func (m *Address) GetTags() map[string]string {
+func (m *ContactInfo) GetAddress() *Address {
 	if m != nil {
-		return m.Tags
+		return m.Address
 	}
 	return nil
 }
 
-type Group struct {
-	Id    int32  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	Name  string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	Notes string `protobuf:"bytes,3,opt,name=notes" json:"notes,omitempty"`
+type Settings struct {
+	Theme      string                      `protobuf:"bytes,1,opt,name=theme" json:"theme,omitempty"`
+	TimeZone   string                      `protobuf:"bytes,2,opt,name=time_zone,json=timeZone" json:"time_zone,omitempty"`
+	UpdateMask *google_protobuf7.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty"`
 }
 
-func (m *Group) Reset()                    { *m = Group{} }
-func (m *Group) String() string            { return proto.CompactTextString(m) }
-func (*Group) ProtoMessage()               {}
-func (*Group) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (m *Settings) Reset()                    { *m = Settings{} }
+func (m *Settings) String() string            { return proto.CompactTextString(m) }
+func (*Settings) ProtoMessage()               {}
+func (*Settings) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
-func (m *Group) GetId() int32 {
+func (m *Settings) GetTheme() string {
 	if m != nil {
-		return m.Id
+		return m.Theme
 	}
-	return 0
+	return ""
 }
 
-func (m *Group) GetName() string {
+func (m *Settings) GetTimeZone() string {
 	if m != nil {
-		return m.Name
+		return m.TimeZone
 	}
 	return ""
 }
 
-func (m *Group) GetNotes() string {
+func (m *Settings) GetUpdateMask() *google_protobuf7.FieldMask {
 	if m != nil {
-		return m.Notes
+		return m.UpdateMask
 	}
-	return ""
+	return nil
 }
 
 type CreateUserRequest struct {
@@ -234,7 +1119,7 @@ type CreateUserRequest struct {
 func (m *CreateUserRequest) Reset()                    { *m = CreateUserRequest{} }
 func (m *CreateUserRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateUserRequest) ProtoMessage()               {}
-func (*CreateUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*CreateUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *CreateUserRequest) GetPayload() *User {
 	if m != nil {
@@ -250,7 +1135,7 @@ type UpdateUserRequest struct {
 func (m *UpdateUserRequest) Reset()                    { *m = UpdateUserRequest{} }
 func (m *UpdateUserRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateUserRequest) ProtoMessage()               {}
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *UpdateUserRequest) GetPayload() *User {
 	if m != nil {
@@ -265,7 +1150,87 @@ type EmptyRequest struct {
 func (m *EmptyRequest) Reset()                    { *m = EmptyRequest{} }
 func (m *EmptyRequest) String() string            { return proto.CompactTextString(m) }
 func (*EmptyRequest) ProtoMessage()               {}
-func (*EmptyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*EmptyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+type ListUsersRequest struct {
+	PageSize      int32                       `protobuf:"varint,1,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	Filter        string                      `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty"`
+	Ids           []int64                     `protobuf:"varint,3,rep,packed,name=ids" json:"ids,omitempty"`
+	CreatedBefore *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=created_before,json=createdBefore" json:"created_before,omitempty"`
+	Address       *Address                    `protobuf:"bytes,5,opt,name=address" json:"address,omitempty"`
+	Active        *google_protobuf4.BoolValue `protobuf:"bytes,6,opt,name=active" json:"active,omitempty"`
+	Statuses      []Status                    `protobuf:"varint,7,rep,packed,name=statuses,enum=examplepb.Status" json:"statuses,omitempty"`
+	Ranks         map[int32]Status            `protobuf:"bytes,8,rep,name=ranks" json:"ranks,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=examplepb.Status"`
+	Addresses     []*Address                  `protobuf:"bytes,9,rep,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *ListUsersRequest) Reset()                    { *m = ListUsersRequest{} }
+func (m *ListUsersRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListUsersRequest) ProtoMessage()               {}
+func (*ListUsersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *ListUsersRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListUsersRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *ListUsersRequest) GetIds() []int64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetCreatedBefore() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetActive() *google_protobuf4.BoolValue {
+	if m != nil {
+		return m.Active
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetStatuses() []Status {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetRanks() map[int32]Status {
+	if m != nil {
+		return m.Ranks
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetAddresses() []*Address {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
 
 type EmptyResponse struct {
 }
@@ -273,18 +1238,26 @@ type EmptyResponse struct {
 func (m *EmptyResponse) Reset()                    { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string            { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()               {}
-func (*EmptyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*EmptyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 type Profile struct {
-	Id    int32  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	Name  string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	Notes string `protobuf:"bytes,3,opt,name=notes" json:"notes,omitempty"`
+	Id             int32             `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name           string            `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Notes          string            `protobuf:"bytes,3,opt,name=notes" json:"notes,omitempty"`
+	Status         Status            `protobuf:"varint,4,opt,name=status,enum=examplepb.Status" json:"status,omitempty"`
+	Roles          []Role            `protobuf:"varint,5,rep,packed,name=roles,enum=examplepb.Role" json:"roles,omitempty"`
+	Statuses       map[string]Status `protobuf:"bytes,6,rep,name=statuses" json:"statuses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=examplepb.Status"`
+	DigestSchedule string            `protobuf:"bytes,7,opt,name=digest_schedule,json=digestSchedule" json:"digest_schedule,omitempty"`
+	Reminders      []string          `protobuf:"bytes,8,rep,name=reminders" json:"reminders,omitempty"`
+	Color          string            `protobuf:"bytes,9,opt,name=color" json:"color,omitempty"`
+	DeviceMac      string            `protobuf:"bytes,10,opt,name=device_mac,json=deviceMac" json:"device_mac,omitempty"`
+	Email          string            `protobuf:"bytes,11,opt,name=email" json:"email,omitempty"`
 }
 
 func (m *Profile) Reset()                    { *m = Profile{} }
 func (m *Profile) String() string            { return proto.CompactTextString(m) }
 func (*Profile) ProtoMessage()               {}
-func (*Profile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*Profile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *Profile) GetId() int32 {
 	if m != nil {
@@ -307,6 +1280,62 @@ func (m *Profile) GetNotes() string {
 	return ""
 }
 
+func (m *Profile) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_STATUS_UNKNOWN
+}
+
+func (m *Profile) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *Profile) GetStatuses() map[string]Status {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *Profile) GetDigestSchedule() string {
+	if m != nil {
+		return m.DigestSchedule
+	}
+	return ""
+}
+
+func (m *Profile) GetReminders() []string {
+	if m != nil {
+		return m.Reminders
+	}
+	return nil
+}
+
+func (m *Profile) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+func (m *Profile) GetDeviceMac() string {
+	if m != nil {
+		return m.DeviceMac
+	}
+	return ""
+}
+
+func (m *Profile) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
 type UpdateProfileRequest struct {
 	Payload *Profile `protobuf:"bytes,1,opt,name=payload" json:"payload,omitempty"`
 }
@@ -314,7 +1343,7 @@ type UpdateProfileRequest struct {
 func (m *UpdateProfileRequest) Reset()                    { *m = UpdateProfileRequest{} }
 func (m *UpdateProfileRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateProfileRequest) ProtoMessage()               {}
-func (*UpdateProfileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *UpdateProfileRequest) GetPayload() *Profile {
 	if m != nil {
@@ -328,12 +1357,34 @@ func init() {
 	proto.RegisterType((*User_Parent)(nil), "examplepb.User.Parent")
 	proto.RegisterType((*Address)(nil), "examplepb.Address")
 	proto.RegisterType((*Group)(nil), "examplepb.Group")
+	proto.RegisterType((*Policy)(nil), "examplepb.Policy")
+	proto.RegisterType((*Policy_Rule)(nil), "examplepb.Policy.Rule")
+	proto.RegisterType((*Policy_Rule_Condition)(nil), "examplepb.Policy.Rule.Condition")
+	proto.RegisterType((*Table)(nil), "examplepb.Table")
+	proto.RegisterType((*Table_Cell)(nil), "examplepb.Table.Cell")
+	proto.RegisterType((*Table_Row)(nil), "examplepb.Table.Row")
+	proto.RegisterType((*Measurement)(nil), "examplepb.Measurement")
+	proto.RegisterType((*Node)(nil), "examplepb.Node")
+	proto.RegisterType((*RawConfig)(nil), "examplepb.RawConfig")
+	proto.RegisterType((*SearchQuery)(nil), "examplepb.SearchQuery")
+	proto.RegisterType((*Schedule)(nil), "examplepb.Schedule")
+	proto.RegisterType((*Notifications)(nil), "examplepb.Notifications")
+	proto.RegisterType((*Notifications_Channel)(nil), "examplepb.Notifications.Channel")
+	proto.RegisterType((*Contact)(nil), "examplepb.Contact")
+	proto.RegisterType((*Contact_Email)(nil), "examplepb.Contact.Email")
+	proto.RegisterType((*Contact_Phone)(nil), "examplepb.Contact.Phone")
+	proto.RegisterType((*ContactInfo)(nil), "examplepb.ContactInfo")
+	proto.RegisterType((*Settings)(nil), "examplepb.Settings")
 	proto.RegisterType((*CreateUserRequest)(nil), "examplepb.CreateUserRequest")
 	proto.RegisterType((*UpdateUserRequest)(nil), "examplepb.UpdateUserRequest")
 	proto.RegisterType((*EmptyRequest)(nil), "examplepb.EmptyRequest")
+	proto.RegisterType((*ListUsersRequest)(nil), "examplepb.ListUsersRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "examplepb.EmptyResponse")
 	proto.RegisterType((*Profile)(nil), "examplepb.Profile")
 	proto.RegisterType((*UpdateProfileRequest)(nil), "examplepb.UpdateProfileRequest")
+	proto.RegisterEnum("examplepb.Status", Status_name, Status_value)
+	proto.RegisterEnum("examplepb.Role", Role_name, Role_value)
+	proto.RegisterEnum("examplepb.Policy_Tier", Policy_Tier_name, Policy_Tier_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -349,7 +1400,10 @@ const _ = grpc.SupportPackageIsVersion4
 type UsersClient interface {
 	Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	Get(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*User, error)
+	Replace(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	List(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	Search(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	UpdateExternalUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*EmptyResponse, error)
 	UpdateExternalUser2(ctx context.Context, in *external.ExternalUser, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
@@ -380,6 +1434,24 @@ func (c *usersClient) Update(ctx context.Context, in *UpdateUserRequest, opts ..
 	return out, nil
 }
 
+func (c *usersClient) Get(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := grpc.Invoke(ctx, "/examplepb.Users/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) Replace(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/examplepb.Users/Replace", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersClient) List(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	out := new(EmptyResponse)
 	err := grpc.Invoke(ctx, "/examplepb.Users/List", in, out, c.cc, opts...)
@@ -389,6 +1461,15 @@ func (c *usersClient) List(ctx context.Context, in *EmptyRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *usersClient) Search(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/examplepb.Users/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersClient) UpdateExternalUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	out := new(EmptyResponse)
 	err := grpc.Invoke(ctx, "/examplepb.Users/UpdateExternalUser", in, out, c.cc, opts...)
@@ -404,55 +1485,94 @@ func (c *usersClient) UpdateExternalUser2(ctx context.Context, in *external.Exte
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// Server API for Users service
-
-type UsersServer interface {
-	Create(context.Context, *CreateUserRequest) (*EmptyResponse, error)
-	Update(context.Context, *UpdateUserRequest) (*EmptyResponse, error)
-	List(context.Context, *EmptyRequest) (*EmptyResponse, error)
-	UpdateExternalUser(context.Context, *User) (*EmptyResponse, error)
-	UpdateExternalUser2(context.Context, *external.ExternalUser) (*EmptyResponse, error)
-}
-
-func RegisterUsersServer(s *grpc.Server, srv UsersServer) {
-	s.RegisterService(&_Users_serviceDesc, srv)
+	return out, nil
+}
+
+// Server API for Users service
+
+type UsersServer interface {
+	Create(context.Context, *CreateUserRequest) (*EmptyResponse, error)
+	Update(context.Context, *UpdateUserRequest) (*EmptyResponse, error)
+	Get(context.Context, *EmptyRequest) (*User, error)
+	Replace(context.Context, *UpdateUserRequest) (*EmptyResponse, error)
+	List(context.Context, *EmptyRequest) (*EmptyResponse, error)
+	Search(context.Context, *ListUsersRequest) (*EmptyResponse, error)
+	UpdateExternalUser(context.Context, *User) (*EmptyResponse, error)
+	UpdateExternalUser2(context.Context, *external.ExternalUser) (*EmptyResponse, error)
+}
+
+func RegisterUsersServer(s *grpc.Server, srv UsersServer) {
+	s.RegisterService(&_Users_serviceDesc, srv)
+}
+
+func _Users_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/examplepb.Users/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Create(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/examplepb.Users/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Update(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _Users_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Create(ctx, in)
+		return srv.(UsersServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/examplepb.Users/Create",
+		FullMethod: "/examplepb.Users/Get",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Create(ctx, req.(*CreateUserRequest))
+		return srv.(UsersServer).Get(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Users_Replace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Update(ctx, in)
+		return srv.(UsersServer).Replace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/examplepb.Users/Update",
+		FullMethod: "/examplepb.Users/Replace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Update(ctx, req.(*UpdateUserRequest))
+		return srv.(UsersServer).Replace(ctx, req.(*UpdateUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -475,6 +1595,24 @@ func _Users_List_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Users_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/examplepb.Users/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Search(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Users_UpdateExternalUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(User)
 	if err := dec(in); err != nil {
@@ -523,10 +1661,22 @@ var _Users_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _Users_Update_Handler,
 		},
+		{
+			MethodName: "Get",
+			Handler:    _Users_Get_Handler,
+		},
+		{
+			MethodName: "Replace",
+			Handler:    _Users_Replace_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _Users_List_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _Users_Search_Handler,
+		},
 		{
 			MethodName: "UpdateExternalUser",
 			Handler:    _Users_UpdateExternalUser_Handler,
@@ -642,6 +1792,7 @@ var _Profiles_serviceDesc = grpc.ServiceDesc{
 type GroupsClient interface {
 	Create(ctx context.Context, in *Group, opts ...grpc.CallOption) (*EmptyResponse, error)
 	Update(ctx context.Context, in *Group, opts ...grpc.CallOption) (*EmptyResponse, error)
+	Search(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	ValidatedList(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	ValidateWKT(ctx context.Context, in *google_protobuf3.Any, opts ...grpc.CallOption) (*google_protobuf4.DoubleValue, error)
 }
@@ -672,6 +1823,15 @@ func (c *groupsClient) Update(ctx context.Context, in *Group, opts ...grpc.CallO
 	return out, nil
 }
 
+func (c *groupsClient) Search(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/examplepb.Groups/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupsClient) ValidatedList(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	out := new(EmptyResponse)
 	err := grpc.Invoke(ctx, "/examplepb.Groups/ValidatedList", in, out, c.cc, opts...)
@@ -695,6 +1855,7 @@ func (c *groupsClient) ValidateWKT(ctx context.Context, in *google_protobuf3.Any
 type GroupsServer interface {
 	Create(context.Context, *Group) (*EmptyResponse, error)
 	Update(context.Context, *Group) (*EmptyResponse, error)
+	Search(context.Context, *ListUsersRequest) (*EmptyResponse, error)
 	ValidatedList(context.Context, *EmptyRequest) (*EmptyResponse, error)
 	ValidateWKT(context.Context, *google_protobuf3.Any) (*google_protobuf4.DoubleValue, error)
 }
@@ -739,6 +1900,24 @@ func _Groups_Update_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Groups_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/examplepb.Groups/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).Search(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Groups_ValidatedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
@@ -787,6 +1966,10 @@ var _Groups_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _Groups_Update_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _Groups_Search_Handler,
+		},
 		{
 			MethodName: "ValidatedList",
 			Handler:    _Groups_ValidatedList_Handler,
@@ -800,74 +1983,519 @@ var _Groups_serviceDesc = grpc.ServiceDesc{
 	Metadata: "example/examplepb/example.proto",
 }
 
+// Client API for Compat service
+
+type CompatClient interface {
+	CreateAddressOrGroup(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	CreateProfileOrGroup(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+}
+
+type compatClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCompatClient(cc *grpc.ClientConn) CompatClient {
+	return &compatClient{cc}
+}
+
+func (c *compatClient) CreateAddressOrGroup(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/examplepb.Compat/CreateAddressOrGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compatClient) CreateProfileOrGroup(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/examplepb.Compat/CreateProfileOrGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Compat service
+
+type CompatServer interface {
+	CreateAddressOrGroup(context.Context, *EmptyRequest) (*EmptyResponse, error)
+	CreateProfileOrGroup(context.Context, *EmptyRequest) (*EmptyResponse, error)
+}
+
+func RegisterCompatServer(s *grpc.Server, srv CompatServer) {
+	s.RegisterService(&_Compat_serviceDesc, srv)
+}
+
+func _Compat_CreateAddressOrGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompatServer).CreateAddressOrGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/examplepb.Compat/CreateAddressOrGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompatServer).CreateAddressOrGroup(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Compat_CreateProfileOrGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompatServer).CreateProfileOrGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/examplepb.Compat/CreateProfileOrGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompatServer).CreateProfileOrGroup(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Compat_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "examplepb.Compat",
+	HandlerType: (*CompatServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAddressOrGroup",
+			Handler:    _Compat_CreateAddressOrGroup_Handler,
+		},
+		{
+			MethodName: "CreateProfileOrGroup",
+			Handler:    _Compat_CreateProfileOrGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "example/examplepb/example.proto",
+}
+
+// Client API for Tables service
+
+type TablesClient interface {
+	Import(ctx context.Context, in *Table, opts ...grpc.CallOption) (*EmptyResponse, error)
+}
+
+type tablesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTablesClient(cc *grpc.ClientConn) TablesClient {
+	return &tablesClient{cc}
+}
+
+func (c *tablesClient) Import(ctx context.Context, in *Table, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/examplepb.Tables/Import", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Tables service
+
+type TablesServer interface {
+	Import(context.Context, *Table) (*EmptyResponse, error)
+}
+
+func RegisterTablesServer(s *grpc.Server, srv TablesServer) {
+	s.RegisterService(&_Tables_serviceDesc, srv)
+}
+
+func _Tables_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Table)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TablesServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/examplepb.Tables/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TablesServer).Import(ctx, req.(*Table))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Tables_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "examplepb.Tables",
+	HandlerType: (*TablesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Import",
+			Handler:    _Tables_Import_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "example/examplepb/example.proto",
+}
+
+// Client API for Configs service
+
+type ConfigsClient interface {
+	Apply(ctx context.Context, in *RawConfig, opts ...grpc.CallOption) (*EmptyResponse, error)
+}
+
+type configsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewConfigsClient(cc *grpc.ClientConn) ConfigsClient {
+	return &configsClient{cc}
+}
+
+func (c *configsClient) Apply(ctx context.Context, in *RawConfig, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/examplepb.Configs/Apply", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Configs service
+
+type ConfigsServer interface {
+	Apply(context.Context, *RawConfig) (*EmptyResponse, error)
+}
+
+func RegisterConfigsServer(s *grpc.Server, srv ConfigsServer) {
+	s.RegisterService(&_Configs_serviceDesc, srv)
+}
+
+func _Configs_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RawConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigsServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/examplepb.Configs/Apply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigsServer).Apply(ctx, req.(*RawConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Configs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "examplepb.Configs",
+	HandlerType: (*ConfigsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Apply",
+			Handler:    _Configs_Apply_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "example/examplepb/example.proto",
+}
+
+// Client API for Queries service
+
+type QueriesClient interface {
+	Run(ctx context.Context, in *SearchQuery, opts ...grpc.CallOption) (*EmptyResponse, error)
+}
+
+type queriesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQueriesClient(cc *grpc.ClientConn) QueriesClient {
+	return &queriesClient{cc}
+}
+
+func (c *queriesClient) Run(ctx context.Context, in *SearchQuery, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/examplepb.Queries/Run", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Queries service
+
+type QueriesServer interface {
+	Run(context.Context, *SearchQuery) (*EmptyResponse, error)
+}
+
+func RegisterQueriesServer(s *grpc.Server, srv QueriesServer) {
+	s.RegisterService(&_Queries_serviceDesc, srv)
+}
+
+func _Queries_Run_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueriesServer).Run(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/examplepb.Queries/Run",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueriesServer).Run(ctx, req.(*SearchQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Queries_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "examplepb.Queries",
+	HandlerType: (*QueriesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Run",
+			Handler:    _Queries_Run_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "example/examplepb/example.proto",
+}
+
 func init() { proto.RegisterFile("example/examplepb/example.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1043 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0xae, 0xbf, 0xb2, 0xe3, 0x7c, 0xbe, 0xb1, 0xd2, 0xcd, 0xd6, 0x25, 0xee, 0x0a, 0x81,
-	0xb1, 0x1a, 0x6f, 0xe5, 0x0a, 0x51, 0xb9, 0x02, 0xa9, 0x69, 0xa3, 0x22, 0xd1, 0xa0, 0xb2, 0x24,
-	0x45, 0x58, 0x48, 0xd6, 0x38, 0x3b, 0x71, 0x96, 0xae, 0x77, 0x97, 0x9d, 0x71, 0x5a, 0x53, 0x71,
-	0x41, 0xe2, 0xc4, 0x91, 0x33, 0xbf, 0x25, 0xe2, 0xce, 0x0d, 0x71, 0xc9, 0x99, 0x1f, 0x82, 0xe6,
-	0x63, 0x37, 0xb6, 0x37, 0xb8, 0x6a, 0x39, 0xed, 0xcc, 0xbc, 0xcf, 0xbc, 0xcf, 0xfb, 0xf1, 0xcc,
-	0xcc, 0xa2, 0x5d, 0xf2, 0x0a, 0x8f, 0xe2, 0x80, 0x38, 0xea, 0x1b, 0x0f, 0xd2, 0x51, 0x3b, 0x4e,
-	0x22, 0x16, 0x81, 0x91, 0x19, 0xac, 0xfa, 0x30, 0x8a, 0x86, 0x01, 0x71, 0x70, 0xec, 0x3b, 0x38,
-	0x0c, 0x23, 0x86, 0x99, 0x1f, 0x85, 0x54, 0x02, 0xad, 0x5d, 0x65, 0x15, 0xb3, 0xc1, 0xf8, 0xd4,
-	0x61, 0xfe, 0x88, 0x50, 0x86, 0x47, 0xb1, 0x02, 0xdc, 0x9c, 0x07, 0x90, 0x51, 0xcc, 0x26, 0xca,
-	0xb8, 0x33, 0x6f, 0xc4, 0x61, 0x6a, 0x7a, 0x6f, 0xde, 0xf4, 0x32, 0xc1, 0x71, 0x4c, 0x92, 0x94,
-	0xf8, 0xcb, 0xa1, 0xcf, 0xce, 0xc6, 0x83, 0xf6, 0x49, 0x34, 0x72, 0xfc, 0xf0, 0x34, 0x1a, 0x04,
-	0xd1, 0xab, 0x28, 0x26, 0xa1, 0xdc, 0x70, 0xb2, 0x37, 0x24, 0xe1, 0x1e, 0x66, 0x01, 0xa6, 0x7b,
-	0xe7, 0x38, 0xf0, 0x3d, 0xcc, 0x88, 0x13, 0xc5, 0x22, 0x72, 0x47, 0x2c, 0xf7, 0xd3, 0x65, 0xe5,
-	0xef, 0xab, 0xb7, 0xf7, 0x77, 0x55, 0x44, 0x46, 0x92, 0x10, 0x07, 0xd9, 0x40, 0xba, 0xb4, 0xff,
-	0x28, 0xa0, 0xe2, 0x31, 0x25, 0x09, 0x6c, 0x23, 0xdd, 0xf7, 0x4c, 0xad, 0xa1, 0x35, 0x4b, 0xfb,
-	0xe5, 0xcb, 0x8b, 0x1d, 0x7d, 0x79, 0xc9, 0xd5, 0x7d, 0x0f, 0x6e, 0xa1, 0x62, 0x88, 0x47, 0xc4,
-	0xd4, 0x1b, 0x5a, 0xd3, 0xd8, 0x37, 0x2e, 0x2f, 0x76, 0x4a, 0x50, 0x58, 0xd2, 0x35, 0x57, 0x2c,
-	0xc3, 0x1d, 0x54, 0x89, 0x93, 0xe8, 0xd4, 0x0f, 0x88, 0x59, 0x68, 0x68, 0xcd, 0x6a, 0x07, 0xda,
-	0x59, 0x5b, 0xda, 0xcf, 0xa4, 0xc5, 0x4d, 0x21, 0x1c, 0x8d, 0x3d, 0x2f, 0x21, 0x94, 0x9a, 0xc5,
-	0x1c, 0xfa, 0xa1, 0xb4, 0xb8, 0x29, 0x04, 0x9a, 0xa8, 0x3c, 0x4c, 0xa2, 0x71, 0x4c, 0xcd, 0x52,
-	0xa3, 0xd0, 0xac, 0x76, 0x36, 0xa6, 0xc0, 0x4f, 0xb8, 0xc1, 0x55, 0x76, 0xb8, 0x8b, 0x2a, 0x31,
-	0x4e, 0x48, 0xc8, 0xa8, 0x59, 0x16, 0xd0, 0xed, 0x29, 0x28, 0x4f, 0xaf, 0xfd, 0x4c, 0x98, 0xdd,
-	0x14, 0x06, 0x0f, 0xd0, 0x6a, 0x5a, 0x89, 0xfe, 0x98, 0x92, 0xc4, 0xac, 0x88, 0x78, 0xf8, 0x3e,
-	0x55, 0x9f, 0x03, 0x35, 0xe0, 0xdb, 0xdd, 0x15, 0x32, 0x35, 0x83, 0x8f, 0x11, 0x12, 0x0a, 0xe9,
-	0x07, 0x3e, 0x65, 0xe6, 0xb2, 0x62, 0x94, 0x62, 0x68, 0xa7, 0x62, 0x68, 0x1f, 0x70, 0x88, 0x6b,
-	0x08, 0xe4, 0x53, 0x9f, 0x32, 0xb8, 0x8f, 0x8c, 0x4c, 0x79, 0xa6, 0x21, 0xf8, 0xac, 0xdc, 0xae,
-	0xa3, 0x14, 0xe1, 0x5e, 0x81, 0xad, 0x3a, 0x2a, 0xcb, 0x04, 0x00, 0x54, 0x3b, 0x78, 0xa3, 0x0c,
-	0xd9, 0x03, 0xfb, 0x6f, 0x0d, 0x55, 0x54, 0xf1, 0xc0, 0x44, 0x95, 0x93, 0x68, 0x1c, 0xb2, 0x64,
-	0xa2, 0x20, 0xe9, 0x14, 0x76, 0x51, 0x89, 0x32, 0xcc, 0x66, 0x3a, 0x89, 0x0a, 0x9a, 0xbe, 0xe4,
-	0xca, 0x75, 0xee, 0xfa, 0xc4, 0x67, 0x13, 0xd1, 0x47, 0xc3, 0x15, 0x63, 0xd8, 0x40, 0x85, 0x1f,
-	0xfd, 0x58, 0x34, 0xcb, 0x70, 0xf9, 0x10, 0xee, 0xa2, 0x22, 0xc3, 0x43, 0x6a, 0x22, 0x91, 0x75,
-	0x3d, 0xdf, 0xbf, 0xf6, 0x11, 0x1e, 0xd2, 0x03, 0x4e, 0xe9, 0x0a, 0xa4, 0xf5, 0x09, 0x32, 0xb2,
-	0x25, 0xee, 0xf0, 0x05, 0x49, 0x63, 0xe3, 0x43, 0xa8, 0xa1, 0xd2, 0x39, 0x0e, 0xc6, 0x2a, 0x2e,
-	0x57, 0x4e, 0xba, 0xfa, 0x7d, 0xcd, 0xfe, 0x1a, 0x95, 0x44, 0x9b, 0xc1, 0x9c, 0xd2, 0xe6, 0xf2,
-	0xe5, 0xc5, 0x4e, 0x11, 0x74, 0x4d, 0x17, 0xea, 0xb4, 0x66, 0xd4, 0x29, 0x74, 0xbb, 0xb1, 0xa4,
-	0xa4, 0x59, 0x43, 0xa5, 0x30, 0x62, 0x84, 0xaa, 0x84, 0xe4, 0xc4, 0xfe, 0x0c, 0x6d, 0x3e, 0x4a,
-	0x08, 0x66, 0x44, 0xf4, 0x95, 0xfc, 0x30, 0x26, 0x94, 0xc1, 0x47, 0x5c, 0x3f, 0x93, 0x20, 0xc2,
-	0x92, 0xa5, 0xda, 0x59, 0x9f, 0xd3, 0x8f, 0x9b, 0xda, 0xf9, 0xfe, 0xe3, 0xd8, 0x7b, 0xf7, 0xfd,
-	0x6b, 0x68, 0x45, 0x0a, 0x43, 0x6e, 0xb5, 0xd7, 0xd1, 0xaa, 0x9a, 0xd3, 0x38, 0x0a, 0x29, 0xb1,
-	0x0f, 0x51, 0x45, 0x9d, 0x1b, 0x58, 0xbb, 0xca, 0x5b, 0x64, 0x5b, 0x9f, 0xc9, 0x56, 0x54, 0x02,
-	0xf1, 0x4a, 0x2c, 0xca, 0xf7, 0x31, 0xaa, 0xc9, 0x78, 0xd3, 0xc3, 0xa8, 0x42, 0xbe, 0x33, 0x1f,
-	0xf2, 0xf5, 0x07, 0x57, 0x42, 0x3a, 0xbf, 0x17, 0x51, 0x89, 0xe7, 0x41, 0xe1, 0x5b, 0x54, 0x96,
-	0xf5, 0x83, 0xe9, 0xde, 0xe7, 0x4a, 0x6a, 0x99, 0x53, 0xd6, 0xd9, 0x04, 0x6f, 0xfc, 0xfc, 0xd7,
-	0x3f, 0xbf, 0xe9, 0x9b, 0x76, 0xd9, 0xe1, 0x07, 0x8f, 0x76, 0x53, 0x12, 0xf8, 0x45, 0x43, 0x65,
-	0x19, 0xeb, 0x8c, 0xef, 0x5c, 0xb9, 0x17, 0xf8, 0x7e, 0x24, 0x7c, 0x7f, 0x6a, 0x6d, 0x49, 0xdf,
-	0xce, 0x6b, 0xe5, 0xbb, 0xed, 0x7b, 0x3f, 0x65, 0x44, 0xbd, 0x5b, 0x1d, 0x10, 0xf6, 0xeb, 0xcd,
-	0xf0, 0x1d, 0x2a, 0x8a, 0xf3, 0x7a, 0x23, 0x4f, 0xf3, 0x26, 0xfe, 0xdb, 0x82, 0xff, 0x26, 0xa8,
-	0xdc, 0x7a, 0x9b, 0xb0, 0xee, 0xe0, 0x90, 0x45, 0xec, 0x8c, 0x24, 0xe2, 0x9e, 0xa1, 0x30, 0x44,
-	0x20, 0x33, 0x9a, 0xbe, 0x60, 0x60, 0x5e, 0x30, 0x0b, 0x38, 0x3e, 0x10, 0x1c, 0x0d, 0x6b, 0xdd,
-	0x99, 0xb9, 0xc1, 0x68, 0x77, 0xf6, 0x46, 0x83, 0xef, 0xd1, 0x56, 0x9e, 0xa8, 0x03, 0xff, 0x71,
-	0xc5, 0xbd, 0x39, 0x29, 0x6b, 0x7b, 0x8e, 0xb0, 0x3f, 0x16, 0xee, 0xbb, 0x5a, 0xab, 0xf3, 0xa7,
-	0x86, 0x96, 0x95, 0x68, 0x28, 0x3c, 0xcd, 0x24, 0x72, 0x8d, 0xa6, 0x16, 0xf0, 0xd4, 0x04, 0xcf,
-	0x9a, 0x6d, 0x38, 0xea, 0xbd, 0xa0, 0x5d, 0xad, 0x05, 0x49, 0x26, 0x8a, 0xdd, 0x9c, 0x28, 0x66,
-	0x35, 0xbd, 0xc0, 0xf5, 0x9e, 0x78, 0xd1, 0x34, 0x41, 0x70, 0xdb, 0xda, 0xce, 0x08, 0xae, 0x57,
-	0x40, 0xe7, 0xd7, 0x02, 0x2a, 0x3f, 0x91, 0x4f, 0xcb, 0xe7, 0x59, 0x32, 0xb9, 0xe7, 0x67, 0x01,
-	0x1f, 0x08, 0xa6, 0x15, 0xbb, 0xe2, 0xc8, 0x17, 0x8a, 0x27, 0x72, 0x98, 0x25, 0xf2, 0x36, 0x9e,
-	0xd4, 0x69, 0xb1, 0x56, 0x94, 0x27, 0xe7, 0x35, 0x8f, 0x54, 0x6b, 0xc1, 0x19, 0x5a, 0x7d, 0xae,
-	0x5e, 0x79, 0xef, 0x5d, 0xe5, 0xfa, 0xbe, 0x7c, 0xe8, 0x05, 0x85, 0x09, 0x69, 0xb0, 0xbd, 0x55,
-	0xa8, 0xaa, 0x61, 0x1f, 0x7b, 0x1e, 0x9c, 0xa3, 0x6a, 0xca, 0xf4, 0xcd, 0x17, 0x47, 0x50, 0xcb,
-	0xbd, 0x59, 0x0f, 0xc3, 0x89, 0x55, 0xcf, 0xad, 0x3e, 0x8e, 0xc6, 0x83, 0x80, 0x3c, 0xe7, 0xd7,
-	0xb9, 0x7d, 0x6f, 0x8a, 0xe8, 0x43, 0x6b, 0xd9, 0x79, 0xf9, 0x82, 0xf5, 0x87, 0x84, 0x75, 0xb5,
-	0x56, 0xcf, 0xb4, 0xb6, 0xd2, 0x29, 0x67, 0xf3, 0xf9, 0xff, 0x0f, 0x0e, 0xba, 0x5a, 0xcb, 0x92,
-	0xbf, 0x21, 0xda, 0xfe, 0xb1, 0xdc, 0xdc, 0x3b, 0xfc, 0x3f, 0xbf, 0x3f, 0xaa, 0x00, 0x0f, 0xb2,
-	0xd1, 0xa0, 0x2c, 0xb6, 0xdd, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xd4, 0x95, 0x9a, 0x69,
-	0x0a, 0x00, 0x00,
+	// 3538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0xcd, 0x6f, 0x1b, 0x49,
+	0x76, 0x57, 0xf3, 0xa3, 0x49, 0x3e, 0x8a, 0x1f, 0x2a, 0x6b, 0xec, 0x66, 0x4b, 0x33, 0xa6, 0x7a,
+	0x6c, 0x8f, 0x4c, 0x5b, 0xa4, 0x96, 0x5e, 0xef, 0x66, 0xe9, 0xf5, 0x8c, 0x44, 0x59, 0x5e, 0x0b,
+	0xb6, 0x35, 0x9a, 0x92, 0x3c, 0x93, 0xd1, 0x64, 0x86, 0x69, 0x91, 0x45, 0xaa, 0x57, 0xcd, 0x6e,
+	0x4e, 0x77, 0x53, 0xb2, 0xbc, 0x13, 0x60, 0x11, 0x24, 0x08, 0x30, 0xc8, 0x21, 0x40, 0x0e, 0xbb,
+	0xa7, 0x00, 0xc9, 0x21, 0x40, 0xfe, 0x81, 0x5c, 0xb9, 0x41, 0x80, 0x00, 0x01, 0x72, 0xcb, 0x8d,
+	0xa7, 0x1c, 0x16, 0xc9, 0x21, 0x97, 0x9c, 0x72, 0x0c, 0x82, 0xfa, 0xe8, 0x66, 0xf3, 0x4b, 0xf3,
+	0x0d, 0x68, 0xba, 0xde, 0xfb, 0xbd, 0x57, 0xf5, 0xaa, 0xde, 0x7b, 0xf5, 0xea, 0x71, 0xe0, 0x26,
+	0x79, 0xad, 0x77, 0x7b, 0x26, 0xa9, 0x88, 0xff, 0xf6, 0x4e, 0xfc, 0xaf, 0x72, 0xcf, 0xb1, 0x3d,
+	0x1b, 0xa5, 0x02, 0x86, 0xba, 0xda, 0xb1, 0xed, 0x8e, 0x49, 0x2a, 0x7a, 0xcf, 0xa8, 0xe8, 0x96,
+	0x65, 0x7b, 0xba, 0x67, 0xd8, 0x96, 0xcb, 0x81, 0xea, 0x4d, 0xc1, 0x65, 0xa3, 0x93, 0x7e, 0xbb,
+	0xe2, 0x19, 0x5d, 0xe2, 0x7a, 0x7a, 0xb7, 0x27, 0x00, 0x2b, 0x93, 0x00, 0xd2, 0xed, 0x79, 0x97,
+	0x82, 0x59, 0x98, 0x64, 0xea, 0x96, 0xcf, 0x7a, 0x67, 0x92, 0x75, 0xe1, 0xe8, 0xbd, 0x1e, 0x71,
+	0xdc, 0x79, 0xfc, 0x56, 0xdf, 0x61, 0x2b, 0x13, 0xfc, 0xd5, 0x49, 0xbe, 0xeb, 0x39, 0xfd, 0xa6,
+	0x27, 0xb8, 0xc5, 0x49, 0x6e, 0xdb, 0x20, 0x66, 0xab, 0xd1, 0xd5, 0xdd, 0x33, 0x81, 0xd8, 0xef,
+	0x18, 0xde, 0x69, 0xff, 0xa4, 0xdc, 0xb4, 0xbb, 0x15, 0xc3, 0x6a, 0xdb, 0x27, 0xa6, 0xfd, 0xda,
+	0xee, 0x11, 0x8b, 0x8b, 0x34, 0x37, 0x3a, 0xc4, 0xda, 0xd0, 0x3d, 0x53, 0x77, 0x37, 0xce, 0x75,
+	0xd3, 0x68, 0xe9, 0x1e, 0xa9, 0xd8, 0x3d, 0xb6, 0x33, 0x15, 0x46, 0x6e, 0xf8, 0x64, 0xa1, 0xef,
+	0xa3, 0xef, 0xae, 0x6f, 0x74, 0x48, 0x1e, 0x71, 0x2c, 0xdd, 0x0c, 0x3e, 0xb8, 0x4a, 0xed, 0xff,
+	0x12, 0x10, 0x7b, 0xe5, 0x12, 0x07, 0xbd, 0x0b, 0x11, 0xa3, 0xa5, 0x48, 0x45, 0x69, 0x3d, 0x5e,
+	0xbf, 0x36, 0x1c, 0x14, 0x72, 0x20, 0x2d, 0xd4, 0xa1, 0xa7, 0x5f, 0x9a, 0xb6, 0xde, 0x2a, 0x1b,
+	0x2d, 0x1c, 0x31, 0x5a, 0xe8, 0x6d, 0x88, 0x59, 0x7a, 0x97, 0x28, 0x91, 0xa2, 0xb4, 0x9e, 0xaa,
+	0xa7, 0x86, 0x83, 0x42, 0x1c, 0x45, 0x17, 0x22, 0x12, 0x66, 0x64, 0x74, 0x1f, 0x12, 0x3d, 0xc7,
+	0x6e, 0x1b, 0x26, 0x51, 0xa2, 0x45, 0x69, 0x3d, 0x5d, 0x45, 0xe5, 0xc0, 0x07, 0xca, 0x07, 0x9c,
+	0x83, 0x7d, 0x08, 0x45, 0xeb, 0xad, 0x96, 0x43, 0x5c, 0x57, 0x89, 0x4d, 0xa1, 0xb7, 0x39, 0x07,
+	0xfb, 0x10, 0xb4, 0x0e, 0x72, 0xc7, 0xb1, 0xfb, 0x3d, 0x57, 0x89, 0x17, 0xa3, 0xeb, 0xe9, 0x6a,
+	0x3e, 0x04, 0xfe, 0x05, 0x65, 0x60, 0xc1, 0x47, 0x9b, 0x90, 0xe8, 0xe9, 0x0e, 0xb1, 0x3c, 0x57,
+	0x91, 0x19, 0xf4, 0x7a, 0x08, 0x4a, 0x6d, 0x2d, 0x1f, 0x30, 0x36, 0xf6, 0x61, 0xe8, 0x11, 0x64,
+	0xfc, 0x6d, 0x69, 0xf4, 0x5d, 0xe2, 0x28, 0x89, 0xa2, 0x24, 0xe4, 0xc4, 0x66, 0xed, 0x8a, 0x0f,
+	0x2a, 0x8e, 0x17, 0x49, 0x68, 0x84, 0x1e, 0x02, 0x30, 0x77, 0x6c, 0x98, 0x86, 0xeb, 0x29, 0x49,
+	0x31, 0x23, 0xf7, 0x8d, 0xb2, 0xef, 0x1b, 0xe5, 0x5d, 0x0a, 0xc1, 0x29, 0x86, 0x7c, 0x61, 0xb8,
+	0x1e, 0xaa, 0x43, 0x2a, 0x70, 0x73, 0x25, 0xc5, 0xe6, 0x53, 0xa7, 0xa4, 0x8e, 0x7c, 0x44, 0x3d,
+	0x39, 0x1c, 0x14, 0x62, 0x5a, 0xe4, 0x61, 0x17, 0x8f, 0xc4, 0xd0, 0x43, 0xc8, 0xf4, 0x1c, 0xa3,
+	0xab, 0x3b, 0x97, 0x0d, 0x66, 0xbb, 0x02, 0x45, 0x69, 0xe6, 0xd6, 0x2c, 0x0a, 0x18, 0x1b, 0x21,
+	0x0c, 0x4b, 0x81, 0xb9, 0x4d, 0xdb, 0xf2, 0xf4, 0xa6, 0xe7, 0x2a, 0x69, 0xb6, 0xf0, 0xdb, 0x93,
+	0x5b, 0xe5, 0x1b, 0xbe, 0x23, 0x70, 0xbb, 0x96, 0xe7, 0x5c, 0xe2, 0x3c, 0x99, 0x20, 0xa3, 0x07,
+	0xa1, 0x2d, 0x3c, 0x33, 0xac, 0x96, 0xb2, 0x58, 0x94, 0xd6, 0xb3, 0xd5, 0xec, 0x68, 0x0b, 0x9f,
+	0x1b, 0x56, 0x6b, 0xb4, 0x75, 0x74, 0x84, 0xea, 0x90, 0x0d, 0x84, 0x1c, 0xdb, 0x24, 0xae, 0x92,
+	0x29, 0x46, 0xd7, 0xb3, 0xd5, 0x95, 0xd9, 0x1b, 0x5f, 0xc6, 0xb6, 0x49, 0x70, 0x30, 0x0f, 0x1d,
+	0xb9, 0x68, 0x0f, 0xb2, 0x63, 0x13, 0xbb, 0x4a, 0x96, 0x59, 0xa2, 0xcd, 0xb3, 0x84, 0xce, 0x2c,
+	0xcc, 0xc8, 0x84, 0x57, 0xe3, 0xa2, 0x3b, 0x00, 0x4d, 0x87, 0xe8, 0x1e, 0x69, 0x35, 0x4e, 0x2e,
+	0x95, 0x1c, 0xf3, 0xf1, 0xc4, 0x70, 0x50, 0x88, 0xfe, 0x5a, 0x92, 0x70, 0x4a, 0xb0, 0xea, 0x97,
+	0xea, 0x2a, 0xc8, 0xdc, 0x83, 0x10, 0x12, 0xf1, 0x40, 0xc3, 0x26, 0xc5, 0x83, 0x40, 0xfd, 0x0c,
+	0xde, 0x9a, 0xb9, 0x69, 0x28, 0x0f, 0xd1, 0x33, 0x72, 0x29, 0xb0, 0xf4, 0x13, 0xdd, 0x87, 0xf8,
+	0xb9, 0x6e, 0xf6, 0x79, 0x3c, 0xcd, 0xf7, 0x37, 0x0e, 0xaa, 0x45, 0xfe, 0x40, 0x52, 0x0f, 0x00,
+	0x4d, 0xdb, 0x31, 0x43, 0xf3, 0xad, 0xb0, 0xe6, 0xe9, 0x63, 0x18, 0x69, 0xd4, 0x7e, 0x17, 0x81,
+	0x84, 0x08, 0x36, 0xa4, 0x40, 0xa2, 0x69, 0xf7, 0xa9, 0x4a, 0xa1, 0xcb, 0x1f, 0xa2, 0x9b, 0x10,
+	0x77, 0x3d, 0xdd, 0x1b, 0x8b, 0x7c, 0x88, 0x4a, 0x91, 0x05, 0xcc, 0xe9, 0x74, 0x27, 0x9a, 0x86,
+	0x77, 0xc9, 0xe2, 0x3e, 0x85, 0xd9, 0x37, 0x5d, 0xd6, 0x1b, 0xa3, 0xc7, 0x82, 0x3b, 0x85, 0xe9,
+	0x27, 0xba, 0x0d, 0xb2, 0x43, 0x3a, 0x86, 0x6d, 0x29, 0x71, 0xa6, 0x27, 0x33, 0x1c, 0x14, 0x52,
+	0xb5, 0x04, 0xa7, 0xb9, 0x58, 0x30, 0xd1, 0x06, 0xa4, 0x4c, 0xdd, 0xea, 0xf4, 0xf5, 0x0e, 0xe1,
+	0x31, 0x9c, 0xaa, 0xe7, 0x86, 0x83, 0x42, 0xba, 0x36, 0x22, 0xe3, 0xd1, 0x27, 0xda, 0x84, 0x98,
+	0xa7, 0x77, 0x5c, 0x05, 0xd8, 0xc1, 0xaf, 0x4e, 0x67, 0x91, 0xf2, 0x91, 0xde, 0x11, 0x47, 0xce,
+	0x90, 0xea, 0x4f, 0x21, 0x15, 0x90, 0x66, 0xec, 0xde, 0x72, 0x78, 0xf7, 0x52, 0xa1, 0xdd, 0xaa,
+	0xb1, 0xcc, 0xa8, 0xca, 0x0d, 0xd3, 0xb0, 0xce, 0x5c, 0x35, 0xde, 0x20, 0x9e, 0xde, 0xd1, 0x7e,
+	0x1d, 0x81, 0x38, 0x8f, 0x2c, 0x25, 0x94, 0x44, 0x59, 0xc4, 0xa2, 0x88, 0x14, 0x61, 0x99, 0x73,
+	0x65, 0x2c, 0x73, 0x32, 0xaf, 0x42, 0xd2, 0x82, 0xc8, 0x9b, 0xab, 0x10, 0xb7, 0x6c, 0x8f, 0xb8,
+	0x7c, 0xf7, 0xea, 0xf2, 0x70, 0x50, 0x88, 0x6c, 0x6e, 0x61, 0x4e, 0x44, 0xaa, 0x30, 0x2f, 0x56,
+	0x8c, 0xfa, 0xcc, 0x67, 0x49, 0x6e, 0x08, 0x7a, 0x07, 0x64, 0xfd, 0x5c, 0xf7, 0x74, 0x87, 0x6d,
+	0xe8, 0xa2, 0xe0, 0xc6, 0xb0, 0xa0, 0xd6, 0xda, 0xc3, 0x41, 0xe1, 0x24, 0x1f, 0x87, 0x2f, 0xe0,
+	0xfd, 0xb5, 0x53, 0xdd, 0x5d, 0xf7, 0x4e, 0x0d, 0xb7, 0xcc, 0xd4, 0xde, 0x2d, 0x7e, 0xf5, 0x55,
+	0x31, 0x44, 0xd3, 0xbb, 0x84, 0x91, 0x46, 0x88, 0xe2, 0xda, 0xe3, 0x62, 0xc0, 0x43, 0xab, 0x9c,
+	0xd6, 0xed, 0xbb, 0x5e, 0xb1, 0x65, 0xb4, 0xdb, 0xc4, 0x29, 0xb6, 0x1d, 0xbb, 0x5b, 0xa4, 0xcc,
+	0xb2, 0xf6, 0xbf, 0x71, 0x90, 0x0f, 0x6c, 0xd3, 0x68, 0x32, 0xa7, 0x76, 0xfa, 0x34, 0x96, 0xa5,
+	0xa9, 0xe4, 0xcb, 0x11, 0x65, 0xdc, 0x37, 0x09, 0xe6, 0x20, 0xf5, 0xb7, 0x71, 0x88, 0xd1, 0x31,
+	0x3a, 0x01, 0xd9, 0xd4, 0x4f, 0x88, 0xe9, 0xcb, 0x69, 0xb3, 0xe5, 0xca, 0x2f, 0x18, 0x88, 0x9d,
+	0x5c, 0xfd, 0xce, 0x70, 0x50, 0xd0, 0xfe, 0x4e, 0xba, 0xf9, 0xc5, 0x67, 0xfa, 0xc6, 0x9b, 0xcd,
+	0x8d, 0x9f, 0x7d, 0xbe, 0xfe, 0xd9, 0x86, 0xf8, 0x2a, 0xf9, 0xa4, 0xbb, 0x1f, 0xdc, 0xc2, 0x42,
+	0x33, 0xaa, 0x05, 0x77, 0x48, 0xe4, 0xca, 0x39, 0xd8, 0x61, 0x0a, 0x87, 0x11, 0x12, 0xe8, 0xa7,
+	0x10, 0xf7, 0x0c, 0xe2, 0xd0, 0x33, 0xa2, 0xa2, 0x6b, 0x73, 0x44, 0x8f, 0x28, 0x86, 0x4b, 0x72,
+	0x3c, 0x7a, 0x0a, 0xd0, 0xb4, 0xad, 0x96, 0xc1, 0xee, 0x75, 0x76, 0x88, 0xe9, 0xea, 0x9d, 0x39,
+	0xd2, 0x3b, 0x01, 0x90, 0xab, 0x08, 0x49, 0xaa, 0x3f, 0x83, 0x74, 0xc8, 0xf6, 0xef, 0xe2, 0xb5,
+	0xea, 0x73, 0x48, 0x87, 0x4c, 0x0a, 0x8b, 0xc6, 0xb9, 0xe8, 0x9d, 0xf1, 0x44, 0x34, 0x7d, 0x81,
+	0x8c, 0xa5, 0x20, 0x18, 0x19, 0xf9, 0x4d, 0x49, 0x2d, 0x3b, 0xeb, 0xfc, 0xa9, 0x78, 0x58, 0x63,
+	0x03, 0x72, 0x13, 0x86, 0xcf, 0x50, 0xfb, 0x93, 0xf1, 0x25, 0x16, 0xbf, 0x69, 0x07, 0xc3, 0x13,
+	0xfc, 0x18, 0x52, 0x01, 0x1d, 0xbd, 0x07, 0x40, 0x5e, 0xf7, 0x68, 0x5a, 0xa0, 0x79, 0x48, 0x1a,
+	0x8f, 0xc7, 0x10, 0x4b, 0x7b, 0x17, 0x62, 0x74, 0xa5, 0x28, 0x03, 0xa9, 0xa3, 0xbd, 0x5d, 0xdc,
+	0x78, 0x8a, 0x77, 0x77, 0xf3, 0x0b, 0x68, 0x11, 0x92, 0x6c, 0x78, 0x80, 0x3f, 0xcc, 0x4b, 0xda,
+	0x6f, 0x24, 0x88, 0x1f, 0xe9, 0x27, 0x26, 0x41, 0xeb, 0x10, 0x73, 0xec, 0x0b, 0xdf, 0x7d, 0x97,
+	0x43, 0xeb, 0x63, 0xfc, 0x32, 0xb6, 0x2f, 0x30, 0x43, 0xa8, 0x9b, 0x10, 0xdb, 0x21, 0xa6, 0x39,
+	0x3a, 0x30, 0x29, 0x74, 0x60, 0x34, 0x93, 0xba, 0x3d, 0xdd, 0x62, 0x76, 0xc6, 0x31, 0xfb, 0x56,
+	0xab, 0x10, 0xc5, 0xf6, 0x05, 0xba, 0x07, 0xf1, 0x26, 0x31, 0x83, 0x10, 0x79, 0x6b, 0x6a, 0x0e,
+	0xaa, 0x16, 0x73, 0x8c, 0xf6, 0xfb, 0x18, 0xa4, 0x5f, 0x12, 0xdd, 0xed, 0x3b, 0xa4, 0x4b, 0xef,
+	0xaa, 0x75, 0x88, 0xea, 0x1d, 0x22, 0x92, 0xd3, 0xf5, 0xe1, 0xa0, 0x80, 0x3e, 0x5a, 0x10, 0xff,
+	0x7c, 0xca, 0xfe, 0xfe, 0xee, 0x64, 0x0b, 0x53, 0x08, 0x2a, 0x83, 0x6c, 0xb7, 0xdb, 0x2e, 0xf1,
+	0xd8, 0x1a, 0xa2, 0x1c, 0xcc, 0x31, 0x0b, 0x5b, 0x3b, 0x42, 0x6a, 0xeb, 0x9f, 0xb1, 0x40, 0xa1,
+	0x35, 0x88, 0xb9, 0xc6, 0x1b, 0x5e, 0xf3, 0xc5, 0x78, 0x4e, 0x17, 0xa0, 0xff, 0xf9, 0x00, 0x33,
+	0x16, 0xad, 0xc9, 0x2e, 0x88, 0xd1, 0x39, 0xf5, 0x78, 0x04, 0x44, 0x66, 0x2e, 0x60, 0xe1, 0x3f,
+	0x3e, 0xc0, 0x3e, 0x0c, 0x6d, 0x41, 0xdc, 0x34, 0xba, 0x86, 0xc7, 0x12, 0x5b, 0xba, 0xba, 0x32,
+	0x55, 0x1b, 0xed, 0x59, 0xde, 0x83, 0xea, 0xc7, 0x74, 0xcb, 0x26, 0xa7, 0xe4, 0x82, 0xe8, 0x27,
+	0x90, 0xd0, 0x4d, 0x43, 0x77, 0x89, 0x5f, 0x07, 0xae, 0x4e, 0xe9, 0x38, 0xf4, 0x1c, 0xc3, 0xea,
+	0x30, 0x25, 0xd8, 0x07, 0xa3, 0x2a, 0xc8, 0x7a, 0xd3, 0x33, 0xce, 0x89, 0x92, 0x98, 0x53, 0x96,
+	0xd5, 0x6d, 0xdb, 0xe4, 0x42, 0x02, 0x89, 0x1e, 0x42, 0xd2, 0xb0, 0x3c, 0xe2, 0x9c, 0xeb, 0xa6,
+	0x92, 0x64, 0x52, 0x85, 0x29, 0xa9, 0x27, 0xe2, 0x71, 0x81, 0x03, 0x28, 0xda, 0x80, 0xb8, 0xee,
+	0x79, 0x8e, 0x2b, 0x0a, 0xc0, 0x1b, 0xb3, 0x16, 0xd8, 0x6f, 0x7a, 0x98, 0xa3, 0xd0, 0x26, 0xcd,
+	0x41, 0x5d, 0xe2, 0xdf, 0x74, 0x57, 0xd4, 0x8b, 0x98, 0x03, 0x91, 0x0a, 0xc9, 0x73, 0xe2, 0x18,
+	0x6d, 0x83, 0xb4, 0x94, 0x74, 0x51, 0x5a, 0x4f, 0xe2, 0x60, 0x4c, 0x1d, 0xad, 0x6f, 0x19, 0x1e,
+	0xab, 0xd4, 0x52, 0x98, 0x7d, 0x53, 0x7c, 0xf3, 0x94, 0x34, 0xcf, 0xdc, 0x7e, 0x57, 0xc9, 0xd0,
+	0x1b, 0x05, 0x07, 0x63, 0xea, 0xae, 0xcc, 0x00, 0x25, 0x5b, 0x94, 0xd6, 0x25, 0xcc, 0x07, 0xda,
+	0x3f, 0x46, 0x21, 0xb6, 0x6f, 0xb7, 0xc8, 0xac, 0x5a, 0x08, 0xdd, 0xa3, 0xea, 0x0c, 0xb3, 0xe5,
+	0x10, 0x4b, 0xa4, 0xdc, 0x5c, 0xc8, 0x67, 0xa9, 0x18, 0x0e, 0x00, 0xd4, 0x3a, 0x76, 0xad, 0x8a,
+	0x0c, 0xab, 0x4e, 0x20, 0xcb, 0x2f, 0x28, 0x53, 0xa4, 0x56, 0x06, 0x44, 0x0f, 0x21, 0x45, 0xeb,
+	0x1a, 0x8b, 0x45, 0x32, 0x7f, 0x43, 0x4c, 0xea, 0xe7, 0x37, 0xe2, 0x1f, 0x4b, 0x78, 0x84, 0x44,
+	0xef, 0x43, 0xa2, 0x67, 0xf6, 0x3b, 0x86, 0xe5, 0xbf, 0x25, 0x56, 0x27, 0xa7, 0x3a, 0xe0, 0x6c,
+	0x7e, 0xcb, 0xf8, 0x1a, 0x7c, 0x21, 0x74, 0x9f, 0x46, 0x28, 0x69, 0x2a, 0xf2, 0xec, 0x19, 0x59,
+	0x32, 0xf9, 0xad, 0x24, 0x61, 0x86, 0x52, 0xf7, 0x00, 0x46, 0x2b, 0x9f, 0x91, 0xd8, 0x6e, 0x8f,
+	0x27, 0xb6, 0xa9, 0x0d, 0x1a, 0xcb, 0xe3, 0x8b, 0xe1, 0x95, 0xfd, 0x20, 0x65, 0xda, 0x6d, 0x48,
+	0x61, 0xfd, 0x62, 0xc7, 0xb6, 0xda, 0x46, 0x87, 0x56, 0x7e, 0xe7, 0xc4, 0x09, 0x32, 0x62, 0x1c,
+	0xfb, 0x43, 0xed, 0xeb, 0x08, 0xa4, 0x0f, 0x89, 0xee, 0x34, 0x4f, 0x3f, 0xea, 0x13, 0xe7, 0x12,
+	0xbd, 0x03, 0xf1, 0x2f, 0xe9, 0x87, 0xc8, 0x9c, 0xa2, 0xca, 0x59, 0x88, 0x62, 0x4e, 0x46, 0x6b,
+	0x90, 0x70, 0x48, 0xdb, 0x21, 0xee, 0x29, 0x5b, 0x43, 0x92, 0x6f, 0x07, 0x48, 0x51, 0xec, 0xd3,
+	0x69, 0x31, 0x69, 0x5f, 0x58, 0xc4, 0x51, 0xa2, 0xa3, 0x62, 0x12, 0x45, 0x17, 0xa4, 0x08, 0xe6,
+	0xf4, 0x89, 0x42, 0x3c, 0x36, 0xaf, 0x10, 0x47, 0xf7, 0x40, 0x36, 0xed, 0xa6, 0x6e, 0x12, 0x51,
+	0x4e, 0xb2, 0xea, 0xac, 0x96, 0xe0, 0x34, 0x57, 0x8d, 0x69, 0xc4, 0xd2, 0xb0, 0x80, 0xa0, 0x3a,
+	0xe4, 0xc5, 0x02, 0x1a, 0x6e, 0xf3, 0x94, 0xb4, 0xfa, 0x26, 0x61, 0x27, 0x98, 0xaa, 0xdf, 0x18,
+	0x0e, 0x0a, 0xd7, 0x4a, 0xb1, 0xa6, 0x63, 0x5b, 0x6a, 0x5a, 0xdb, 0x2c, 0x96, 0xf8, 0xbf, 0x1a,
+	0xce, 0x09, 0x81, 0x43, 0x81, 0xd7, 0xfe, 0x45, 0x82, 0xa4, 0x3f, 0xa0, 0xf1, 0xe0, 0x7a, 0xba,
+	0xe3, 0xf9, 0xe9, 0x9b, 0x0d, 0xd0, 0xdb, 0x10, 0x25, 0x56, 0x4b, 0xd4, 0x79, 0xe9, 0xe1, 0xa0,
+	0x90, 0xf8, 0x25, 0xe7, 0x60, 0x4a, 0x47, 0x25, 0x48, 0xd2, 0xc8, 0x7c, 0x63, 0x5b, 0x44, 0x98,
+	0x9f, 0x1d, 0x0e, 0x0a, 0x20, 0x30, 0xf4, 0x0a, 0x0a, 0xf8, 0x68, 0x15, 0x62, 0x2d, 0xfd, 0xd2,
+	0x2f, 0xfc, 0xd8, 0x4e, 0xf7, 0xa4, 0xd7, 0x09, 0xcc, 0xa8, 0xe8, 0x11, 0xbd, 0xc7, 0x9a, 0x84,
+	0xf7, 0x0b, 0x84, 0x23, 0x5f, 0x0b, 0x9d, 0xb7, 0xbf, 0x4e, 0xee, 0xbf, 0xaf, 0x23, 0x38, 0x04,
+	0xd7, 0xfe, 0x4b, 0x82, 0xcc, 0xbe, 0xed, 0x19, 0x6d, 0xa3, 0xc9, 0x5b, 0x31, 0xe8, 0xe7, 0x34,
+	0x54, 0x75, 0xcb, 0x1a, 0x55, 0x60, 0xc5, 0x31, 0xe7, 0x09, 0x61, 0xcb, 0x3b, 0x1c, 0x88, 0x03,
+	0x09, 0xf5, 0x37, 0x12, 0x24, 0x04, 0x95, 0x26, 0x02, 0xef, 0xb2, 0x17, 0x24, 0x02, 0xfa, 0x4d,
+	0xfd, 0xcb, 0x7f, 0xeb, 0xf3, 0xea, 0xc4, 0x1f, 0x52, 0x1f, 0xee, 0x3b, 0xa6, 0x78, 0x37, 0xd0,
+	0x4f, 0x74, 0x1d, 0x64, 0x97, 0x34, 0x1d, 0xe2, 0x89, 0x97, 0x83, 0x18, 0xd5, 0x7e, 0x3c, 0x1c,
+	0x14, 0x36, 0x35, 0xa6, 0xaf, 0x94, 0x87, 0x38, 0xe9, 0xea, 0x86, 0x89, 0x7c, 0x3d, 0xa5, 0xeb,
+	0xf4, 0x86, 0x39, 0x39, 0xb5, 0xed, 0x33, 0xc4, 0xb4, 0x08, 0x29, 0xed, 0xbf, 0xe9, 0xca, 0xf8,
+	0x3b, 0x0c, 0x6d, 0x0a, 0x29, 0xb6, 0xb4, 0x74, 0x55, 0x09, 0x19, 0x28, 0x20, 0xe5, 0x5d, 0xca,
+	0x7f, 0xb6, 0x80, 0x85, 0xfa, 0x4d, 0x88, 0xf7, 0x4e, 0xe9, 0x59, 0x45, 0xe6, 0x4a, 0x1c, 0x50,
+	0x3e, 0x95, 0x60, 0x40, 0xb5, 0x04, 0x71, 0xa6, 0x83, 0x06, 0x82, 0x6f, 0xf2, 0x44, 0x91, 0xe1,
+	0xd3, 0xd5, 0xa7, 0x10, 0x67, 0xd2, 0xe8, 0x26, 0xc8, 0x56, 0xbf, 0x7b, 0x42, 0x9c, 0x49, 0xa8,
+	0x20, 0xa3, 0xd5, 0x70, 0xa6, 0xe3, 0x95, 0xc1, 0x88, 0x50, 0x4f, 0x82, 0xdc, 0x25, 0xde, 0xa9,
+	0xdd, 0xd2, 0xfe, 0x55, 0x82, 0xb4, 0x58, 0xd8, 0x9e, 0xd5, 0xb6, 0x67, 0x26, 0xe5, 0xe5, 0xb0,
+	0x4d, 0x29, 0xb1, 0x6e, 0x4a, 0xe5, 0x7b, 0xc3, 0x4f, 0x82, 0x0f, 0xf8, 0x8b, 0xb0, 0xdb, 0xd3,
+	0x2d, 0x11, 0x86, 0xd8, 0x1f, 0xa2, 0x87, 0x23, 0xf3, 0xe2, 0xf3, 0xba, 0x37, 0xdc, 0x8e, 0xbf,
+	0x92, 0xa4, 0xc0, 0xe4, 0xda, 0xdd, 0xe1, 0xa0, 0x70, 0xbb, 0x8a, 0xc4, 0x12, 0xfc, 0x53, 0x8c,
+	0x2c, 0x44, 0x6a, 0x39, 0xbe, 0xd4, 0x60, 0x42, 0xed, 0x6f, 0x69, 0xb0, 0x11, 0xcf, 0x33, 0x2c,
+	0xf6, 0xd0, 0x89, 0x7b, 0xa7, 0xc4, 0xb7, 0x24, 0x48, 0x3b, 0x12, 0xe6, 0x64, 0x74, 0x9b, 0xb7,
+	0x53, 0x1a, 0x6f, 0x02, 0xc3, 0x42, 0x0f, 0x30, 0x16, 0x52, 0xc7, 0xd4, 0xca, 0x47, 0x90, 0xee,
+	0xf7, 0x68, 0x63, 0x8c, 0xb5, 0xe9, 0x44, 0x97, 0x6a, 0xfa, 0x1e, 0x7d, 0x4a, 0x3b, 0x79, 0x2f,
+	0x75, 0xf7, 0x0c, 0x03, 0x87, 0xd3, 0xef, 0xda, 0xd2, 0x70, 0x50, 0xc8, 0xd4, 0xc3, 0x0a, 0xb4,
+	0xf7, 0x61, 0x69, 0x87, 0xa5, 0x23, 0xf6, 0x50, 0x27, 0x5f, 0xf6, 0x89, 0xeb, 0xa1, 0xbb, 0x90,
+	0x10, 0x7d, 0x33, 0x45, 0x9a, 0x4a, 0xc3, 0x0c, 0xe8, 0xf3, 0xa9, 0xfc, 0x2b, 0xa6, 0xee, 0x7b,
+	0xca, 0x67, 0x61, 0x91, 0x77, 0x96, 0xb8, 0xa8, 0xf6, 0x0f, 0x31, 0xc8, 0xd3, 0xf6, 0x12, 0x45,
+	0xb9, 0xbe, 0xbe, 0x07, 0x90, 0xea, 0xe9, 0x1d, 0xd2, 0x60, 0x45, 0x5a, 0xa8, 0xfe, 0x13, 0x55,
+	0xd7, 0xa7, 0x5b, 0xa3, 0xd2, 0x29, 0x49, 0x81, 0x87, 0xb4, 0x62, 0x7b, 0x00, 0x72, 0xdb, 0x30,
+	0x3d, 0xe2, 0x88, 0xdd, 0x5c, 0x19, 0x0e, 0x0a, 0x37, 0x9e, 0x15, 0xbf, 0x96, 0x10, 0x7b, 0x6d,
+	0x35, 0x3e, 0xbf, 0xb7, 0xfe, 0xf8, 0xf1, 0x57, 0x6b, 0x8f, 0xef, 0x96, 0xef, 0xdd, 0xc2, 0x02,
+	0x4a, 0x83, 0xd9, 0x68, 0xf1, 0x0b, 0x3c, 0x8a, 0xe9, 0x27, 0x7a, 0x0e, 0xd9, 0x20, 0x95, 0x93,
+	0xb6, 0xed, 0x10, 0x25, 0x36, 0x67, 0xcf, 0xa7, 0x7a, 0x5d, 0x3f, 0x3a, 0xc5, 0x19, 0x3f, 0xd7,
+	0x33, 0x51, 0x74, 0xff, 0x5b, 0xf8, 0xdc, 0x28, 0xb3, 0x8c, 0xea, 0x38, 0xf9, 0x5b, 0xd7, 0x71,
+	0x1b, 0x90, 0xa4, 0xfd, 0x8c, 0x3e, 0x2d, 0x1a, 0x13, 0xac, 0x17, 0xb5, 0x14, 0x4e, 0xa9, 0x8c,
+	0x85, 0x03, 0x08, 0xfa, 0x39, 0x2d, 0x89, 0x68, 0xc9, 0x92, 0x9c, 0x7a, 0xd6, 0x4d, 0x9e, 0x42,
+	0x19, 0xeb, 0xa3, 0xf2, 0x85, 0x09, 0xa1, 0x4d, 0x48, 0x89, 0xb5, 0x12, 0x5a, 0x01, 0x46, 0xe7,
+	0x18, 0x34, 0x02, 0xa9, 0xcf, 0x01, 0x46, 0x6a, 0x66, 0xbc, 0xe3, 0xde, 0x1b, 0x7f, 0x7b, 0xcd,
+	0x58, 0x7b, 0xa8, 0x00, 0xc8, 0x41, 0x46, 0xf8, 0x8e, 0xdb, 0xb3, 0x2d, 0x97, 0x68, 0x7f, 0x13,
+	0x83, 0x84, 0xe8, 0xd2, 0xa2, 0xec, 0xa8, 0x93, 0xc1, 0xfa, 0x17, 0xab, 0x63, 0xfd, 0x0b, 0x76,
+	0x42, 0x40, 0x43, 0x8b, 0x51, 0xd1, 0xda, 0x78, 0x03, 0x83, 0x5d, 0x7b, 0x6a, 0x5c, 0xb3, 0x2a,
+	0xba, 0xe6, 0x77, 0x31, 0xee, 0x82, 0xcc, 0xb7, 0x4d, 0x89, 0xcd, 0x5b, 0x9b, 0x00, 0xd0, 0x22,
+	0x86, 0x77, 0x03, 0xe3, 0xec, 0x04, 0xc2, 0xde, 0xcf, 0x3a, 0x80, 0x9c, 0x4b, 0x6f, 0xac, 0xe0,
+	0xac, 0xe4, 0xa9, 0x1b, 0x4b, 0x18, 0x22, 0x74, 0x13, 0xb1, 0xf3, 0xa3, 0xa3, 0x7b, 0x00, 0xb9,
+	0x96, 0xd1, 0x21, 0xae, 0x37, 0xaa, 0x06, 0x12, 0x6c, 0xf1, 0x30, 0x1c, 0x14, 0x64, 0x5e, 0x0d,
+	0xe0, 0x2c, 0x87, 0x04, 0x57, 0xfe, 0x26, 0xa4, 0x1c, 0xd2, 0x35, 0xac, 0x16, 0x71, 0xf8, 0x99,
+	0xa7, 0xea, 0x68, 0x38, 0x28, 0x64, 0x4b, 0x8b, 0x14, 0xde, 0x70, 0x09, 0x7d, 0xaf, 0xbb, 0x78,
+	0x04, 0xa2, 0xb6, 0x34, 0x6d, 0xd3, 0x76, 0x58, 0x85, 0x2f, 0xda, 0x58, 0xa5, 0xd4, 0x29, 0x79,
+	0xdd, 0x60, 0x64, 0xcc, 0xb9, 0x68, 0x1d, 0xa0, 0x45, 0xce, 0x8d, 0x26, 0x4d, 0x2b, 0x4d, 0x05,
+	0x46, 0x75, 0x51, 0x29, 0xda, 0xd5, 0x9b, 0x38, 0xc5, 0x99, 0x2f, 0xf5, 0x26, 0x2a, 0xf9, 0x79,
+	0x3a, 0xcd, 0x40, 0xcb, 0xc3, 0x41, 0x21, 0xff, 0xb5, 0x94, 0xf9, 0xe2, 0xb3, 0x2f, 0xb6, 0x3e,
+	0xbf, 0xb7, 0xc5, 0xfe, 0xde, 0x12, 0xd9, 0x5b, 0xdd, 0x87, 0xcc, 0x98, 0xf9, 0x33, 0x0a, 0xc6,
+	0xef, 0xe4, 0x31, 0x4f, 0x60, 0x99, 0x67, 0x2b, 0xbf, 0x97, 0x2f, 0x12, 0xcc, 0xfd, 0xc9, 0x84,
+	0x35, 0xbb, 0xef, 0xcf, 0x21, 0xa5, 0x17, 0x20, 0x73, 0xd5, 0x08, 0x41, 0xf6, 0xf0, 0x68, 0xfb,
+	0xe8, 0xd5, 0x61, 0xe3, 0xd5, 0xfe, 0xf3, 0xfd, 0x0f, 0x3f, 0xd9, 0xcf, 0x2f, 0xa0, 0x25, 0xc8,
+	0x08, 0xda, 0xf6, 0xce, 0xd1, 0xde, 0xc7, 0xbb, 0x79, 0x09, 0x5d, 0x83, 0x9c, 0x20, 0xed, 0xed,
+	0x0b, 0x62, 0x44, 0x65, 0x55, 0x4d, 0x52, 0x2a, 0x3d, 0x86, 0x18, 0x75, 0x0a, 0xb4, 0x0c, 0x79,
+	0xfc, 0xe1, 0x8b, 0xdd, 0xc6, 0xab, 0xfd, 0xc3, 0x83, 0xdd, 0x9d, 0xbd, 0xa7, 0x7b, 0xbb, 0x4f,
+	0xf2, 0x0b, 0x28, 0x0b, 0xc0, 0xa8, 0xdb, 0x4f, 0x5e, 0xee, 0xed, 0xe7, 0x25, 0x94, 0x83, 0x34,
+	0x1b, 0xbf, 0xdc, 0x7d, 0x59, 0xdf, 0xc5, 0xf9, 0x48, 0xf5, 0x9f, 0x64, 0x88, 0xb3, 0x30, 0x45,
+	0x9f, 0x82, 0xcc, 0x53, 0x39, 0x0a, 0x3f, 0x07, 0xa6, 0xb2, 0xbb, 0x1a, 0xae, 0x01, 0xc6, 0xe3,
+	0xe7, 0xc6, 0x9f, 0xfe, 0xfb, 0xef, 0xff, 0x3a, 0xb2, 0xa4, 0xc9, 0x15, 0xfa, 0x23, 0x82, 0x5b,
+	0xf3, 0x2d, 0x46, 0x7f, 0x2e, 0x81, 0xcc, 0x37, 0x6e, 0x4c, 0xf7, 0x54, 0xe6, 0xbf, 0x42, 0xf7,
+	0x0e, 0xd3, 0xfd, 0xf8, 0xf8, 0xed, 0x2a, 0x62, 0xda, 0x2b, 0xbf, 0x1a, 0xfd, 0x34, 0xf3, 0x27,
+	0xc1, 0x4c, 0xea, 0x35, 0x3e, 0xf5, 0x6c, 0x2e, 0xda, 0x81, 0xe8, 0x2f, 0x88, 0x87, 0x6e, 0x4c,
+	0xcf, 0xc2, 0xa7, 0x9f, 0xbc, 0x67, 0x34, 0xc4, 0x66, 0x5d, 0x44, 0xc0, 0xd5, 0x36, 0x3a, 0xc4,
+	0x43, 0x7f, 0x26, 0x41, 0x02, 0x93, 0x9e, 0xa9, 0x37, 0xbf, 0xbf, 0x35, 0xdb, 0x4c, 0xef, 0x23,
+	0x35, 0x2b, 0xf4, 0x3a, 0x5c, 0x5f, 0x4d, 0x2a, 0x1d, 0xdf, 0xa9, 0xae, 0x8c, 0x13, 0xe7, 0xd8,
+	0xf2, 0x47, 0x10, 0x63, 0xbf, 0xa3, 0xcc, 0x35, 0x66, 0xfe, 0xec, 0x6b, 0x6c, 0xf6, 0x15, 0x24,
+	0xce, 0xe9, 0x78, 0x09, 0xe5, 0x2a, 0xba, 0xe5, 0xd9, 0xde, 0x29, 0x71, 0xd8, 0xef, 0x3f, 0x2e,
+	0xfa, 0x18, 0x64, 0xfe, 0xe8, 0x41, 0x2b, 0x57, 0xe4, 0xf4, 0x2b, 0xe6, 0x78, 0x8b, 0xcd, 0x91,
+	0x43, 0x19, 0x71, 0x20, 0x2e, 0xd7, 0xd6, 0x01, 0xc4, 0xf7, 0x29, 0xdc, 0xe0, 0x47, 0x93, 0xfb,
+	0x7e, 0x85, 0xde, 0x3b, 0x4c, 0x6f, 0xb1, 0x36, 0xfe, 0x83, 0x95, 0x9a, 0xab, 0x8c, 0x8d, 0x5d,
+	0xf4, 0x4b, 0xb8, 0x36, 0x3d, 0x51, 0x15, 0xcd, 0xf9, 0x89, 0xe1, 0x9b, 0x37, 0x4b, 0xbd, 0x3e,
+	0x31, 0x43, 0x83, 0x97, 0x41, 0x35, 0xa9, 0x54, 0xfd, 0x37, 0x09, 0x92, 0x22, 0xca, 0x5d, 0xf4,
+	0x22, 0x08, 0xa3, 0x19, 0x49, 0xe0, 0x8a, 0x79, 0x96, 0xd9, 0x3c, 0x59, 0x2d, 0x55, 0x11, 0xbf,
+	0x0f, 0xba, 0x35, 0xa9, 0x84, 0x9c, 0x20, 0x70, 0x6e, 0x4e, 0xb9, 0xda, 0x78, 0x12, 0xba, 0x42,
+	0xf5, 0x06, 0x4f, 0x15, 0x6c, 0x82, 0x35, 0xf5, 0x7a, 0x30, 0xc1, 0x6c, 0xcf, 0xaa, 0xfe, 0x67,
+	0x14, 0x64, 0xde, 0x2e, 0x45, 0xcf, 0x02, 0x63, 0xa6, 0x5a, 0xa2, 0x57, 0xcc, 0x27, 0xa2, 0x46,
+	0x4b, 0x54, 0x78, 0xef, 0x98, 0x1a, 0x72, 0x18, 0x18, 0xf2, 0x5d, 0x34, 0xbd, 0x4d, 0x57, 0x5e,
+	0xdc, 0xe2, 0x79, 0x45, 0x5d, 0x14, 0xfa, 0x2a, 0xbf, 0xa2, 0xeb, 0x95, 0x4a, 0xe8, 0x93, 0x1f,
+	0xea, 0xa5, 0xd7, 0x99, 0xe6, 0x3c, 0xca, 0xfa, 0x9a, 0x85, 0x9b, 0xb6, 0x21, 0xf3, 0xb1, 0xf8,
+	0x05, 0xb9, 0xf5, 0x7d, 0xa3, 0x4c, 0x1b, 0x0e, 0x0a, 0x0b, 0x4c, 0xbf, 0x72, 0x9c, 0x41, 0x69,
+	0x31, 0x43, 0x43, 0x6f, 0xb5, 0x90, 0xbf, 0x31, 0xc8, 0x83, 0xb4, 0x3f, 0xcf, 0x27, 0xcf, 0x8f,
+	0xd0, 0xf2, 0x54, 0x85, 0xb6, 0x6d, 0x5d, 0xaa, 0xd3, 0x6d, 0xbb, 0x27, 0x76, 0xff, 0xc4, 0x24,
+	0xac, 0x72, 0xd3, 0x7e, 0x14, 0x4c, 0xf3, 0x9e, 0x9a, 0xac, 0x5c, 0x9c, 0x79, 0x34, 0x49, 0xd1,
+	0x44, 0xa2, 0xd4, 0xa4, 0x92, 0x7a, 0xcd, 0xa7, 0xd0, 0x79, 0x59, 0xff, 0x57, 0x37, 0xfd, 0xab,
+	0xa3, 0xfa, 0xf7, 0x11, 0x90, 0x77, 0xe8, 0x63, 0xc3, 0x43, 0x7f, 0x29, 0xc1, 0x32, 0x3f, 0x69,
+	0x51, 0x75, 0x7d, 0xe8, 0xf0, 0x5f, 0x74, 0xbe, 0x87, 0xe1, 0xdb, 0xc3, 0x41, 0xe1, 0x16, 0x5a,
+	0x9a, 0x2a, 0xe4, 0x50, 0x6e, 0xe2, 0xe0, 0xd9, 0xaa, 0xaf, 0x69, 0xd9, 0x0a, 0x7b, 0xf1, 0x78,
+	0x15, 0xdb, 0x22, 0x0d, 0xbb, 0x4d, 0x0f, 0x76, 0xb4, 0x1c, 0xe1, 0xe4, 0x3f, 0x74, 0x39, 0xea,
+	0xd2, 0x74, 0x2c, 0xce, 0x5e, 0x4e, 0x4d, 0x2a, 0x8d, 0x56, 0xa4, 0x5b, 0x97, 0x0d, 0xbb, 0x5d,
+	0xfd, 0x43, 0x90, 0x59, 0x7f, 0xd9, 0x45, 0xfb, 0x20, 0xef, 0x75, 0x7b, 0xb6, 0xe3, 0x8d, 0xb9,
+	0x31, 0x63, 0x5e, 0xb1, 0x04, 0x85, 0xb9, 0x71, 0x32, 0x08, 0x0b, 0x8f, 0x29, 0xa3, 0xa9, 0xa3,
+	0xcb, 0x1e, 0xe7, 0x6d, 0xa3, 0xe3, 0xa2, 0x13, 0x88, 0x6f, 0xf7, 0x7a, 0xe6, 0x25, 0x0a, 0xb7,
+	0xce, 0x83, 0x0e, 0xd5, 0x15, 0xda, 0xef, 0x32, 0xbd, 0xef, 0x1e, 0x2f, 0x6b, 0xb9, 0x4a, 0x93,
+	0x2b, 0xab, 0x98, 0x76, 0xf3, 0x8c, 0xb4, 0xa8, 0x2d, 0x49, 0x9f, 0x46, 0xa7, 0xfb, 0x0b, 0x09,
+	0x12, 0xb4, 0x8d, 0x65, 0x10, 0x17, 0x7d, 0x05, 0x51, 0xdc, 0xb7, 0x50, 0xf8, 0xf7, 0x89, 0x50,
+	0x9f, 0xeb, 0x5b, 0x5c, 0xc5, 0x19, 0xae, 0xfe, 0x4b, 0xae, 0xef, 0xf8, 0xad, 0x7a, 0x1e, 0x62,
+	0x2f, 0xf6, 0xf6, 0x9f, 0xa3, 0x80, 0x56, 0x93, 0x4a, 0x75, 0x04, 0xf2, 0xe1, 0xee, 0x36, 0xde,
+	0x79, 0x36, 0x4e, 0x3e, 0xa4, 0x6e, 0x7b, 0xfc, 0xf2, 0x87, 0xfc, 0x0f, 0x1d, 0x62, 0x75, 0x8f,
+	0x82, 0xaf, 0x13, 0x99, 0x89, 0x3d, 0xf8, 0xff, 0x01, 0x00, 0xd6, 0x4d, 0xe4, 0x43, 0x9b, 0x23,
+	0x00, 0x00,
 }
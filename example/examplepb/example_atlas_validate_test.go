@@ -3,8 +3,22 @@ package examplepb
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/infobloxopen/protoc-gen-atlas-validate/example/external"
+	"github.com/infobloxopen/protoc-gen-atlas-validate/interceptor"
 	"github.com/infobloxopen/protoc-gen-atlas-validate/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 type Test struct {
@@ -227,3 +241,2529 @@ func TestRequiredFields(t *testing.T) {
 
 	}
 }
+
+func TestDefaultFields(t *testing.T) {
+	tests := []struct {
+		input           json.RawMessage
+		defaultFunction func(ctx context.Context, message json.RawMessage) (json.RawMessage, error)
+		context         context.Context
+		expected        string
+	}{
+		{
+			input:           json.RawMessage([]byte(`{"id": 1, "name": "first"}`)),
+			defaultFunction: default_Profiles_Create_0,
+			context:         context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			expected:        `{"id":1,"name":"first","notes":"n/a"}`,
+		},
+		{
+			input:           json.RawMessage([]byte(`{"id": 1, "notes": "some notes"}`)),
+			defaultFunction: default_Profiles_Create_0,
+			context:         context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			expected:        `{"id": 1, "notes": "some notes"}`,
+		},
+		{
+			input:           json.RawMessage([]byte(`{"name": "first", "profile": {"id": 1}}`)),
+			defaultFunction: default_Users_Create_0,
+			context:         context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			expected:        `{"name":"first","profile":{"id":1,"notes":"n/a"}}`,
+		},
+		{
+			input:           json.RawMessage([]byte(`{"name": "first"}`)),
+			defaultFunction: default_Users_Create_0,
+			context:         context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			expected:        `{"name": "first"}`,
+		},
+	}
+
+	for n, test := range tests {
+		out, err := test.defaultFunction(test.context, test.input)
+		if err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+
+		if string(out) != test.expected {
+			t.Errorf(" %d test failed, expected %s, got %s \n", n+1, test.expected, out)
+		}
+	}
+
+	// in_set option of a default is checked against a value set when it is injected
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+	runtime.RegisterValueSet("locales", []string{"de"})
+	if _, err := default_Queries_Run_0(ctx, json.RawMessage(`{"query": "a"}`)); err == nil || err.Error() != `field "locale" must be one of the allowed values` {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	runtime.RegisterValueSet("locales", []string{"en", "de"})
+	if out, err := default_Queries_Run_0(ctx, json.RawMessage(`{"query": "a"}`)); err != nil || string(out) != `{"locale":"en","query":"a","refresh_schedule":"0 * * * *"}` {
+		t.Errorf("unexpected result %s, error %v", out, err)
+	}
+
+	// external.proto is generated with json_names=true and case_insensitive=true parameters,
+	// a default is injected only if a field is not set under any name validators accept
+	accounts := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"login": "a"}`, expected: `{"display_name":"` + "`anonymous`" + `","login":"a"}`},
+		{input: `{"login": "a", "displayName": "b"}`, expected: `{"login": "a", "displayName": "b"}`},
+		{input: `{"login": "a", "Display_Name": "b"}`, expected: `{"display_name":"b","login":"a"}`},
+	}
+	for n, test := range accounts {
+		r := httptest.NewRequest("POST", "/external/accounts", strings.NewReader(test.input))
+		r.Header.Set("X-Atlas-Validate", "true")
+		if errs := external.AtlasValidateAnnotator(context.Background(), r).Get("Atlas-Validation-Error"); len(errs) != 0 {
+			t.Errorf(" %d test failed, error %s \n", n+1, errs[0])
+		}
+		if b, _ := ioutil.ReadAll(r.Body); string(b) != test.expected {
+			t.Errorf(" %d test failed, expected %s, got %s \n", n+1, test.expected, b)
+		}
+	}
+}
+
+func TestBodyCandidates(t *testing.T) {
+	tests := []Test{
+		{
+			input:            json.RawMessage([]byte(`{"country": "USA", "city": "New York"}`)),
+			validateFunction: validate_Compat_CreateAddressOrGroup_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         false,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"name": "first", "notes": "some notes"}`)),
+			validateFunction: validate_Compat_CreateAddressOrGroup_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         false,
+		},
+		{
+			input:            json.RawMessage([]byte(`{}`)),
+			validateFunction: validate_Compat_CreateAddressOrGroup_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         false,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"country": "USA", "name": "first"}`)),
+			validateFunction: validate_Compat_CreateAddressOrGroup_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         true,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"name": "first", "notes": "some notes"}`)),
+			validateFunction: validate_Compat_CreateProfileOrGroup_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         false,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"country": "USA"}`)),
+			validateFunction: validate_Compat_CreateProfileOrGroup_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         true,
+		},
+	}
+
+	for n, test := range tests {
+		err := test.validateFunction(test.context, test.input)
+		if err == nil && test.negative {
+			t.Errorf(" %d test failed, error must be not nil \n", n+1)
+		}
+
+		if err != nil && !test.negative {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	ctx := runtime.WithCoverage(context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"))
+	input := json.RawMessage([]byte(`{"name": "first", "profile": {"id": 1, "name": "some name"}, "address": {"country": "USA"}}`))
+
+	if err := validate_Users_Create_0(ctx, input); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	expected := []string{"address", "name", "profile", "profile.name"}
+	fields := runtime.CoverageFromContext(ctx).Fields()
+	if len(fields) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, fields)
+	}
+	for i := range expected {
+		if fields[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, fields)
+		}
+	}
+
+	if runtime.CoverageFromContext(context.Background()) != nil {
+		t.Errorf("coverage must be disabled by default")
+	}
+}
+
+func TestMaxFutureSkew(t *testing.T) {
+	now := time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC)
+	runtime.Now = func() time.Time { return now }
+	defer func() { runtime.Now = time.Now }()
+
+	tests := []Test{
+		{
+			input:            json.RawMessage([]byte(`{"name": "first", "timestamp": "2018-10-01T12:04:59Z"}`)),
+			validateFunction: validate_Users_Create_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         false,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"name": "first", "timestamp": "2017-10-01T12:00:00Z"}`)),
+			validateFunction: validate_Users_Create_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         false,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"name": "first", "timestamp": "2018-10-01T12:05:01Z"}`)),
+			validateFunction: validate_Users_Create_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         true,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"name": "first", "timestamp": "yesterday"}`)),
+			validateFunction: validate_Users_Create_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         true,
+		},
+	}
+
+	for n, test := range tests {
+		err := test.validateFunction(test.context, test.input)
+		if err == nil && test.negative {
+			t.Errorf(" %d test failed, error must be not nil \n", n+1)
+		}
+
+		if err != nil && !test.negative {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+	}
+}
+
+func TestNestedRequiredFields(t *testing.T) {
+	tests := []Test{
+		{
+			input:            json.RawMessage([]byte(`{"name": "first"}`)),
+			validateFunction: validate_Users_Create_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         false,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"name": "first", "primary_group": null}`)),
+			validateFunction: validate_Users_Create_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         false,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"name": "first", "primary_group": {}}`)),
+			validateFunction: validate_Users_Create_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         true,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"name": "first", "primary_group": {"name": "admins"}}`)),
+			validateFunction: validate_Users_Create_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         false,
+		},
+	}
+
+	for n, test := range tests {
+		err := test.validateFunction(test.context, test.input)
+		if err == nil && test.negative {
+			t.Errorf(" %d test failed, error must be not nil \n", n+1)
+		}
+
+		if err != nil && !test.negative {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+	}
+}
+
+func TestJSONPointerPaths(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    json.RawMessage
+		validate func(ctx context.Context, r json.RawMessage, path string) error
+		expected string
+	}{
+		{
+			input:    json.RawMessage(`{"name": "first", "groups": [{"name": "admins"}, {}]}`),
+			validate: (&User{}).AtlasValidateJSON,
+			expected: `field "groups.[1].name" is required for "POST" operation.`,
+		},
+		{
+			input:    json.RawMessage(`{"address": {"a/b~c": 1}}`),
+			validate: (&external.ExternalUser{}).AtlasValidateJSON,
+			expected: `unknown field "/address/a~1b~0c".`,
+		},
+		{
+			input:    json.RawMessage(`{"addresses": [{"city": "Tacoma"}, {"town": "Tacoma"}]}`),
+			validate: (&external.ExternalUser{}).AtlasValidateJSON,
+			expected: `unknown field "/addresses/1/town".`,
+		},
+	}
+
+	for n, test := range tests {
+		err := test.validate(ctx, test.input, "")
+		if err == nil || err.Error() != test.expected {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestCELExpressions(t *testing.T) {
+	tests := []Test{
+		{
+			input:            json.RawMessage([]byte(`{"name": "first", "notes": "some notes"}`)),
+			validateFunction: validate_Groups_Create_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         false,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"name": "first"}`)),
+			validateFunction: validate_Groups_Create_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         false,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"name": "first", "notes": "first"}`)),
+			validateFunction: validate_Groups_Create_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"),
+			negative:         true,
+		},
+		{
+			input:            json.RawMessage([]byte(`{"id": 1, "name": "first", "notes": "first"}`)),
+			validateFunction: validate_Groups_Update_0,
+			context:          context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "PUT"),
+			negative:         true,
+		},
+	}
+
+	for n, test := range tests {
+		err := test.validateFunction(test.context, test.input)
+		if err == nil && test.negative {
+			t.Errorf(" %d test failed, error must be not nil \n", n+1)
+		}
+
+		if err != nil && !test.negative {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+	}
+}
+
+func TestQueryParams(t *testing.T) {
+	now := time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC)
+	runtime.Now = func() time.Time { return now }
+	defer func() { runtime.Now = time.Now }()
+
+	tests := []struct {
+		query    string
+		negative bool
+	}{
+		{query: "page_size=50&filter=name==first&ids=1&ids=2", negative: false},
+		{query: "pageSize=50&address.city=Tacoma&active=true", negative: false},
+		{query: "page_size=50&unknown=1", negative: true},
+		{query: "address.unknown=1", negative: true},
+		{query: "createdBefore=2018-10-01T12:30:00Z", negative: false},
+		{query: "page_size=fifty", negative: true},
+		{query: "page_size=50&page_size=10", negative: true},
+		{query: "ids=1&ids=two", negative: true},
+		{query: "active=maybe", negative: true},
+		{query: "address=Tacoma", negative: true},
+		{query: "filter.name=first", negative: true},
+		{query: "created_before=yesterday", negative: true},
+		{query: "created_before=2018-10-01T14:00:00Z", negative: true},
+	}
+
+	for n, test := range tests {
+		r := httptest.NewRequest("GET", "/users/search?"+test.query, nil)
+		md := AtlasValidateAnnotator(context.Background(), r)
+		errs := md.Get("Atlas-Validation-Error")
+		if len(errs) == 0 && test.negative {
+			t.Errorf(" %d test failed, error must be not nil \n", n+1)
+		}
+
+		if len(errs) != 0 && !test.negative {
+			t.Errorf(" %d test failed, error %s \n", n+1, errs[0])
+		}
+	}
+}
+
+func TestRepeatedNestedMaps(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    json.RawMessage
+		expected string
+	}{
+		{
+			input:    json.RawMessage(`{"rules": [{}, {"labels": {"env": "prod"}}, {"labels": {"env": 1}}]}`),
+			expected: `invalid value for "rules.[2].labels.env": expected string.`,
+		},
+		{
+			input:    json.RawMessage(`{"rules": [{"labels": ["env"]}]}`),
+			expected: `invalid value for "rules.[0].labels": expected map.`,
+		},
+		{
+			input:    json.RawMessage(`{"rules": [{"labels": {"env": "prod", "Env": "dev"}}]}`),
+			expected: `invalid map key "Env" at "rules.[0].labels"`,
+		},
+		{
+			input:    json.RawMessage(`{"rules": [{"labels": {"-env": null}}]}`),
+			expected: `invalid map key "-env" at "rules.[0].labels"`,
+		},
+		{
+			input:    json.RawMessage(`{"rules": [{"groups": {"admins": {"name": "admins"}}}]}`),
+			expected: `invalid value for "rules.[0].groups.admins": expected int32 key.`,
+		},
+		{
+			input:    json.RawMessage(`{"rules": [{"groups": {"1": {"id": 1}}}]}`),
+			expected: `field "rules.[0].groups.1.name" is required for "POST" operation.`,
+		},
+		{
+			input:    json.RawMessage(`{"rules": [{"groups": {"1": {"name": "admins", "unknown": 1}}}]}`),
+			expected: `unknown field "rules.[0].groups.1.unknown".`,
+		},
+		{
+			input:    json.RawMessage(`{"rules": [{"tiers": {"basic": "TIER_FREE", "premium": "TIER_GOLD"}}]}`),
+			expected: `invalid value for "rules.[0].tiers.premium": "TIER_GOLD" is not a valid Tier`,
+		},
+		{
+			input:    json.RawMessage(`{"rules": [{"conditions": {"a": {"expression": "x"}, "b": {}}}]}`),
+			expected: `field "rules.[0].conditions.b.expression" is required for "POST" operation.`,
+		},
+		{
+			input:    json.RawMessage(`{"rules": [{"conditions": {"a": {"expression": "x", "unknown": 1}}}]}`),
+			expected: `unknown field "rules.[0].conditions.a.unknown".`,
+		},
+		{
+			input: json.RawMessage(`{"rules": [{"labels": {"env": "prod", "app-1": "web"}, "groups": {"1": {"name": "admins"}}}, {"labels": null}]}`),
+		},
+		{
+			input: json.RawMessage(`{"rules": [{"tiers": {"basic": "TIER_FREE", "premium": 1, "none": null}}]}`),
+		},
+	}
+
+	for n, test := range tests {
+		err := (&Policy{}).AtlasValidateJSON(ctx, test.input, "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestValidationCache(t *testing.T) {
+	now := time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC)
+	runtime.Now = func() time.Time { return now }
+	defer func() { runtime.Now = time.Now }()
+
+	runtime.EnableValidationCache(1)
+	defer runtime.EnableValidationCache(0)
+
+	first := `{"name": "first", "timestamp": "2018-10-01T12:04:00Z"}`
+	second := `{"name": "second", "timestamp": "2018-10-01T12:04:00Z"}`
+
+	annotate := func(body string) []string {
+		r := httptest.NewRequest("POST", "/users", strings.NewReader(body))
+		return AtlasValidateAnnotator(context.Background(), r).Get("Atlas-Validation-Error")
+	}
+
+	if errs := annotate(first); len(errs) != 0 {
+		t.Fatalf("unexpected error %s", errs[0])
+	}
+
+	// the timestamp is too far in the future now, but the cached result is used
+	now = now.Add(-10 * time.Minute)
+	if errs := annotate(first); len(errs) != 0 {
+		t.Errorf("cached request must not be validated, got %s", errs[0])
+	}
+
+	if errs := annotate(second); len(errs) == 0 {
+		t.Errorf("request that is not cached must be validated")
+	}
+
+	now = now.Add(10 * time.Minute)
+	if errs := annotate(second); len(errs) != 0 {
+		t.Fatalf("unexpected error %s", errs[0])
+	}
+
+	// the first request is evicted by the second one
+	now = now.Add(-10 * time.Minute)
+	if errs := annotate(first); len(errs) == 0 {
+		t.Errorf("evicted request must be validated")
+	}
+
+	// hooks depend on context of a request, so their requests are not cached
+	calls := 0
+	ctx := context.WithValue(context.Background(), hookContextKey{}, func(context.Context) { calls++ })
+	for i := 0; i < 2; i++ {
+		r := httptest.NewRequest("POST", "/configs", strings.NewReader(`{"version": 1}`))
+		if errs := AtlasValidateAnnotator(ctx, r).Get("Atlas-Validation-Error"); len(errs) != 0 {
+			t.Fatalf("unexpected error %s", errs[0])
+		}
+	}
+	if calls != 2 {
+		t.Errorf("hook must be called for every request, called %d times", calls)
+	}
+}
+
+func TestEnumFields(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"status": "STATUS_ACTIVE", "roles": ["ROLE_ADMIN", 2]}`},
+		{input: `{"status": 2, "roles": null}`},
+		{input: `{"status": "ACTIVE", "statuses": {"a": "inactive", "b": "Status_Unknown"}}`},
+		{input: `{"status": null}`},
+		{input: `{"status": "FROOBAR"}`, expected: `invalid value for "status": "FROOBAR" is not a valid Status`},
+		{input: `{"status": 3}`, expected: `invalid value for "status": 3 is not a valid Status`},
+		{input: `{"roles": ["ROLE_ADMIN", "ADMIN"]}`, expected: `invalid value for "roles.[1]": "ADMIN" is not a valid Role`},
+		{input: `{"roles": ["role_member"]}`, expected: `invalid value for "roles.[0]": "role_member" is not a valid Role`},
+		{input: `{"statuses": {"a": "DELETED"}}`, expected: `invalid value for "statuses.a": "DELETED" is not a valid Status`},
+	}
+
+	for n, test := range tests {
+		err := validate_Profiles_Create_0(ctx, json.RawMessage(test.input))
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+
+	out, err := default_Profiles_Create_0(ctx, json.RawMessage(`{"notes": "x", "status": "active", "statuses": {"a": "INACTIVE"}, "roles": ["ROLE_ADMIN"]}`))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	expected := `{"notes":"x","roles":["ROLE_ADMIN"],"status":"STATUS_ACTIVE","statuses":{"a":"STATUS_INACTIVE"}}`
+	if string(out) != expected {
+		t.Errorf("expected %s, got %s", expected, out)
+	}
+}
+
+func TestMaxFields(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+	ctx = context.WithValue(ctx, runtime.AllowUnknownContextKey, true)
+
+	if err := validate_Groups_Create_0(ctx, json.RawMessage(`{"name": "a", "b": 1, "c": 2, "d": 3, "e": 4}`)); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+
+	err := validate_Groups_Create_0(ctx, json.RawMessage(`{"name": "a", "b": 1, "c": 2, "d": 3, "e": 4, "f": 5}`))
+	if expected := `object "" has too many fields`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+
+	err = (&external.ExternalUser{}).AtlasValidateJSON(ctx, json.RawMessage(`{"address": {"a": 1, "b": 2, "c": 3, "d": 4, "e": 5, "f": 6, "g": 7, "h": 8, "i": 9}}`), "/user")
+	if expected := `object "/user/address" has too many fields`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+}
+
+func TestFormatFields(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"digest_schedule": "*/15 9-17 * * MON-FRI"}`},
+		{input: `{"digest_schedule": "0 0 1,15 jan,jul ?"}`},
+		{input: `{"digest_schedule": "@daily", "reminders": ["30 0 8 * * 7", "@hourly"]}`},
+		{input: `{"digest_schedule": null, "reminders": null}`},
+		{input: `{"digest_schedule": "0 0 * *"}`, expected: `field "digest_schedule" must be a valid cron expression`},
+		{input: `{"digest_schedule": "60 0 * * *"}`, expected: `field "digest_schedule" must be a valid cron expression`},
+		{input: `{"digest_schedule": "0 0 31-1 * *"}`, expected: `field "digest_schedule" must be a valid cron expression`},
+		{input: `{"digest_schedule": "*/0 * * * *"}`, expected: `field "digest_schedule" must be a valid cron expression`},
+		{input: `{"digest_schedule": "0 ? * * *"}`, expected: `field "digest_schedule" must be a valid cron expression`},
+		{input: `{"digest_schedule": 5}`, expected: `invalid value for "digest_schedule": expected string.`},
+		{input: `{"reminders": ["0 8 * * *"]}`, expected: `field "reminders.[0]" must be a valid cron expression`},
+		{input: `{"device_mac": "01:23:45:67:89:AB"}`},
+		{input: `{"device_mac": "01-23-45-67-89-ab-cd-ef"}`},
+		{input: `{"device_mac": "0123.4567.89ab"}`},
+		{input: `{"device_mac": "01:23:45:67:89"}`, expected: `field "device_mac" must be a valid MAC address`},
+		{input: `{"device_mac": "01:23:45:67:89:zz"}`, expected: `field "device_mac" must be a valid MAC address`},
+		{input: `{"device_mac": "00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01"}`, expected: `field "device_mac" must be a valid MAC address`},
+		{input: `{"email": "user@example.com"}`},
+		{input: `{"email": null}`},
+		{input: `{"email": "user.example.com"}`, expected: `field "email" does not match required pattern`},
+		{input: `{"email": "a@b@c"}`, expected: `field "email" does not match required pattern`},
+	}
+
+	for n, test := range tests {
+		err := validate_Profiles_Create_0(ctx, json.RawMessage(test.input))
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+
+	err := validate_Profiles_Create_0(ctx, json.RawMessage(`{"color": "#fff"}`))
+	if expected := `field "color" has unknown format "hex_color"`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+
+	runtime.RegisterFormat("hex_color", "hex color", func(s string) bool { return len(s) == 7 && s[0] == '#' })
+
+	if err := validate_Profiles_Create_0(ctx, json.RawMessage(`{"color": "#ffffff"}`)); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+
+	err = validate_Profiles_Create_0(ctx, json.RawMessage(`{"color": "#fff"}`))
+	if expected := `field "color" must be a valid hex color`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+}
+
+func TestDenyAllowedMethods(t *testing.T) {
+	tests := []struct {
+		input            string
+		validateFunction func(ctx context.Context, message json.RawMessage) error
+		method           string
+		expected         string
+	}{
+		{`{"id": 1, "name": "first"}`, validate_Users_Create_0, "POST", `field "id" is unsupported for "POST" operation; allowed: [LINK, PATCH, PUT, SEARCH].`},
+		{`{"name": "first"}`, validate_Profiles_Update_0, "PUT", `field "name" is unsupported for "PUT" operation; allowed: [LINK, POST, SEARCH].`},
+		{`{"name": "first", "address": {"state": "CA"}}`, validate_Users_Create_0, "POST", `field "state" is unsupported for "POST" operation; allowed: [LINK, SEARCH].`},
+	}
+
+	for n, test := range tests {
+		ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, test.method)
+		if err := test.validateFunction(ctx, json.RawMessage(test.input)); err == nil || err.Error() != test.expected {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestIgnoreExtraFields(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"name": "first", "address": {"city": "Tacoma", "_links": {"self": "/addresses/1"}, "_etag": "W/\"1\""}}`},
+		{input: `{"name": "first", "address": {"_links": 1}}`},
+		{input: `{"name": "first", "address": {"city": "Tacoma", "_meta": {}}}`, expected: `unknown field "address._meta".`},
+		{input: `{"name": "first", "_links": {}}`, expected: `unknown field "_links".`},
+	}
+
+	for n, test := range tests {
+		err := validate_Users_Create_0(ctx, json.RawMessage(test.input))
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestMaxFieldBytes(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+	notes := strings.Repeat("a", 62)
+
+	if err := validate_Groups_Create_0(ctx, json.RawMessage(`{"name": "a", "notes": "`+notes+`"}`)); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+
+	err := validate_Groups_Create_0(ctx, json.RawMessage(`{"name": "a", "notes": "`+notes+`\n"}`))
+	if expected := `field "notes" is too large`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+
+	err = validate_Users_Create_0(ctx, json.RawMessage(`{"name": "a", "groups": [{"name": "b", "notes": "`+notes+`aa"}]}`))
+	if expected := `field "groups.[0].notes" is too large`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+}
+
+func TestMaxLength(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"name": "a", "tags": ["abcdefgh", "ünïcödé"], "avatar": "AQIDBA=="}`},
+		{input: `{"name": "a", "tags": null, "avatar": null}`},
+		{input: `{"name": "a", "tags": ["a", "abcdefghi"]}`, expected: `field "tags.[1]" exceeds max length 8`},
+		{input: `{"name": "a", "avatar": "AQIDBAU="}`, expected: `field "avatar" exceeds max length 4`},
+		{input: `{"name": "a", "groups": [{"name": "b", "tags": ["abcdefghi"]}]}`, expected: `field "groups.[0].tags.[0]" exceeds max length 8`},
+	}
+
+	for n, test := range tests {
+		validate := validate_Groups_Create_0
+		if strings.Contains(test.input, "groups") {
+			validate = validate_Users_Create_0
+		}
+
+		err := validate(ctx, json.RawMessage(test.input))
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"age": 0, "offset": "-9007199254740992", "size": "18446744073709551615", "weights": [0, 0.5]}`},
+		{input: `{"age": 150, "offset": 9007199254740992, "size": 1, "weights": null}`},
+		{input: `{"age": null, "offset": null, "size": null}`},
+		{input: `{"age": -1}`, expected: `field "age" must be >= 0`},
+		{input: `{"age": "151"}`, expected: `field "age" must be <= 150`},
+		{input: `{"offset": "9007199254740993"}`, expected: `field "offset" must be <= 9.007199254740992e+15`},
+		{input: `{"size": "0"}`, expected: `field "size" must be >= 1`},
+		{input: `{"weights": [0.25, -0.1]}`, expected: `field "weights.[1]" must be >= 0`},
+		{input: `{"weights": ["Infinity"]}`, expected: `field "weights.[0]" must be <= 0.5`},
+		{input: `{"weights": ["NaN"]}`, expected: `field "weights.[0]" must be >= 0`},
+		{input: `{"limit": 1, "aliases": ["a", null], "active": true}`},
+		{input: `{"limit": "10", "aliases": null, "active": null}`},
+		{input: `{"limit": 0}`, expected: `field "limit" must be >= 1`},
+		{input: `{"limit": true}`, expected: `invalid value for "limit": expected int32.`},
+		{input: `{"limit": {"value": 1}}`, expected: `invalid value for "limit": expected int32.`},
+		{input: `{"aliases": ["a", 1]}`, expected: `invalid value for "aliases.[1]": expected string.`},
+		{input: `{"active": "yes"}`, expected: `invalid value for "active": expected bool.`},
+		// example/examplepb is generated with strict_wkt=true
+		{input: `{"interval": "3.5s", "attrs": {"a": [1]}, "times": ["2018-10-01T12:00:00Z", null]}`},
+		{input: `{"interval": null, "attrs": null, "times": null}`},
+		{input: `{"attrs": {"atlas_validate_unknown_field": {"nested": [null, {"x": 1}]}}}`},
+		{input: `{"interval": "3.5"}`, expected: `invalid value for "interval": expected duration.`},
+		{input: `{"attrs": [1]}`, expected: `invalid value for "attrs": expected struct.`},
+		{input: `{"times": ["2018-10-01"]}`, expected: `invalid value for "times.[0]": expected timestamp.`},
+	}
+
+	for n, test := range tests {
+		err := (&Measurement{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+// testRegistrar routes requests the way runtime.Registrar documents it: to the
+// first validator registered for a method and a matching path.
+type testRegistrar struct {
+	methods    []string
+	patterns   []gwruntime.Pattern
+	validators []runtime.Validator
+}
+
+func (r *testRegistrar) RegisterValidator(httpMethod string, pattern gwruntime.Pattern, validator runtime.Validator) {
+	r.methods = append(r.methods, httpMethod)
+	r.patterns = append(r.patterns, pattern)
+	r.validators = append(r.validators, validator)
+}
+
+func (r *testRegistrar) validate(method, path, body string) error {
+	for i, m := range r.methods {
+		if m == method && runtime.PatternMatch(r.patterns[i], path) {
+			return r.validators[i](context.Background(), path, json.RawMessage(body))
+		}
+	}
+	return nil
+}
+
+func TestRegisterAtlasValidators(t *testing.T) {
+	reg := &testRegistrar{}
+	RegisterAtlasValidators(reg)
+	if len(reg.methods) != len(AtlasValidatePatterns()) {
+		t.Fatalf("expected %d registered validators, got %d", len(AtlasValidatePatterns()), len(reg.methods))
+	}
+
+	tests := []struct {
+		method   string
+		path     string
+		body     string
+		expected string
+	}{
+		{method: "POST", path: "/users", body: `{"name": "a",}`},
+		{method: "POST", path: "/users", body: `{"name": "a", "foo": 1}`, expected: `unknown field "foo".`},
+		{method: "POST", path: "/users", body: `{}`, expected: `field "name" is required for "POST" operation.`},
+		{method: "GET", path: "/users_get", body: `{"a": 1}`, expected: `body is not allowed`},
+		{method: "POST", path: "/unknown", body: `{"foo": 1}`},
+	}
+
+	for n, test := range tests {
+		var msg string
+		if err := reg.validate(test.method, test.path, test.body); err != nil {
+			msg = err.Error()
+		}
+		if msg != test.expected {
+			t.Errorf(" %d test failed, expected error %q, got %q \n", n+1, test.expected, msg)
+		}
+	}
+
+	// a validator called for a path its pattern does not match validates nothing
+	for _, path := range []string{"", "users", "/unknown"} {
+		for i, validator := range reg.validators {
+			if err := validator(context.Background(), path, json.RawMessage(`{"foo": 1}`)); err != nil {
+				t.Errorf("validator %d must not validate path %q, got %s", i, path, err)
+			}
+		}
+	}
+}
+
+func TestOptInHeader(t *testing.T) {
+	tests := []struct {
+		header   string
+		validate bool
+	}{
+		{header: "", validate: false},
+		{header: "false", validate: false},
+		{header: "yes", validate: false},
+		{header: "true", validate: true},
+		{header: "1", validate: true},
+	}
+
+	for n, test := range tests {
+		r := httptest.NewRequest("POST", "/external/accounts", strings.NewReader(`{"id": 1}`))
+		if test.header != "" {
+			r.Header.Set("X-Atlas-Validate", test.header)
+		}
+		md := external.AtlasValidateAnnotator(context.Background(), r)
+		if validated := len(md.Get("Atlas-Validation-Method")) != 0; validated != test.validate {
+			t.Errorf(" %d test failed, expected validated %t, got %t \n", n+1, test.validate, validated)
+		}
+		if errs := md.Get("Atlas-Validation-Error"); (len(errs) != 0) != test.validate {
+			t.Errorf(" %d test failed, unexpected errors %q \n", n+1, errs)
+		}
+	}
+}
+
+func TestAtlasValidateReader(t *testing.T) {
+	tests := []struct {
+		method   string
+		path     string
+		body     string
+		expected string
+	}{
+		{method: "POST", path: "/users", body: `{"name": "a",}`},
+		{method: "POST", path: "/users", body: `{"name": "a", "foo": 1}`, expected: `unknown field "foo".`},
+		{method: "POST", path: "/users", body: `{"name": "a"`, expected: `invalid value for "": expected object.`},
+		{method: "GET", path: "/users_get", body: `{"a": 1}`, expected: `body is not allowed`},
+		{method: "POST", path: "/unknown", body: `{"foo": 1}`},
+		{method: "POST", path: "", body: `{"foo": 1}`},
+		{method: "POST", path: "users", body: `{"foo": 1}`},
+	}
+
+	for n, test := range tests {
+		var msg string
+		if err := AtlasValidateReader(context.Background(), test.method, test.path, strings.NewReader(test.body)); err != nil {
+			msg = err.Error()
+		}
+		if msg != test.expected {
+			t.Errorf(" %d test failed, expected error %q, got %q \n", n+1, test.expected, msg)
+		}
+	}
+
+	tail := []struct {
+		input    string
+		expected string
+	}{
+		{input: ` {"a": [1]} `, expected: `{"a": [1]}`},
+		{input: ``},
+		{input: `{"a": 1} {"b": 2}`},
+		{input: `{"a": 1`},
+	}
+	for n, test := range tail {
+		b, err := runtime.ReadJSON(strings.NewReader(test.input))
+		if string(b) != test.expected || (err == nil) != (test.expected != "" || test.input == "") {
+			t.Errorf(" %d test failed, got %q, %v \n", n+1, b, err)
+		}
+	}
+}
+
+func TestPatternsByMethod(t *testing.T) {
+	n := 0
+	for method, indexes := range validate_PatternsByMethod {
+		for i, index := range indexes {
+			if validate_Patterns[index].httpMethod != method || (i != 0 && index <= indexes[i-1]) {
+				t.Errorf("%s: unexpected pattern index %d", method, index)
+			}
+		}
+		n += len(indexes)
+	}
+	if n != len(validate_Patterns) {
+		t.Errorf("expected %d indexed patterns, got %d", len(validate_Patterns), n)
+	}
+}
+
+func TestConstraints(t *testing.T) {
+	c, ok := AtlasValidateConstraints["examplepb.User.name"]
+	if !ok || !reflect.DeepEqual(c.Required, []string{"PATCH", "POST", "PUT"}) {
+		t.Errorf("unexpected constraints %+v", c)
+	}
+
+	c = AtlasValidateConstraints["examplepb.Measurement.age"]
+	if c.Min == nil || *c.Min != 0 || c.Max == nil || *c.Max != 150 {
+		t.Errorf("unexpected constraints %+v", c)
+	}
+
+	if c = AtlasValidateConstraints["examplepb.User.created_by"]; !c.ReadOnly || !reflect.DeepEqual(c.Denied, []string{"LINK", "PATCH", "POST", "PUT", "SEARCH"}) {
+		t.Errorf("unexpected constraints %+v", c)
+	}
+
+	if _, ok := AtlasValidateConstraints["examplepb.User.profile"]; ok {
+		t.Error("unexpected constraints of a field without options")
+	}
+}
+
+func TestSelfTest(t *testing.T) {
+	if err := AtlasValidateSelfTest(); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+
+	err := runtime.SelfTest(pattern_Users_Create_0, "POST", false, func(context.Context, json.RawMessage) error { panic("boom") }, nil, nil, nil)
+	if expected := `self-test: POST /users: panic on empty request: boom`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+
+	err = runtime.SelfTest(pattern_Users_Create_0, "POST", false, nil, nil, nil, nil)
+	if expected := `self-test: POST /users: validator is not set`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+}
+
+func TestAcceptsJSON(t *testing.T) {
+	tests := map[string]bool{
+		"":                                   true,
+		"application/json":                   true,
+		"text/html, application/*;q=0.5":     true,
+		"application/problem+json":           true,
+		"APPLICATION/JSON; charset=utf-8":    true,
+		"application/octet-stream":           false,
+		"application/json;q=0, text/plain":   false,
+		"text/event-stream, application/xml": false,
+	}
+
+	for accept, expected := range tests {
+		if runtime.AcceptsJSON(accept) != expected {
+			t.Errorf("AcceptsJSON(%q) expected %t", accept, expected)
+		}
+	}
+}
+
+func TestIntegerBoolean(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"name": "first", "profile": {"id": 1}}`},
+		{input: `{"name": "first", "profile": {"id": "1"}}`},
+		{input: `{"name": "first", "profile": {"id": true}}`, expected: `field "profile.id": expected integer`},
+		{input: `{"name": "first", "groups": [{"name": "g", "id": false}]}`, expected: `field "groups.[0].id": expected integer`},
+	}
+
+	for n, test := range tests {
+		err := validate_Users_Create_0(ctx, json.RawMessage(test.input))
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+
+	u := url.Values{"ids": []string{"1", "true"}}
+	r := httptest.NewRequest("GET", "/users/search?"+u.Encode(), nil)
+	if errs := AtlasValidateAnnotator(context.Background(), r).Get("Atlas-Validation-Error"); len(errs) == 0 {
+		t.Errorf("boolean query parameter of an integer field must be rejected")
+	}
+}
+
+func TestRequiredEmptyString(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	// presence is enough by default
+	if err := validate_Users_Create_0(ctx, json.RawMessage(`{"name": ""}`)); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+
+	// example_multi.proto sets required_rejects_empty_string
+	if err := validate_Users2_Create2_0(ctx, json.RawMessage(`{"name": "second", "display_name": "Second"}`)); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+
+	for _, input := range []string{`{"name": "", "display_name": "Second"}`, `{"display_name": "Second"}`} {
+		err := validate_Users2_Create2_0(ctx, json.RawMessage(input))
+		if expected := `field "name" is required for "POST" operation.`; err == nil || err.Error() != expected {
+			t.Errorf("%s: expected %s, got %v", input, expected, err)
+		}
+	}
+}
+
+func TestInSet(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	err := validate_Users_Create_0(ctx, json.RawMessage(`{"name": "first", "address": {"region": "us-west"}}`))
+	if expected := `field "address.region" references unknown value set "regions"`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+
+	runtime.RegisterValueSet("regions", []string{"us-west", "us-east"})
+	runtime.RegisterValueSet("languages", []string{"en", "de"})
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"name": "first", "address": {"region": "us-west", "languages": ["en", "de"]}}`},
+		{input: `{"name": "first", "address": {"region": null, "languages": null}}`},
+		{input: `{"name": "first", "address": {"region": "eu-central"}}`, expected: `field "address.region" must be one of the allowed values`},
+		{input: `{"name": "first", "address": {"region": 1}}`, expected: `invalid value for "address.region": expected string.`},
+		{input: `{"name": "first", "address": {"languages": ["en", "fr"]}}`, expected: `field "address.languages.[1]" must be one of the allowed values`},
+	}
+
+	for n, test := range tests {
+		err := validate_Users_Create_0(ctx, json.RawMessage(test.input))
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+
+	// sets can be replaced without regeneration
+	runtime.RegisterValueSet("regions", []string{"eu-central"})
+	if err := validate_Users_Create_0(ctx, json.RawMessage(`{"name": "first", "address": {"region": "eu-central"}}`)); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+
+	// a request cached by the annotator is validated again after a set it uses is
+	// replaced
+	runtime.EnableValidationCache(8)
+	defer runtime.EnableValidationCache(0)
+	annotate := func() []string {
+		r := httptest.NewRequest("POST", "/users", strings.NewReader(`{"name": "first", "address": {"region": "eu-central"}}`))
+		return AtlasValidateAnnotator(context.Background(), r).Get("Atlas-Validation-Error")
+	}
+	if errs := annotate(); len(errs) != 0 {
+		t.Fatalf("unexpected error %s", errs[0])
+	}
+	runtime.RegisterValueSet("regions", []string{"eu"})
+	if errs := annotate(); len(errs) == 0 {
+		t.Errorf("request cached before the set was replaced must be validated")
+	}
+	runtime.RegisterValueSet("regions", []string{"eu-central"})
+	if errs := annotate(); len(errs) != 0 {
+		t.Errorf("unexpected error %s", errs[0])
+	}
+}
+
+func TestTenantPolicy(t *testing.T) {
+	runtime.SetTenantPolicyResolver(func(tenantID string) runtime.TenantPolicy {
+		if tenantID == "acme" {
+			return runtime.TenantPolicy{"examplepb.User.name.required": false, "examplepb.User.id.deny": true}
+		}
+		return nil
+	})
+	defer runtime.SetTenantPolicyResolver(nil)
+
+	runtime.SetRulePolicy(func(ctx context.Context, ruleID string) bool { return ruleID != "examplepb.User.id.deny" })
+	defer runtime.SetRulePolicy(nil)
+
+	annotate := func(tenantID string, body string) []string {
+		ctx := context.WithValue(context.Background(), runtime.TenantIDContextKey, tenantID)
+		r := httptest.NewRequest("POST", "/users", strings.NewReader(body))
+		return AtlasValidateAnnotator(ctx, r).Get("Atlas-Validation-Error")
+	}
+
+	if errs := annotate("acme", `{}`); len(errs) != 0 {
+		t.Errorf("unexpected error %s", errs[0])
+	}
+
+	if errs := annotate("other", `{}`); len(errs) == 0 {
+		t.Errorf("required field must be enforced for a tenant without overrides")
+	}
+
+	// rule policy disables deny, the tenant override enables it back
+	if errs := annotate("other", `{"id": 1, "name": "first"}`); len(errs) != 0 {
+		t.Errorf("unexpected error %s", errs[0])
+	}
+
+	if errs := annotate("acme", `{"id": 1, "name": "first"}`); len(errs) == 0 {
+		t.Errorf("tenant override must take precedence over rule policy")
+	}
+}
+
+func TestNestedArrays(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"rows": [{"cells": [{"value": "a"}, {"value": "b", "span": 2}]}, {"cells": []}, {}]}`},
+		{input: `{"rows": [{"cells": [{}]}, {"cells": [{}, {}, {}, {"span": true}]}]}`, expected: `field "rows.[1].cells.[3].span": expected integer`},
+		{input: `{"rows": [{"cells": [{"colour": "red"}]}]}`, expected: `unknown field "rows.[0].cells.[0].colour".`},
+		{input: `{"rows": [{"cells": {"value": "a"}}]}`, expected: `invalid value for "rows.[0].cells": expected array.`},
+		{input: `{"rows": [{"cells": ["a"]}]}`, expected: `invalid value for "rows.[0].cells.[0]": expected object.`},
+	}
+
+	for n, test := range tests {
+		err := (&Table{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestFormBody(t *testing.T) {
+	tests := []struct {
+		method   string
+		path     string
+		body     string
+		expected string
+	}{
+		{method: "POST", path: "/users", body: "name=first&profile.id=1&address.city=Tacoma"},
+		{method: "POST", path: "/users", body: "name=first&profile.id=abc", expected: `form field "profile.id": expected int32.`},
+		{method: "POST", path: "/users", body: "name=first&name=second", expected: `form field "name" must have a single value.`},
+		{method: "POST", path: "/users", body: "name=first&nickname=f", expected: `unknown field "nickname".`},
+		{method: "POST", path: "/users", body: "profile=1", expected: `form field "profile": expected a nested field.`},
+		{method: "POST", path: "/users", body: "name=first&address.tags[env]=prod"},
+		{method: "POST", path: "/users", body: "name=first&address.tags=prod", expected: `form field "address.tags": expected a map entry.`},
+		{method: "PUT", path: "/profiles/1", body: "id=1&nickname=f"},
+		{method: "POST", path: "/users", body: "name=%zz", expected: `invalid value: unable to parse form body`},
+		{method: "POST", path: "/users", body: "profile.id=1&address.city=Tacoma", expected: `field "name" is required for "POST" operation.`},
+		{method: "PATCH", path: "/user/1", body: "address.city=Tacoma", expected: `field "name" is required for "PATCH" operation.`},
+	}
+	// options of fields apply to form fields the same way as to query parameters
+	runtime.RegisterValueSet("locales", []string{"en", "de"})
+	tests = append(tests, []struct {
+		method   string
+		path     string
+		body     string
+		expected string
+	}{
+		{method: "POST", path: "/queries", body: "query=a&owner=b&locale=de&refresh_schedule=0+*+*+*+*"},
+		{method: "POST", path: "/queries", body: "query=a&owner=b&locale=fr", expected: `form field "locale" must be one of the allowed values`},
+		{method: "POST", path: "/queries", body: "query=a&owner=b&refresh_schedule=hourly", expected: `form field "refresh_schedule" must be a valid cron expression`},
+		{method: "POST", path: "/users", body: "name=first&profile.digest_schedule=0+9+*+*+*"},
+		{method: "POST", path: "/users", body: "name=first&profile.digest_schedule=9am", expected: `form field "profile.digest_schedule" must be a valid cron expression`},
+	}...)
+
+	for n, test := range tests {
+		r := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		errs := AtlasValidateAnnotator(context.Background(), r).Get("Atlas-Validation-Error")
+		if test.expected == "" && len(errs) != 0 {
+			t.Errorf(" %d test failed, error %s \n", n+1, errs[0])
+		}
+		if test.expected != "" && (len(errs) == 0 || errs[0] != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, errs)
+		}
+	}
+}
+
+func TestOneofMembers(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"email": {"address": "user@example.com"}}`},
+		{input: `{"phone": {"number": "555-0100", "extension": 12}}`},
+		{input: `{"email": null}`},
+		{input: `{"email": {"number": "555-0100"}}`, expected: `field "email.address" is required for "POST" operation.`},
+		{input: `{"phone": {"number": "555-0100", "address": "user@example.com"}}`, expected: `unknown field "phone.address".`},
+		{input: `{"phone": {"number": "555-0100", "extension": true}}`, expected: `field "phone.extension": expected integer`},
+		{input: `{"phone": "555-0100"}`, expected: `invalid value for "phone": expected object.`},
+		{input: `{"email": {"address": "user@example.com"}, "phone": {"number": "555-0100"}}`, expected: `only one of "method" may be set`},
+		{input: `{"email": {"address": "user@example.com"}, "phone": null}`},
+	}
+
+	for n, test := range tests {
+		err := (&Contact{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestUnknownFieldHandler(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	var seen []string
+	runtime.SetUnknownFieldHandler(func(ctx context.Context, path string, field string, raw json.RawMessage) error {
+		seen = append(seen, runtime.JoinPath(path, field)+"="+string(raw))
+		if strings.HasPrefix(field, "x-") {
+			return nil
+		}
+		return fmt.Errorf("field %q is not in x- namespace", runtime.JoinPath(path, field))
+	})
+	defer runtime.SetUnknownFieldHandler(nil)
+
+	if err := validate_Users_Create_0(ctx, json.RawMessage(`{"name": "first", "address": {"x-trace": 1}}`)); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+
+	err := validate_Users_Create_0(ctx, json.RawMessage(`{"name": "first", "nickname": "f"}`))
+	if expected := `field "nickname" is not in x- namespace`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+
+	// ignored extra fields are not passed to the handler
+	if err := validate_Users_Create_0(ctx, json.RawMessage(`{"name": "first", "address": {"_etag": "1"}}`)); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+
+	if expected := []string{"address.x-trace=1", "nickname=\"f\""}; strings.Join(seen, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected %v, got %v", expected, seen)
+	}
+
+	runtime.SetUnknownFieldHandler(nil)
+	err = validate_Users_Create_0(ctx, json.RawMessage(`{"name": "first", "address": {"x-trace": 1}}`))
+	if expected := `unknown field "address.x-trace".`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+}
+
+func TestPathVariable(t *testing.T) {
+	tests := []struct {
+		method   string
+		path     string
+		body     string
+		expected string
+	}{
+		{method: "PUT", path: "/users/5", body: `{"id": 5, "name": "first"}`},
+		{method: "PATCH", path: "/user/5", body: `{"id": "5", "name": "first"}`},
+		{method: "PUT", path: "/users/5", body: `{"name": "first"}`},
+		{method: "PUT", path: "/users/5", body: `{"id": 6, "name": "first"}`, expected: `field "id" must match path variable "payload.id"`},
+		{method: "PATCH", path: "/user/5", body: `{"id": null, "name": "first"}`},
+	}
+
+	for n, test := range tests {
+		r := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+		errs := AtlasValidateAnnotator(context.Background(), r).Get("Atlas-Validation-Error")
+		if test.expected == "" && len(errs) != 0 {
+			t.Errorf(" %d test failed, error %s \n", n+1, errs[0])
+		}
+		if test.expected != "" && (len(errs) == 0 || errs[0] != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, errs)
+		}
+	}
+
+	vars, ok := runtime.PatternVariables(pattern_Users_Update_0, "/users/5")
+	if !ok || vars["payload.id"] != "5" {
+		t.Errorf("expected payload.id variable, got %v", vars)
+	}
+}
+
+func TestMatchJSONNames(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"name": "second", "displayName": "Second", "loginCount": 1}`},
+		{input: `{"name": "second", "display_name": "Second", "login_count": 1}`},
+		{input: `{"name": "second"}`, expected: `field "display_name" is required for "POST" operation.`},
+		{input: `{"name": "second", "displayName": "Second", "loginCount": true}`, expected: `field "login_count": expected integer`},
+		{input: `{"name": "second", "displayName": "Second", "display_name": "Second"}`, expected: `field "display_name" is set twice.`},
+		{input: `{"name": "second", "displayName": "Second", "nickName": "s"}`, expected: `unknown field "nickName".`},
+	}
+
+	for n, test := range tests {
+		err := validate_Users2_Create2_0(ctx, json.RawMessage(test.input))
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestMaxTotalElements(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"rows": [{"cells": [{}, {}, {}]}, {"cells": [{}, {}]}]}`},
+		{input: `{"rows": [{"cells": [{}, {}, {}, {}, {}, {}]}, {}]}`},
+		{input: `{"rows": [{"cells": [{}, {}, {}]}, {"cells": [{}, {}]}, {"cells": [{}]}]}`, expected: `request contains too many elements`},
+		{input: `{"rows": [{}, {}, {}, {}, {}, {}, {}, {}, {}]}`, expected: `request contains too many elements`},
+	}
+
+	for n, test := range tests {
+		err := validate_Tables_Import_0(ctx, json.RawMessage(test.input))
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+
+	// the limit is applied by method entrypoints only
+	input := `{"rows": [{}, {}, {}, {}, {}, {}, {}, {}, {}]}`
+	if err := (&Table{}).AtlasValidateJSON(ctx, json.RawMessage(input), ""); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+}
+
+func TestMaxTotalElementsCollect(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+	ctx = runtime.WithMaxTotalElements(ctx, 1)
+
+	// external.proto is generated with error_codes=true and error_mode=collect parameters
+	input := `{"addresses": [{}, {}], "nickname": "a"}`
+	expected := []runtime.ValidationError{
+		{Field: "/addresses", Code: runtime.CodeInvalid, Message: `request contains too many elements`},
+		{Field: "/nickname", Code: runtime.CodeUnknownField, Message: `unknown field "/nickname".`},
+	}
+
+	errs, _ := (&external.ExternalUser{}).AtlasValidateJSON(ctx, json.RawMessage(input), "").(runtime.Errors)
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), errs)
+	}
+	for i, e := range errs {
+		if ve, ok := e.(*runtime.ValidationError); !ok || *ve != expected[i] {
+			t.Errorf("%d: expected %+v, got %#v", i, expected[i], e)
+		}
+	}
+}
+
+func TestRelaxedJSON(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected string
+	}{
+		{body: `{"name": "first", "profile": {"id": 1}}`},
+		{body: "{\n  // created by cli\n  \"name\": \"first\",\n  \"profile\": {\"id\": 1,},\n}"},
+		{body: `{"name": /* nickname */ "first // not a comment", "parents": [{"name": "p"},]}`},
+		{body: `{"name": "first", "nickname": "f",}`, expected: `unknown field "nickname".`},
+		{body: `{"name": "first",,}`, expected: `invalid value for "": expected object.`},
+		{body: `{"name": "first" /* unterminated`, expected: `invalid value: unable to parse body`},
+	}
+
+	for n, test := range tests {
+		r := httptest.NewRequest("POST", "/users", strings.NewReader(test.body))
+		errs := AtlasValidateAnnotator(context.Background(), r).Get("Atlas-Validation-Error")
+		if test.expected == "" && len(errs) != 0 {
+			t.Errorf(" %d test failed, error %s \n", n+1, errs[0])
+		}
+		if test.expected != "" && (len(errs) == 0 || errs[0] != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, errs)
+		}
+		if test.expected != "" {
+			continue
+		}
+		// normalized body is passed to handlers
+		b, _ := ioutil.ReadAll(r.Body)
+		if !json.Valid(b) || r.ContentLength != int64(len(b)) {
+			t.Errorf(" %d test failed, body %q is not normalized \n", n+1, b)
+		}
+	}
+}
+
+func TestAllowUnknownHierarchy(t *testing.T) {
+	expected := map[string]bool{
+		"POST /users":                  false, // file
+		"PUT /profiles/{payload.id=*}": true,  // method over file
+		"POST /groups":                 true,  // service over file
+		"PUT /groups/{id=*}":           true,  // method without allow_unknown_fields
+		"GET /groups":                  false, // method over service
+		"PUT /wkt_get":                 false, // method over service
+		"POST /compat/one_of":          false, // method without allow_unknown_fields, file
+	}
+
+	actual := make(map[string]bool)
+	for _, v := range AtlasValidatePatterns() {
+		actual[v.HTTPMethod+" "+v.Pattern.String()] = v.AllowUnknown
+	}
+	if n := len(AtlasValidatePatterns()); n != len(validate_Patterns) {
+		t.Errorf("expected %d patterns, got %d", len(validate_Patterns), n)
+	}
+
+	for route, allowUnknown := range expected {
+		if v, ok := actual[route]; !ok || v != allowUnknown {
+			t.Errorf("%s: expected allowUnknown %t, got %t (found %t)", route, allowUnknown, v, ok)
+		}
+	}
+}
+
+func TestMaxTotalTextBytes(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	// example_multi.proto sets max_total_text_bytes to 64, quotes are counted
+	name := strings.Repeat("n", 30)
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"name": "` + name + `", "display_name": "` + name + `", "login_count": 12345678}`},
+		{input: `{"name": "` + name + `", "display_name": null}`},
+		{input: `{"name": "` + name + `", "display_name": "` + name + `x"}`, expected: `total text size exceeds limit`},
+		{input: `{"name": "` + strings.Repeat("n", 60) + `", "display_name": "a"}`, expected: `total text size exceeds limit`},
+	}
+
+	for n, test := range tests {
+		err := validate_Users2_Create2_0(ctx, json.RawMessage(test.input))
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestMaxTotalTextBytesCollect(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+	ctx = runtime.WithMaxTotalTextBytes(ctx, 12)
+
+	// external.proto is generated with error_codes=true and error_mode=collect parameters
+	input := `{"display_name": "abcdef", "name": "abcdef", "nickname": "a"}`
+	expected := []runtime.ValidationError{
+		{Field: "/name", Code: runtime.CodeInvalid, Message: `total text size exceeds limit`},
+		{Field: "/nickname", Code: runtime.CodeUnknownField, Message: `unknown field "/nickname".`},
+	}
+
+	errs, _ := (&external.ExternalUser{}).AtlasValidateJSON(ctx, json.RawMessage(input), "").(runtime.Errors)
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), errs)
+	}
+	for i, e := range errs {
+		if ve, ok := e.(*runtime.ValidationError); !ok || *ve != expected[i] {
+			t.Errorf("%d: expected %+v, got %#v", i, expected[i], e)
+		}
+	}
+}
+
+func TestRequiredForType(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"channels": [{"type": "email", "address": "ops@example.com"}, {"type": "webhook", "url": "https://example.com", "secret": "s"}]}`},
+		{input: `{"channels": [{"type": "sms"}, {"address": "ops@example.com"}]}`},
+		{input: `{"channels": [{"type": "email", "address": "ops@example.com"}, {"type": "email"}]}`, expected: `field "address" is required for type "email" at "channels.[1]"`},
+		{input: `{"channels": [{"type": "webhook", "url": "https://example.com"}]}`, expected: `field "secret" is required for type "webhook" at "channels.[0]"`},
+		{input: `{"channels": [{"type": "webhook", "url": "https://example.com", "secret": "s", "address": "ops@example.com"}]}`},
+	}
+
+	for n, test := range tests {
+		err := (&Notifications{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestNormalization(t *testing.T) {
+	runtime.RegisterNormalizer("trim_name", func(ctx context.Context, body json.RawMessage) (json.RawMessage, error) {
+		var v map[string]json.RawMessage
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, err
+		}
+		var name string
+		if err := json.Unmarshal(v["name"], &name); err != nil || name == strings.TrimSpace(name) {
+			return body, nil
+		}
+		v["name"], _ = json.Marshal(strings.TrimSpace(name))
+		return json.Marshal(v)
+	})
+	defer runtime.RegisterNormalizer("trim_name", nil)
+
+	tests := []struct {
+		body       string
+		normalized []string
+		expected   string
+	}{
+		{body: `{"id": 1, "notes": "some notes"}`, expected: `{"id": 1, "notes": "some notes"}`},
+		{body: `{"id": 1}`, normalized: []string{"defaults"}, expected: `{"id":1,"notes":"n/a"}`},
+		{body: `{"id": 1, "name": " first ", "notes": "some notes"}`, normalized: []string{"trim_name"}, expected: `{"id":1,"name":"first","notes":"some notes"}`},
+		{body: `{"id": 1, "name": " first "}`, normalized: []string{"defaults", "trim_name"}, expected: `{"id":1,"name":"first","notes":"n/a"}`},
+	}
+
+	for n, test := range tests {
+		r := httptest.NewRequest("POST", "/profiles", strings.NewReader(test.body))
+		md := AtlasValidateAnnotator(context.Background(), r)
+		if errs := md.Get("Atlas-Validation-Error"); len(errs) != 0 {
+			t.Errorf(" %d test failed, error %s \n", n+1, errs[0])
+			continue
+		}
+		if normalized := md.Get("Atlas-Validation-Normalized"); fmt.Sprint(normalized) != fmt.Sprint(test.normalized) {
+			t.Errorf(" %d test failed, expected transforms %v, got %v \n", n+1, test.normalized, normalized)
+		}
+		if b, _ := ioutil.ReadAll(r.Body); string(b) != test.expected {
+			t.Errorf(" %d test failed, expected body %s, got %s \n", n+1, test.expected, b)
+		}
+	}
+}
+
+func TestExternalMapValues(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"name": "first", "external_contacts": {"a": {"id": 1, "name": "a"}, "b": {"address": {"city": "Tacoma"}}}}`},
+		{input: `{"name": "first", "external_contacts": null}`},
+		{input: `{"name": "first", "external_contacts": {"a": {"nickname": "a"}}}`, expected: `unknown field "external_contacts.a/nickname".`},
+		{input: `{"name": "first", "external_contacts": {"a": {"address": {"street": "Main"}}}}`, expected: `unknown field "external_contacts.a/address/street".`},
+		{input: `{"name": "first", "external_contacts": {"a": "b"}}`, expected: `invalid value for "external_contacts.a": expected object.`},
+		{input: `{"name": "first", "external_contacts": [{"id": 1}]}`, expected: `invalid value for "external_contacts": expected map.`},
+	}
+
+	for n, test := range tests {
+		err := (&User{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestOneofPath(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	input := `{"email": {}, "phone": {}}`
+	if err := (&Contact{}).AtlasValidateJSON(ctx, json.RawMessage(input), "contacts.[0]"); err == nil || err.Error() != `only one of "contacts.[0].method" may be set` {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestExternalEnums(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"name": "first", "external_kind": "KIND_SERVICE", "external_roles": ["ROLE_OWNER", 0], "external_kinds": {"a": 1, "b": null}}`},
+		{input: `{"name": "first", "external_kind": null, "external_roles": null, "external_kinds": null}`},
+		{input: `{"name": "first", "external_kind": "KIND_ROBOT"}`, expected: `invalid value for "external_kind": "KIND_ROBOT" is not a valid Kind`},
+		{input: `{"name": "first", "external_roles": ["ROLE_MEMBER", 5]}`, expected: `invalid value for "external_roles.[1]": 5 is not a valid ExternalUser_Role`},
+		{input: `{"name": "first", "external_kinds": {"a": "person"}}`, expected: `invalid value for "external_kinds.a": "person" is not a valid Kind`},
+	}
+
+	for n, test := range tests {
+		err := (&User{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+
+	// an enum without registered values is not checked
+	if err := runtime.ValidateRegisteredEnum(json.RawMessage(`"KIND_ROBOT"`), "kind", "external.Unregistered"); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+}
+
+func TestCollectErrors(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	// example/external is generated with error_mode=collect
+	input := `{"id": true, "nickname": "a", "address": {"street": "Main", "zip": "98402"}, "addresses": [{"city": "Tacoma"}, {"country": 1, "floor": 2}, "a"]}`
+	expected := []string{
+		`unknown field "/address/street".`,
+		`invalid value for "/addresses/1/country": expected string.`,
+		`unknown field "/addresses/1/floor".`,
+		`invalid value for "/addresses/2": expected object.`,
+		`field "/id": expected integer`,
+		`unknown field "/nickname".`,
+	}
+
+	err := (&external.ExternalUser{}).AtlasValidateJSON(ctx, json.RawMessage(input), "")
+	me, ok := err.(interface{ Errors() []error })
+	if !ok {
+		t.Fatalf("expected multiple errors, got %v", err)
+	}
+	if len(me.Errors()) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(me.Errors()), err)
+	}
+	for i, e := range me.Errors() {
+		if e.Error() != expected[i] {
+			t.Errorf("%d error: expected %s, got %s", i+1, expected[i], e)
+		}
+	}
+
+	msg, _ := json.Marshal(expected)
+	if runtime.ErrorMessage(err) != string(msg) {
+		t.Errorf("unexpected error message %s", runtime.ErrorMessage(err))
+	}
+
+	// a single error is returned as is
+	err = (&external.ExternalUser{}).AtlasValidateJSON(ctx, json.RawMessage(`{"id": true}`), "")
+	if _, ok := err.(interface{ Errors() []error }); ok || err == nil || runtime.ErrorMessage(err) != `field "/id": expected integer` {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestJSONNamesParam(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	// example/external is generated with json_names=true
+	tcases := []struct {
+		input       string
+		expectedErr string
+	}{
+		{`{"display_name": "a"}`, ``},
+		{`{"displayName": "a"}`, ``},
+		{`{"displayName": "a", "display_name": "b"}`, `field "/display_name" is set twice.`},
+		{`{"displayName": "a", "nickname": "b"}`, `unknown field "/nickname".`},
+	}
+
+	for i, tc := range tcases {
+		err := (&external.ExternalUser{}).AtlasValidateJSON(ctx, json.RawMessage(tc.input), "")
+		if (err == nil && tc.expectedErr != "") || (err != nil && err.Error() != tc.expectedErr) {
+			t.Errorf("%d: expected error %q, got %v", i+1, tc.expectedErr, err)
+		}
+	}
+
+	// grpc-gateway matches keys case-sensitively, so the annotator passes it renamed keys,
+	// and keys of messages of other packages it does not rename are matched as they are
+	bodies := []struct {
+		annotator   func(context.Context, *http.Request) metadata.MD
+		url         string
+		input       string
+		expected    string
+		expectedErr string
+	}{
+		{
+			annotator: external.AtlasValidateAnnotator,
+			url:       "/external/accounts",
+			input:     `{"LOGIN": "a", "Addresses": [{"City": "b"}], "displayName": "c"}`,
+			expected:  `{"addresses":[{"city":"b"}],"displayName":"c","login":"a"}`,
+		},
+		{
+			annotator: external.AtlasValidateAnnotator,
+			url:       "/external/accounts",
+			input:     `{"login": "a", "display_name": "c"}`,
+			expected:  `{"login": "a", "display_name": "c"}`,
+		},
+		{
+			annotator:   AtlasValidateAnnotator,
+			url:         "/users",
+			input:       `{"name": "a", "external_user": {"Name": "b"}}`,
+			expectedErr: `unknown field "external_user/Name".`,
+		},
+	}
+	for i, tc := range bodies {
+		r := httptest.NewRequest("POST", tc.url, strings.NewReader(tc.input))
+		r.Header.Set("X-Atlas-Validate", "true")
+		var msg string
+		if errs := tc.annotator(context.Background(), r).Get("Atlas-Validation-Error"); len(errs) != 0 {
+			msg = errs[0]
+		}
+		if msg != tc.expectedErr {
+			t.Errorf("%d: expected error %q, got %q", i+1, tc.expectedErr, msg)
+		}
+		if b, _ := ioutil.ReadAll(r.Body); tc.expectedErr == "" && string(b) != tc.expected {
+			t.Errorf("%d: expected body %s, got %s", i+1, tc.expected, b)
+		}
+	}
+}
+
+func TestUnknownQueryParams(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{url: "/users/search?page_size=50&unknown=1", expected: `unknown field "unknown".`},
+		{url: "/users/search?address.unknown=1", expected: `unknown field "address.unknown".`},
+		{url: "/users/search?address.tags[env]=prod"},
+		{url: "/users/search?address.tags=prod", expected: `query parameter "address.tags": expected a map entry.`},
+		{url: "/users/search?address.tags[env]=prod&address.tags[env]=dev", expected: `query parameter "address.tags[env]" must have a single value.`},
+		{url: "/users/search?statuses=STATUS_ACTIVE&statuses=2"},
+		{url: "/users/search?statuses=STATUS_GONE", expected: `query parameter "statuses": "STATUS_GONE" is not a valid value.`},
+		{url: "/users/search?ranks[1]=STATUS_ACTIVE"},
+		{url: "/users/search?ranks[one]=STATUS_ACTIVE", expected: `query parameter "ranks[one]": expected int32 key.`},
+		{url: "/users/search?ranks[1]=STATUS_GONE", expected: `query parameter "ranks[1]": "STATUS_GONE" is not a valid value.`},
+		{url: "/users/search?addresses.city=Tacoma"},
+		// service Groups allows unknown fields
+		{url: "/groups/search?page_size=50&unknown=1"},
+		{url: "/groups/search?page_size=fifty&unknown=1", expected: `query parameter "page_size": expected int32.`},
+		// options of fields apply to query parameters as to body fields
+		{url: "/users/search?page_size=0", expected: `query parameter "page_size" must be >= 1`},
+		{url: "/users/search?page_size=101", expected: `query parameter "page_size" must be <= 100`},
+		{url: "/users/search?page_size=100&filter=name==first"},
+		{url: "/users/search?filter=name", expected: `query parameter "filter" does not match required pattern`},
+		{url: "/users/search?filter=name==" + strings.Repeat("a", 32), expected: `query parameter "filter" exceeds max length 32`},
+	}
+
+	for n, test := range tests {
+		md := AtlasValidateAnnotator(context.Background(), httptest.NewRequest("GET", test.url, nil))
+		errs := md.Get("Atlas-Validation-Error")
+		if test.expected == "" && len(errs) != 0 {
+			t.Errorf(" %d test failed, error %s \n", n+1, errs[0])
+		}
+
+		if test.expected != "" && (len(errs) == 0 || errs[0] != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, errs)
+		}
+	}
+}
+
+func TestRequestValidators(t *testing.T) {
+	tests := []struct {
+		validate func(context.Context, []byte, string) error
+		body     string
+		method   string
+		expected string
+	}{
+		{validate: ValidateCreateUserRequest, body: `{"name": "a"}`, method: "POST"},
+		{validate: ValidateCreateUserRequest, body: `{"name": "a", "unknown": 1}`, method: "POST", expected: `unknown field "unknown".`},
+		{validate: ValidateCreateUserRequest, body: `{"name": "a"}`, method: "PUT", expected: `"PUT" operation is not supported for CreateUserRequest`},
+		// service Groups allows unknown fields
+		{validate: ValidateGroup, body: `{"name": "a", "unknown": 1}`, method: "POST"},
+		{validate: ValidateGroup, body: `{"name": "a"}`, method: "PUT", expected: `field "id" is required for "PUT" operation.`},
+		// allow_empty_object_body=true parameter accepts only an empty object
+		{validate: ValidateListUsersRequest, body: `{}`, method: "GET"},
+		{validate: ValidateListUsersRequest, body: `{"limit": 1}`, method: "GET", expected: `body is not allowed`},
+		{validate: ValidateListUsersRequest, body: `null`, method: "GET", expected: `body is not allowed`},
+	}
+
+	for n, test := range tests {
+		err := test.validate(context.Background(), []byte(test.body), test.method)
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestDuplicateKeys(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"name": "a", "address": {"city": "Tacoma"}, "groups": [{"name": "b"}]}`},
+		{input: `{"name": "a", "name": "b"}`, expected: `duplicate field "name"`},
+		{input: `{"name": "a", "address": {"city": "Tacoma", "city": "Seattle"}}`, expected: `duplicate field "address.city"`},
+		{input: `{"name": "a", "groups": [{"name": "b", "name": "c"}]}`, expected: `duplicate field "groups.[0].name"`},
+		{input: `{"name": "a", "address": {"tags": {"env": "prod", "env": "dev"}}}`, expected: `duplicate field "address.tags.env"`},
+	}
+
+	for n, test := range tests {
+		err := validate_Users_Create_0(ctx, json.RawMessage(test.input))
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestMaxDepth(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+	defer func(max int) { runtime.MaxDepth = max }(runtime.MaxDepth)
+	runtime.MaxDepth = 3
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"children": [{"children": [{"name": "c"}]}]}`},
+		{input: `{"children": [{"children": [{"children": []}]}]}`},
+		{input: `{"children": [{"children": [{"children": [{}]}]}]}`, expected: `maximum nesting depth 3 exceeded at "children.[0].children.[0].children.[0]"`},
+		{input: `{"links": {"a": {"links": {"b": {"children": [{}]}}}}}`, expected: `maximum nesting depth 3 exceeded at "links.a.links.b.children.[0]"`},
+	}
+
+	for n, test := range tests {
+		err := (&Node{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+
+	deep := strings.Repeat(`{"children": [`, 1000) + strings.Repeat(`]}`, 1000)
+	runtime.MaxDepth = 100
+	if err := (&Node{}).AtlasValidateJSON(ctx, json.RawMessage(deep), ""); err == nil || !strings.HasPrefix(err.Error(), "maximum nesting depth 100 exceeded") {
+		t.Errorf("expected nesting depth error, got %v", err)
+	}
+}
+
+func TestRuntimeOptions(t *testing.T) {
+	ctx := runtime.NewContext(context.Background(), runtime.Options{MaxDepth: 1, ErrorHeader: "X-Validation-Error"})
+
+	r := httptest.NewRequest("POST", "/users", strings.NewReader(`{"name": "a"}`))
+	md := AtlasValidateAnnotator(ctx, r)
+	if errs := md.Get("X-Validation-Error"); len(errs) != 0 || len(md.Get("Atlas-Validation-Error")) != 0 {
+		t.Errorf("unexpected errors %v", md)
+	}
+
+	r = httptest.NewRequest("POST", "/users", strings.NewReader(`{"name": "a", "address": {}}`))
+	md = AtlasValidateAnnotator(ctx, r)
+	if errs := md.Get("X-Validation-Error"); len(errs) != 1 || errs[0] != `maximum nesting depth 1 exceeded at "address"` {
+		t.Errorf("unexpected errors %v", md)
+	}
+
+	// a body cached with the default depth is validated again with a lower one
+	runtime.EnableValidationCache(8)
+	defer runtime.EnableValidationCache(0)
+	for n, c := range []context.Context{context.Background(), ctx} {
+		r = httptest.NewRequest("POST", "/users", strings.NewReader(`{"name": "a", "address": {}}`))
+		md = AtlasValidateAnnotator(c, r)
+		if errs := md.Get("X-Validation-Error"); (len(errs) != 0) != (n == 1) {
+			t.Errorf(" %d test failed, unexpected errors %v \n", n+1, md)
+		}
+	}
+
+	// external.proto is generated with error_mode=collect parameter
+	err := (&external.ExternalUser{}).AtlasValidateJSON(context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"), json.RawMessage(`{"id": true, "nickname": "a"}`), "")
+	if err = runtime.ReportedErrors(runtime.NewContext(ctx, runtime.Options{FirstError: true}), err); err == nil || err.Error() != `field "/id": expected integer` {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestScalarTypes(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"age": 1, "offset": "-1", "size": "1", "verified": true, "unit": "m", "checksum": "AQI=", "ratio": "NaN"}`},
+		{input: `{"age": null, "verified": null, "unit": null, "checksum": null, "ratio": null}`},
+		{input: `{"age": "not-a-number"}`, expected: `invalid value for "age": expected int32.`},
+		{input: `{"age": 1.5}`, expected: `invalid value for "age": expected int32.`},
+		{input: `{"age": 3000000000}`, expected: `invalid value for "age": expected int32.`},
+		{input: `{"size": -1}`, expected: `invalid value for "size": expected uint64.`},
+		{input: `{"offset": "12345"}`},
+		{input: `{"offset": "abc"}`, expected: `invalid value for "offset": expected int64.`},
+		{input: `{"offset": "1.5"}`, expected: `invalid value for "offset": expected int64.`},
+		{input: `{"size": "abc"}`, expected: `invalid value for "size": expected uint64.`},
+		{input: `{"verified": "true"}`, expected: `invalid value for "verified": expected bool.`},
+		{input: `{"unit": 1}`, expected: `invalid value for "unit": expected string.`},
+		{input: `{"checksum": "-_8="}`},
+		{input: `{"checksum": "AQI"}`},
+		{input: `{"checksum": "not base64"}`, expected: `field "checksum" is not valid base64`},
+		{input: `{"checksum": "!!!not base64!!!"}`, expected: `field "checksum" is not valid base64`},
+		{input: `{"checksum": 1}`, expected: `invalid value for "checksum": expected bytes.`},
+		{input: `{"ratio": {}}`, expected: `invalid value for "ratio": expected double.`},
+		{input: `{"weights": [0, "x"]}`, expected: `invalid value for "weights.[1]": expected float.`},
+		{input: `{"weights": "notanarray"}`, expected: `invalid value for "weights": expected array.`},
+		{input: `{"aliases": {}}`, expected: `invalid value for "aliases": expected array.`},
+	}
+
+	for n, test := range tests {
+		err := (&Measurement{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestFieldAllowUnknown(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"extension": {"name": "a", "vendor": "b", "children": [{"vendor": "c"}]}}`},
+		{input: `{"plugins": {"a": {"vendor": "b"}}}`},
+		{input: `{"extension": {"name": 1}}`, expected: `invalid value for "extension.name": expected string.`},
+		{input: `{"vendor": "b"}`, expected: `unknown field "vendor".`},
+		{input: `{"children": [{"vendor": "b"}]}`, expected: `unknown field "children.[0].vendor".`},
+	}
+
+	for n, test := range tests {
+		err := (&Node{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestFieldStrict(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+	ctx = context.WithValue(ctx, runtime.AllowUnknownContextKey, true)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"vendor": "b", "spec": {"name": "a"}}`},
+		{input: `{"spec": {"extension": {"vendor": "b"}}}`},
+		{input: `{"spec": {"vendor": "b"}}`, expected: `unknown field "spec.vendor".`},
+		{input: `{"extension": {"spec": {"children": [{"vendor": "b"}]}}}`, expected: `unknown field "extension.spec.children.[0].vendor".`},
+	}
+
+	for n, test := range tests {
+		err := (&Node{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestBindingBodies(t *testing.T) {
+	tests := []struct {
+		method   string
+		url      string
+		input    string
+		expected string
+	}{
+		{method: "PUT", url: "/users_replace", input: `{"payload": {"name": "a"}}`},
+		{method: "PUT", url: "/users_replace", input: `{"name": "a"}`, expected: `unknown field "name".`},
+		{method: "PATCH", url: "/users_replace/1", input: `{"name": "a"}`},
+		{method: "PATCH", url: "/users_replace/1", input: `{"payload": {"name": "a"}}`, expected: `field "name" is required for "PATCH" operation.`},
+	}
+
+	for n, test := range tests {
+		r := httptest.NewRequest(test.method, test.url, strings.NewReader(test.input))
+		errs := AtlasValidateAnnotator(context.Background(), r).Get("Atlas-Validation-Error")
+		if test.expected == "" && len(errs) != 0 {
+			t.Errorf(" %d test failed, error %s \n", n+1, errs[0])
+		}
+
+		if test.expected != "" && (len(errs) == 0 || errs[0] != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, errs)
+		}
+	}
+}
+
+func TestRequiredIf(t *testing.T) {
+	tests := []struct {
+		method   string
+		input    string
+		expected string
+	}{
+		{method: "POST", input: `{}`},
+		{method: "POST", input: `{"end": "b", "timezone": "UTC"}`},
+		{method: "POST", input: `{"start": "a", "end": "b", "timezone": "UTC"}`},
+		{method: "POST", input: `{"start": "a", "timezone": "UTC"}`, expected: `field "end" is required when "start" is set`},
+		{method: "POST", input: `{"start": "a", "end": "b"}`, expected: `field "timezone" is required when "start" is set`},
+		{method: "PATCH", input: `{"start": "a", "end": "b"}`},
+	}
+
+	for n, test := range tests {
+		ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, test.method)
+		err := (&Schedule{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestCaseInsensitiveParam(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	// example/external is generated with case_insensitive=true
+	tcases := []struct {
+		input       string
+		expectedErr string
+	}{
+		{`{"ID": 1, "Name": "a"}`, ``},
+		{`{"DisplayName": "a", "Address": {"ZIP": "b"}}`, ``},
+		{`{"Id": 1, "id": 2}`, `field "/id" is set twice.`},
+		{`{"DISPLAYNAME": "a", "display_name": "b"}`, `field "/display_name" is set twice.`},
+		{`{"ID": "a"}`, `invalid value for "/id": expected int32.`},
+		{`{"NickName": "a"}`, `unknown field "/NickName".`},
+	}
+
+	for i, tc := range tcases {
+		err := (&external.ExternalUser{}).AtlasValidateJSON(ctx, json.RawMessage(tc.input), "")
+		if (err == nil && tc.expectedErr != "") || (err != nil && err.Error() != tc.expectedErr) {
+			t.Errorf("%d: expected error %q, got %v", i+1, tc.expectedErr, err)
+		}
+	}
+
+	// grpc-gateway matches keys case-sensitively, so the annotator passes it renamed keys,
+	// and keys of messages of other packages it does not rename are matched as they are
+	bodies := []struct {
+		annotator   func(context.Context, *http.Request) metadata.MD
+		url         string
+		input       string
+		expected    string
+		expectedErr string
+	}{
+		{
+			annotator: external.AtlasValidateAnnotator,
+			url:       "/external/accounts",
+			input:     `{"LOGIN": "a", "Addresses": [{"City": "b"}], "displayName": "c"}`,
+			expected:  `{"addresses":[{"city":"b"}],"displayName":"c","login":"a"}`,
+		},
+		{
+			annotator: external.AtlasValidateAnnotator,
+			url:       "/external/accounts",
+			input:     `{"login": "a", "display_name": "c"}`,
+			expected:  `{"login": "a", "display_name": "c"}`,
+		},
+		{
+			annotator:   AtlasValidateAnnotator,
+			url:         "/users",
+			input:       `{"name": "a", "external_user": {"Name": "b"}}`,
+			expectedErr: `unknown field "external_user/Name".`,
+		},
+	}
+	for i, tc := range bodies {
+		r := httptest.NewRequest("POST", tc.url, strings.NewReader(tc.input))
+		r.Header.Set("X-Atlas-Validate", "true")
+		var msg string
+		if errs := tc.annotator(context.Background(), r).Get("Atlas-Validation-Error"); len(errs) != 0 {
+			msg = errs[0]
+		}
+		if msg != tc.expectedErr {
+			t.Errorf("%d: expected error %q, got %q", i+1, tc.expectedErr, msg)
+		}
+		if b, _ := ioutil.ReadAll(r.Body); tc.expectedErr == "" && string(b) != tc.expected {
+			t.Errorf("%d: expected body %s, got %s", i+1, tc.expected, b)
+		}
+	}
+}
+
+func TestMatchedMethod(t *testing.T) {
+	tests := []struct {
+		method       string
+		url          string
+		input        string
+		expected     string
+		allowUnknown bool
+	}{
+		{method: "PUT", url: "/users/1", input: `{"name": "a"}`, expected: "/examplepb.Users/Update"},
+		{method: "PUT", url: "/users/1", input: `{"unknown": "a"}`, expected: "/examplepb.Users/Update"},
+		{method: "GET", url: "/groups/search", expected: "/examplepb.Groups/Search", allowUnknown: true},
+		{method: "GET", url: "/unknown"},
+	}
+
+	for n, test := range tests {
+		r := httptest.NewRequest(test.method, test.url, strings.NewReader(test.input))
+		ctx := metadata.NewIncomingContext(context.Background(), AtlasValidateAnnotator(context.Background(), r))
+		method, allowUnknown := interceptor.GetAtlasValidationMethod(ctx)
+		if method != test.expected || allowUnknown != test.allowUnknown {
+			t.Errorf(" %d test failed, expected %s %v, got %s %v \n", n+1, test.expected, test.allowUnknown, method, allowUnknown)
+		}
+	}
+}
+
+func TestValidateResponse(t *testing.T) {
+	tests := []struct {
+		method   string
+		url      string
+		accept   string
+		input    string
+		expected string
+	}{
+		// deny option of id applies to requests only
+		{method: "GET", url: "/users_get", input: `{"id": 1, "name": "a"}`},
+		{method: "GET", url: "/users_get", input: `{"id": "a", "name": "a"}`, expected: `invalid value for "id": expected int32.`},
+		{method: "GET", url: "/users_get", input: `{"name": "a", "nickname": "b"}`, expected: `unknown field "nickname".`},
+		{method: "PUT", url: "/users/1", input: `{}`},
+		{method: "PUT", url: "/users/1", input: `{"name": "a"}`, expected: `unknown field "name".`},
+		{method: "GET", url: "/unknown", input: `{"name": "a"}`},
+		// name is required for all operations of requests only
+		{method: "GET", url: "/users_get", input: `{"id": 1}`},
+		{method: "GET", url: "/users_get", accept: "application/json", input: `{"id": "a"}`, expected: `invalid value for "id": expected int32.`},
+		{method: "GET", url: "/users_get", accept: "application/octet-stream", input: `{"id": "a"}`},
+		{method: "GET", url: "/users_get", accept: "text/plain, application/json;q=0", input: `{"id": "a"}`},
+	}
+
+	for n, test := range tests {
+		r := httptest.NewRequest(test.method, test.url, nil)
+		if test.accept != "" {
+			r.Header.Set("Accept", test.accept)
+		}
+		err := AtlasValidateResponse(context.Background(), r, []byte(test.input))
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+
+	// a context of a request being responded to does not scope rules of a response
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "PUT")
+	r := httptest.NewRequest("GET", "/users_get", nil)
+	if err := AtlasValidateResponse(ctx, r, []byte(`{"id": 1, "created_by": "b"}`)); err != nil {
+		t.Errorf("response test failed, error %s \n", err)
+	}
+}
+
+func TestItemsBounds(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "PATCH")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"days": ["mon"], "exceptions": [{}, {}]}`},
+		{input: `{"days": null, "exceptions": null}`},
+		{input: `{"days": []}`, expected: `field "days" must have at least 1 items`},
+		{input: `{"days": ["1", "2", "3", "4", "5", "6", "7", "8"]}`, expected: `field "days" must have at most 7 items`},
+		{input: `{"exceptions": [{}, {}, {}]}`, expected: `field "exceptions" must have at most 2 items`},
+		{input: `{"exceptions": [{"days": []}]}`, expected: `field "exceptions.[0].days" must have at least 1 items`},
+	}
+
+	for n, test := range tests {
+		err := (&Schedule{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestJSONPathStyle(t *testing.T) {
+	// validators generated with path_style=jsonpath join paths with these functions
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: runtime.JoinJSONPath("", "address"), expected: "$.address"},
+		{path: runtime.JoinJSONPath(runtime.JoinJSONPath("", "address"), "city"), expected: "$.address.city"},
+		{path: runtime.JoinJSONPath(runtime.JoinJSONPathIndex(runtime.JoinJSONPath("", "groups"), 0), "name"), expected: "$.groups[0].name"},
+		{path: runtime.JoinJSONPathIndex("", 1), expected: "$[1]"},
+		{path: runtime.JoinJSONPath(runtime.JoinJSONPath("", "labels"), "app.kubernetes.io/name"), expected: "$.labels['app.kubernetes.io/name']"},
+		{path: runtime.JoinJSONPath("", `it's`), expected: `$['it\'s']`},
+		{path: runtime.JoinJSONPath("", "2fa"), expected: "$['2fa']"},
+	}
+
+	for n, test := range tests {
+		if test.path != test.expected {
+			t.Errorf(" %d test failed, expected %s, got %s \n", n+1, test.expected, test.path)
+		}
+	}
+}
+
+// hookContextKey holds a function the AtlasJSONValidate hook of RawConfig calls with its context.
+type hookContextKey struct{}
+
+// AtlasJSONValidate hook of RawConfig accepts opaque configs as they are.
+func (_ *RawConfig) AtlasJSONValidate(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
+	if f, ok := ctx.Value(hookContextKey{}).(func(context.Context)); ok {
+		f(ctx)
+	}
+	var v map[string]json.RawMessage
+	if json.Unmarshal(r, &v) == nil && string(v["opaque"]) == "true" {
+		return r, runtime.ErrSkipValidation
+	}
+	if runtime.HTTPPathFromContext(ctx) == "/configs/locked" {
+		return r, fmt.Errorf("configs at %q are locked", runtime.HTTPPathFromContext(ctx))
+	}
+
+	return r, nil
+}
+
+func TestSkipValidation(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"opaque": true, "version": "x"}`},
+		{input: `{"version": "x"}`, expected: `invalid value for "version": expected int32.`},
+		{input: `{"opaque": false}`, expected: `unknown field "opaque".`},
+	}
+
+	for n, test := range tests {
+		err := (&RawConfig{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestHTTPPath(t *testing.T) {
+	tests := []struct {
+		url      string
+		input    string
+		expected string
+	}{
+		{url: "/configs", input: `{"version": 1}`},
+		{url: "/configs/locked", input: `{"version": 1}`, expected: `configs at "/configs/locked" are locked`},
+	}
+
+	for n, test := range tests {
+		r := httptest.NewRequest("POST", test.url, strings.NewReader(test.input))
+		errs := AtlasValidateAnnotator(context.Background(), r).Get("Atlas-Validation-Error")
+		if test.expected == "" && len(errs) != 0 {
+			t.Errorf(" %d test failed, error %s \n", n+1, errs)
+		}
+
+		if test.expected != "" && (len(errs) == 0 || errs[0] != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, errs)
+		}
+	}
+}
+
+func TestHookContext(t *testing.T) {
+	deadline := time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC)
+	var called bool
+	ctx := context.WithValue(context.Background(), hookContextKey{}, func(ctx context.Context) {
+		called = true
+		if d, ok := ctx.Deadline(); !ok || !d.Equal(deadline) {
+			t.Errorf("hook must get a deadline of the incoming context, got %v", d)
+		}
+		if ctx.Err() == nil {
+			t.Errorf("hook must get a cancellation of the incoming context")
+		}
+	})
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	r := httptest.NewRequest("POST", "/configs", strings.NewReader(`{"version": 1}`))
+	if errs := AtlasValidateAnnotator(ctx, r).Get("Atlas-Validation-Error"); len(errs) != 0 {
+		t.Errorf("unexpected error %s", errs[0])
+	}
+
+	if !called {
+		t.Errorf("hook must get a value of the incoming context")
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	tests := []struct {
+		method   string
+		req      interface{}
+		expected string
+	}{
+		{method: "/examplepb.Users/Update", req: &UpdateUserRequest{Payload: &User{Name: "a"}}},
+		// a proto3 string set to "" is omitted from a marshaled body and is not required
+		{method: "/examplepb.Users/Update", req: &UpdateUserRequest{Payload: &User{Name: ""}}},
+		{method: "/examplepb.Users/Update", req: &UpdateUserRequest{}},
+		{method: "/examplepb.Users/Create", req: &CreateUserRequest{Payload: &User{Id: 1, Name: "a"}}, expected: `field "id" is unsupported for "POST" operation; allowed: [LINK, PATCH, PUT, SEARCH].`},
+		{method: "/examplepb.Users/List", req: &EmptyRequest{}},
+		{method: "/examplepb.Unknown/Method", req: &UpdateUserRequest{}},
+	}
+
+	interceptor := AtlasValidateInterceptor()
+	for n, test := range tests {
+		called := false
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		}
+
+		_, err := interceptor(context.Background(), test.req, &grpc.UnaryServerInfo{FullMethod: test.method}, handler)
+		if test.expected == "" && (err != nil || !called) {
+			t.Errorf(" %d test failed, error %v \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || called || status.Convert(err).Message() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+
+	// fields with presence are still required in marshaled bodies
+	ctx := context.WithValue(context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST"), runtime.MarshaledContextKey, true)
+	if err := (&User{}).AtlasValidateJSON(ctx, json.RawMessage(`{}`), ""); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	if err := (&Credentials{}).AtlasValidateJSON(ctx, json.RawMessage(`{"version": 0}`), ""); err == nil || err.Error() != `field "username" is required for "POST" operation.` {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	tests := []struct {
+		method   string
+		input    string
+		expected string
+	}{
+		{method: "POST", input: `{"name": "a"}`},
+		{method: "POST", input: `{"name": "a", "created_by": "b"}`, expected: `field "created_by" is read-only`},
+		{method: "PUT", input: `{"name": "a", "created_by": "b"}`, expected: `field "created_by" is read-only`},
+		{method: "PATCH", input: `{"name": "a", "created_by": null}`, expected: `field "created_by" is read-only`},
+		{method: "GET", input: `{"name": "a", "created_by": "b"}`},
+	}
+
+	for n, test := range tests {
+		ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, test.method)
+		err := (&User{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+
+	r := httptest.NewRequest("GET", "/users_get", nil)
+	if err := AtlasValidateResponse(context.Background(), r, []byte(`{"name": "a", "created_by": "b"}`)); err != nil {
+		t.Errorf("response test failed, error %s \n", err)
+	}
+}
+
+func TestFieldGroups(t *testing.T) {
+	tests := []struct {
+		method   string
+		input    string
+		expected string
+	}{
+		{method: "POST", input: `{"name": "a", "phone": "555-0100"}`},
+		{method: "POST", input: `{"email": "a@example.com"}`},
+		{method: "POST", input: `{"phone": null}`},
+		{method: "POST", input: `{"name": "a"}`, expected: `at least one of [phone email] is required`},
+		{method: "PUT", input: `{}`, expected: `at least one of [phone email] is required`},
+		{method: "PATCH", input: `{"name": "a"}`},
+		{method: "PATCH", input: `{"name": "a", "company": null}`},
+		{method: "PATCH", input: `{"name": "a", "company": "b"}`, expected: `fields [name company] are mutually exclusive`},
+		{method: "PATCH", input: `{"address": {"city": "a"}}`},
+		{method: "PATCH", input: `{"address": null}`, expected: `field "address" may not be null`},
+	}
+
+	for n, test := range tests {
+		ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, test.method)
+		err := (&ContactInfo{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestMutuallyExclusivePath(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "PATCH")
+	if err := (&ContactInfo{}).AtlasValidateJSON(ctx, json.RawMessage(`{"name": "a", "company": "b"}`), "contacts.[0]"); err == nil || err.Error() != `fields [contacts.[0].name contacts.[0].company] are mutually exclusive` {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestProto2Required(t *testing.T) {
+	tests := []struct {
+		method   string
+		input    string
+		expected string
+	}{
+		{method: "POST", input: `{"username": "a", "version": 1}`},
+		{method: "PATCH", input: `{"username": "a"}`},
+		{method: "PUT", input: `{"password": "p"}`, expected: `field "username" is required for "PUT" operation.`},
+		// explicit required option overrides the label
+		{method: "POST", input: `{"username": "a"}`, expected: `field "version" is required for "POST" operation.`},
+	}
+
+	for n, test := range tests {
+		ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, test.method)
+		err := (&Credentials{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestUpdateMask(t *testing.T) {
+	tests := []struct {
+		method   string
+		input    string
+		expected string
+	}{
+		{method: "PATCH", input: `{"theme": "dark", "update_mask": "theme"}`},
+		{method: "PATCH", input: `{"time_zone": "UTC", "update_mask": "timeZone"}`},
+		{method: "PATCH", input: `{"update_mask": "themes,locale.region"}`},
+		{method: "PATCH", input: `{"theme": "dark", "update_mask": "theme,timeZone"}`, expected: `field "time_zone" is required for "PATCH" operation.`},
+		{method: "PATCH", input: `{"theme": "dark"}`, expected: `field "time_zone" is required for "PATCH" operation.`},
+		{method: "PATCH", input: `{"theme": "dark", "update_mask": ""}`, expected: `field "time_zone" is required for "PATCH" operation.`},
+		{method: "PATCH", input: `{"theme": "dark", "update_mask": null}`, expected: `field "time_zone" is required for "PATCH" operation.`},
+		// the mask is consulted for update operation only
+		{method: "PUT", input: `{"update_mask": "theme"}`, expected: `field "time_zone" is required for "PUT" operation.`},
+		{method: "POST", input: `{"update_mask": "timeZone"}`, expected: `field "theme" is required for "POST" operation.`},
+	}
+
+	for n, test := range tests {
+		ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, test.method)
+		err := (&Settings{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err)
+		}
+
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected error %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestErrorCodes(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "POST")
+
+	// external.proto is generated with error_codes=true and error_mode=collect parameters
+	input := `{"id": 1, "addresses": [{"city": 1}], "note": "a"}`
+	err := (&external.ExternalAccount{}).AtlasValidateJSON(ctx, json.RawMessage(input), "")
+	if err == nil {
+		t.Fatal("expected errors")
+	}
+
+	expected := []runtime.ValidationError{
+		{Field: "/login", Code: runtime.CodeRequired, Message: `field "/login" is required for "POST" operation.`},
+		{Field: "/addresses/0/city", Code: runtime.CodeTypeMismatch, Message: `invalid value for "/addresses/0/city": expected string.`},
+		{Field: "/id", Code: runtime.CodeDenied, Message: `field "id" is unsupported for "POST" operation.`},
+		{Field: "/note", Code: runtime.CodeUnknownField, Message: `unknown field "/note".`},
+	}
+
+	errs := err.(runtime.Errors)
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), err)
+	}
+	for i, e := range errs {
+		if ve, ok := e.(*runtime.ValidationError); !ok || *ve != expected[i] {
+			t.Errorf("%d: expected %+v, got %#v", i, expected[i], e)
+		}
+	}
+
+	if s := runtime.ErrorsJSON(errs[0]); s != `[{"field":"/login","code":"REQUIRED","message":"field \"/login\" is required for \"POST\" operation."}]` {
+		t.Errorf("unexpected JSON %s", s)
+	}
+
+	// failures of CEL expressions are collected with errors of fields
+	err = (&external.ExternalAccount{}).AtlasValidateJSON(ctx, json.RawMessage(`{"login": "root", "note": "a"}`), "")
+	expected = []runtime.ValidationError{
+		{Field: "/note", Code: runtime.CodeUnknownField, Message: `unknown field "/note".`},
+		{Field: "", Code: runtime.CodeInvalid, Message: `invalid value for "": login may not be root.`},
+	}
+
+	errs, _ = err.(runtime.Errors)
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), err)
+	}
+	for i, e := range errs {
+		if ve, ok := e.(*runtime.ValidationError); !ok || *ve != expected[i] {
+			t.Errorf("%d: expected %+v, got %#v", i, expected[i], e)
+		}
+	}
+}
+
+func TestCustomMethods(t *testing.T) {
+	// defaults of locale are checked against the set
+	runtime.RegisterValueSet("locales", []string{"en", "de"})
+
+	tests := []struct {
+		method   string
+		input    string
+		expected string
+	}{
+		{method: "SEARCH", input: `{"query": "a"}`},
+		{method: "LINK", input: `{"query": "a"}`},
+		{method: "SEARCH", input: `{}`, expected: `field "query" is required for "SEARCH" operation.`},
+		{method: "LINK", input: `{}`, expected: `field "query" is required for "LINK" operation.`},
+		{method: "SEARCH", input: `{"query": "a", "refresh": true}`, expected: `field "refresh" is unsupported for "SEARCH" operation; allowed: [PATCH, POST, PUT].`},
+		{method: "LINK", input: `{"query": "a", "created_by": "b"}`, expected: `field "created_by" is read-only`},
+		{method: "POST", input: `{"query": "a", "owner": "b", "refresh": true}`},
+		{method: "POST", input: `{"query": "a"}`, expected: `field "owner" is required for "POST" operation.`},
+		{method: "POST", input: `{"owner": "b"}`, expected: `field "query" is required for "POST" operation.`},
+	}
+
+	for n, test := range tests {
+		r := httptest.NewRequest(test.method, "/queries", strings.NewReader(test.input))
+		md := AtlasValidateAnnotator(context.Background(), r)
+		if method := md.Get("Atlas-Validation-Method"); len(method) == 0 || method[0] != "/examplepb.Queries/Run" {
+			t.Errorf(" %d test failed, unexpected method %q \n", n+1, method)
+		}
+		var msg string
+		if errs := md.Get("Atlas-Validation-Error"); len(errs) != 0 {
+			msg = errs[0]
+		}
+		if msg != test.expected {
+			t.Errorf(" %d test failed, expected error %q, got %q \n", n+1, test.expected, msg)
+		}
+	}
+
+	// query is required for three methods (POST, LINK and SEARCH) that are not all
+	// write methods, so it is not required for PATCH
+	for n, test := range []struct {
+		method   string
+		input    string
+		expected string
+	}{
+		{method: "PATCH", input: `{"owner": "b"}`},
+		{method: "PUT", input: `{}`, expected: `field "owner" is required for "PUT" operation.`},
+	} {
+		ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, test.method)
+		var msg string
+		if err := (&SearchQuery{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), ""); err != nil {
+			msg = err.Error()
+		}
+		if msg != test.expected {
+			t.Errorf(" %d test failed, expected error %q, got %q \n", n+1, test.expected, msg)
+		}
+	}
+}
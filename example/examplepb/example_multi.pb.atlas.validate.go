@@ -6,6 +6,7 @@ package examplepb // import "github.com/infobloxopen/protoc-gen-atlas-validate/e
 import context "context"
 import fmt "fmt"
 import json "encoding/json"
+import url "net/url"
 import runtime1 "github.com/infobloxopen/protoc-gen-atlas-validate/runtime"
 import proto "github.com/gogo/protobuf/proto"
 import math "math"
@@ -20,15 +21,38 @@ var _ = math.Inf
 // validate_Users2_Create2_0 is an entrypoint for validating "POST" HTTP request
 // that match *.pb.gw.go/pattern_Users2_Create2_0.
 func validate_Users2_Create2_0(ctx context.Context, r json.RawMessage) (err error) {
+	ctx = runtime1.WithMaxTotalTextBytes(ctx, 64)
 	return validate_Object_User2(ctx, r, "")
 }
 
+// validate_response_Users2_Create2_0 is an entrypoint for validating a response body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Users2_Create2_0.
+func validate_response_Users2_Create2_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_EmptyResponse2(ctx, r, "")
+}
+
+// validate_form_Users2_Create2_0 is an entrypoint for validating a form-urlencoded body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_Users2_Create2_0.
+func validate_form_Users2_Create2_0(ctx context.Context, form url.Values) error {
+	if err := runtime1.ValidateQuery(ctx, form, validate_Query_Object_User2); err != nil {
+		return err
+	}
+	return validate_required_Object_User2(ctx, runtime1.FormObject(form), "")
+}
+
 // validate_Object_User2 function validates a JSON for a given object.
 func validate_Object_User2(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&User2{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -37,6 +61,24 @@ func validate_Object_User2(ctx context.Context, r json.RawMessage, path string)
 	if err = json.Unmarshal(r, &v); err != nil {
 		return fmt.Errorf("invalid value for %q: expected object.", path)
 	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
+
+	if vv, ok := v["displayName"]; ok {
+		if _, ok := v["display_name"]; ok {
+			return fmt.Errorf("field %q is set twice.", runtime1.JoinPath(path, "display_name"))
+		}
+		delete(v, "displayName")
+		v["display_name"] = vv
+	}
+	if vv, ok := v["loginCount"]; ok {
+		if _, ok := v["login_count"]; ok {
+			return fmt.Errorf("field %q is set twice.", runtime1.JoinPath(path, "login_count"))
+		}
+		delete(v, "loginCount")
+		v["login_count"] = vv
+	}
 
 	if err = validate_required_Object_User2(ctx, v, path); err != nil {
 		return err
@@ -47,12 +89,47 @@ func validate_Object_User2(ctx context.Context, r json.RawMessage, path string)
 	for k, _ := range v {
 		switch k {
 		case "id":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
 			method := runtime1.HTTPMethodFromContext(ctx)
-			if method == "POST" {
-				return fmt.Errorf("field %q is unsupported for %q operation.", k, method)
+			if runtime1.RuleEnabled(ctx, "examplepb.User2.id.deny") && (method == "POST") {
+				return fmt.Errorf("field %q is unsupported for %q operation; allowed: [LINK, PATCH, PUT, SEARCH].", k, method)
+			}
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int32"); err != nil {
+				return err
 			}
 		case "name":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "display_name":
+			runtime1.MarkCovered(ctx, runtime1.JoinPath(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "string"); err != nil {
+				return err
+			}
+		case "login_count":
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPath(path, k)); err != nil {
+				return err
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPath(path, k), "int32"); err != nil {
+				return err
+			}
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
 			if !allowUnknown {
 				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
 			}
@@ -66,7 +143,11 @@ func (_ *User2) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path s
 	if hook, ok := interface{}(&User2{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -76,19 +157,45 @@ func (_ *User2) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path s
 func validate_required_Object_User2(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
-	if _, ok := v["name"]; !ok {
-		path = runtime1.JoinPath(path, "name")
-		return fmt.Errorf("field %q is required for %q operation.", path, method)
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	if vv, ok := v["display_name"]; runtime1.RuleEnabled(ctx, "examplepb.User2.display_name.required") && (!ok || string(vv) == `""`) && !marshaled && (method == "POST") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "display_name"), method)
+	}
+	if vv, ok := v["name"]; runtime1.RuleEnabled(ctx, "examplepb.User2.name.required") && (!ok || string(vv) == `""`) && !marshaled && (method == "PATCH" || method == "POST" || method == "PUT") {
+		return fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPath(path, "name"), method)
 	}
 	return nil
 }
 
+// validate_Query_Object_User2 function validates a query parameter for a given object.
+func validate_Query_Object_User2(ctx context.Context, fieldPath []string, values []string, key string) error {
+	switch fieldPath[0] {
+	case "id":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "int32", false)
+	case "name":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "display_name", "displayName":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "string", false)
+	case "login_count", "loginCount":
+		return runtime1.ValidateQueryValue(ctx, key, fieldPath, values, "int32", false)
+	}
+	return runtime1.ValidateUnknownQueryParameter(ctx, key)
+}
+
 // validate_Object_EmptyResponse2 function validates a JSON for a given object.
 func validate_Object_EmptyResponse2(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&EmptyResponse2{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -97,6 +204,9 @@ func validate_Object_EmptyResponse2(ctx context.Context, r json.RawMessage, path
 	if err = json.Unmarshal(r, &v); err != nil {
 		return fmt.Errorf("invalid value for %q: expected object.", path)
 	}
+	if err = runtime1.ValidateUniqueKeys(r, path, runtime1.JoinPath); err != nil {
+		return err
+	}
 
 	if err = validate_required_Object_EmptyResponse2(ctx, v, path); err != nil {
 		return err
@@ -107,6 +217,12 @@ func validate_Object_EmptyResponse2(ctx context.Context, r json.RawMessage, path
 	for k, _ := range v {
 		switch k {
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					return err
+				}
+				continue
+			}
 			if !allowUnknown {
 				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
 			}
@@ -120,7 +236,11 @@ func (_ *EmptyResponse2) AtlasValidateJSON(ctx context.Context, r json.RawMessag
 	if hook, ok := interface{}(&EmptyResponse2{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -130,5 +250,7 @@ func (_ *EmptyResponse2) AtlasValidateJSON(ctx context.Context, r json.RawMessag
 func validate_required_Object_EmptyResponse2(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
 	return nil
 }
@@ -20,8 +20,10 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 type User2 struct {
-	Id   int32  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Id          int32  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName" json:"display_name,omitempty"`
+	LoginCount  int32  `protobuf:"varint,4,opt,name=login_count,json=loginCount" json:"login_count,omitempty"`
 }
 
 func (m *User2) Reset()                    { *m = User2{} }
@@ -43,6 +45,20 @@ func (m *User2) GetName() string {
 	return ""
 }
 
+func (m *User2) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *User2) GetLoginCount() int32 {
+	if m != nil {
+		return m.LoginCount
+	}
+	return 0
+}
+
 type EmptyResponse2 struct {
 }
 
@@ -131,24 +147,27 @@ var _Users2_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("example/examplepb/example_multi.proto", fileDescriptor2) }
 
 var fileDescriptor2 = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xad, 0x48, 0xcc,
-	0x2d, 0xc8, 0x49, 0xd5, 0x87, 0xd2, 0x05, 0x49, 0x30, 0x56, 0x7c, 0x6e, 0x69, 0x4e, 0x49, 0xa6,
-	0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x10, 0x27, 0x5c, 0x5a, 0x4a, 0x26, 0x3d, 0x3f, 0x3f, 0x3d,
-	0x27, 0x55, 0x3f, 0xb1, 0x20, 0x53, 0x3f, 0x31, 0x2f, 0x2f, 0xbf, 0x24, 0xb1, 0x24, 0x33, 0x3f,
-	0xaf, 0x18, 0xa2, 0x50, 0xca, 0x2f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
-	0x3f, 0x33, 0x2f, 0x2d, 0x3f, 0x29, 0x27, 0xbf, 0x22, 0xbf, 0x20, 0x35, 0x4f, 0x1f, 0x2c, 0x9d,
-	0xac, 0x9b, 0x9e, 0x9a, 0xa7, 0x9b, 0x58, 0x92, 0x93, 0x58, 0xac, 0x5b, 0x96, 0x98, 0x93, 0x99,
-	0x92, 0x58, 0x92, 0xaa, 0x9f, 0x5f, 0x00, 0x36, 0x40, 0x1f, 0x2c, 0x1c, 0x0f, 0x13, 0x86, 0x98,
-	0xa7, 0x64, 0xc7, 0xc5, 0x1a, 0x5a, 0x9c, 0x5a, 0x64, 0x24, 0x24, 0xc6, 0xc5, 0x94, 0x99, 0x22,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xea, 0xc4, 0x76, 0x6b, 0xbf, 0x24, 0x13, 0x07, 0x43, 0x10, 0x53,
-	0x66, 0x8a, 0x90, 0x2c, 0x17, 0x4b, 0x5e, 0x62, 0x6e, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xa7,
-	0x13, 0xe7, 0xad, 0xfd, 0x92, 0xac, 0x42, 0xcc, 0x0c, 0x4c, 0x8c, 0x41, 0x60, 0x61, 0x25, 0x01,
-	0x2e, 0x3e, 0xd7, 0xdc, 0x82, 0x92, 0xca, 0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x23,
-	0xa3, 0x60, 0x2e, 0x36, 0x90, 0x89, 0xc5, 0x46, 0x42, 0x9e, 0x5c, 0xec, 0xce, 0x45, 0xa9, 0x89,
-	0x25, 0xa9, 0x46, 0x42, 0x02, 0x7a, 0x70, 0x0f, 0xea, 0x81, 0xed, 0x93, 0x92, 0x44, 0x12, 0x41,
-	0x35, 0x41, 0x49, 0xb0, 0xe9, 0xf2, 0x93, 0xc9, 0x4c, 0xdc, 0x4a, 0x6c, 0xfa, 0xa5, 0x20, 0x83,
-	0xac, 0x18, 0xb5, 0x9c, 0xfc, 0xa3, 0x7c, 0x49, 0xf7, 0x38, 0x46, 0xd0, 0x5b, 0xc3, 0x59, 0x49,
-	0x6c, 0x60, 0x6d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xaf, 0x0f, 0x9a, 0xa0, 0x01,
-	0x00, 0x00,
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xbf, 0x6a, 0xeb, 0x30,
+	0x14, 0x87, 0x23, 0x27, 0x71, 0x88, 0x72, 0xb9, 0xe4, 0x6a, 0xb9, 0x71, 0xb8, 0x97, 0x06, 0x43,
+	0x21, 0x04, 0x62, 0x81, 0xbb, 0x94, 0x76, 0x29, 0x09, 0x1d, 0x3a, 0x34, 0x83, 0x4b, 0x87, 0x76,
+	0x31, 0x72, 0xac, 0xba, 0x02, 0x59, 0x47, 0xc4, 0x72, 0x49, 0xd6, 0xce, 0xdd, 0xfa, 0x40, 0x79,
+	0x88, 0x6e, 0x99, 0xfb, 0x20, 0xc5, 0xca, 0x1f, 0x5a, 0x3a, 0x75, 0xd2, 0xe1, 0x3b, 0x3f, 0x7d,
+	0x3a, 0x1c, 0xe1, 0x63, 0xbe, 0x64, 0xb9, 0x96, 0x9c, 0xee, 0x4e, 0x9d, 0xec, 0xab, 0x38, 0x2f,
+	0xa5, 0x11, 0x81, 0x5e, 0x80, 0x01, 0xd2, 0x3e, 0xb4, 0xfb, 0xff, 0x32, 0x80, 0x4c, 0x72, 0xca,
+	0xb4, 0xa0, 0x4c, 0x29, 0x30, 0xcc, 0x08, 0x50, 0xc5, 0x36, 0xd8, 0x9f, 0x65, 0xc2, 0x3c, 0x96,
+	0x49, 0x30, 0x87, 0x9c, 0x0a, 0xf5, 0x00, 0x89, 0x84, 0x25, 0x68, 0xae, 0xa8, 0x6d, 0xcf, 0xc7,
+	0x19, 0x57, 0x63, 0x66, 0x24, 0x2b, 0xc6, 0x4f, 0x4c, 0x8a, 0x94, 0x19, 0x4e, 0x41, 0x5b, 0x01,
+	0xb5, 0x38, 0xde, 0xe3, 0xad, 0xcf, 0x7f, 0x41, 0xb8, 0x79, 0x5b, 0xf0, 0x45, 0x48, 0xfe, 0x62,
+	0x47, 0xa4, 0x3d, 0x34, 0x40, 0xc3, 0xe6, 0xa4, 0xb5, 0x59, 0x7b, 0x75, 0x8c, 0x6a, 0x91, 0x23,
+	0x52, 0xf2, 0x1f, 0x37, 0x14, 0xcb, 0x79, 0xcf, 0x19, 0xa0, 0x61, 0x7b, 0xd2, 0xde, 0xac, 0xbd,
+	0x26, 0xa9, 0xd7, 0x1c, 0x14, 0x59, 0x4c, 0x46, 0xf8, 0x57, 0x2a, 0x0a, 0x2d, 0xd9, 0x2a, 0xb6,
+	0xb1, 0xba, 0x8d, 0x59, 0x03, 0x41, 0xb5, 0xa8, 0xb3, 0x6b, 0xce, 0xaa, 0xec, 0x11, 0xee, 0x48,
+	0xc8, 0x84, 0x8a, 0xe7, 0x50, 0x2a, 0xd3, 0x6b, 0x54, 0x8f, 0x45, 0xd8, 0xa2, 0x69, 0x45, 0xfc,
+	0x2e, 0xfe, 0x7d, 0x99, 0x6b, 0xb3, 0x8a, 0x78, 0xa1, 0x41, 0x15, 0x3c, 0x0c, 0x6f, 0xb0, 0x5b,
+	0xcd, 0x57, 0x84, 0xe4, 0x0a, 0xb7, 0xa6, 0x0b, 0xce, 0x0c, 0x0f, 0x49, 0x37, 0x38, 0xec, 0x2b,
+	0xb0, 0xd3, 0xf7, 0xbd, 0x4f, 0xe4, 0xab, 0xc1, 0xff, 0xf3, 0xfc, 0xf6, 0xfe, 0xea, 0x74, 0x7c,
+	0x97, 0x96, 0x95, 0xe8, 0x0c, 0x8d, 0x26, 0x77, 0x9b, 0xb5, 0xe7, 0x0e, 0xd0, 0x10, 0x9d, 0x5e,
+	0xdc, 0x5f, 0xff, 0x7c, 0xa3, 0xdf, 0xfe, 0xf4, 0xfc, 0x50, 0x25, 0xae, 0xbd, 0x76, 0xf2, 0x31,
+	0x00, 0xae, 0xf6, 0x0b, 0x32, 0xf9, 0x01, 0x00, 0x00,
 }
@@ -5,14 +5,20 @@ package examplepb // import "github.com/infobloxopen/protoc-gen-atlas-validate/e
 
 import bytes "bytes"
 import context "context"
+import fmt "fmt"
 import http "net/http"
+import io "io"
 import ioutil "io/ioutil"
 import json "encoding/json"
+import strconv "strconv"
+import url "net/url"
 import metadata "google.golang.org/grpc/metadata"
+import grpc "google.golang.org/grpc"
+import codes "google.golang.org/grpc/codes"
+import status "google.golang.org/grpc/status"
 import runtime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 import runtime1 "github.com/infobloxopen/protoc-gen-atlas-validate/runtime"
 import proto "github.com/gogo/protobuf/proto"
-import fmt "fmt"
 import math "math"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,133 +29,725 @@ var _ = math.Inf
 var validate_Patterns = []struct {
 	pattern    runtime.Pattern
 	httpMethod string
-	validator  func(context.Context, json.RawMessage) error
+	// method is a gRPC name of the method the pattern is bound to.
+	method    string
+	validator func(context.Context, json.RawMessage) error
 	// Included for introspection purpose.
 	allowUnknown bool
+	// defaulter injects default values into a valid body, nil if there is nothing to inject.
+	defaulter func(context.Context, json.RawMessage) (json.RawMessage, error)
+	// queryValidator validates query parameters of a request without body, nil if they are not validated.
+	queryValidator func(context.Context, url.Values) error
+	// formValidator validates a form-urlencoded body, nil if such bodies are not validated.
+	formValidator func(context.Context, url.Values) error
+	// responseValidator validates a response body.
+	responseValidator func(context.Context, json.RawMessage) error
 }{
 	// patterns for file example/examplepb/example.proto
 	{
-		pattern:      pattern_Users_Create_0,
-		httpMethod:   "POST",
-		validator:    validate_Users_Create_0,
-		allowUnknown: false,
+		pattern:           pattern_Users_Create_0,
+		httpMethod:        "POST",
+		method:            "/examplepb.Users/Create",
+		validator:         validate_Users_Create_0,
+		allowUnknown:      false,
+		defaulter:         default_Users_Create_0,
+		formValidator:     validate_form_Users_Create_0,
+		responseValidator: validate_response_Users_Create_0,
+	},
+	{
+		pattern:           pattern_Users_Update_0,
+		httpMethod:        "PUT",
+		method:            "/examplepb.Users/Update",
+		validator:         validate_Users_Update_0,
+		allowUnknown:      false,
+		defaulter:         default_Users_Update_0,
+		formValidator:     validate_form_Users_Update_0,
+		responseValidator: validate_response_Users_Update_0,
+	},
+	{
+		pattern:           pattern_Users_Update_1,
+		httpMethod:        "PATCH",
+		method:            "/examplepb.Users/Update",
+		validator:         validate_Users_Update_1,
+		allowUnknown:      false,
+		defaulter:         default_Users_Update_1,
+		formValidator:     validate_form_Users_Update_1,
+		responseValidator: validate_response_Users_Update_1,
+	},
+	{
+		pattern:           pattern_Users_Get_0,
+		httpMethod:        "GET",
+		method:            "/examplepb.Users/Get",
+		validator:         validate_Users_Get_0,
+		allowUnknown:      false,
+		responseValidator: validate_response_Users_Get_0,
 	},
 	{
-		pattern:      pattern_Users_Update_0,
-		httpMethod:   "PUT",
-		validator:    validate_Users_Update_0,
-		allowUnknown: false,
+		pattern:           pattern_Users_Replace_0,
+		httpMethod:        "PUT",
+		method:            "/examplepb.Users/Replace",
+		validator:         validate_Users_Replace_0,
+		allowUnknown:      false,
+		defaulter:         default_Users_Replace_0,
+		formValidator:     validate_form_Users_Replace_0,
+		responseValidator: validate_response_Users_Replace_0,
 	},
 	{
-		pattern:      pattern_Users_Update_1,
-		httpMethod:   "PATCH",
-		validator:    validate_Users_Update_1,
-		allowUnknown: false,
+		pattern:           pattern_Users_Replace_1,
+		httpMethod:        "PATCH",
+		method:            "/examplepb.Users/Replace",
+		validator:         validate_Users_Replace_1,
+		allowUnknown:      false,
+		defaulter:         default_Users_Replace_1,
+		formValidator:     validate_form_Users_Replace_1,
+		responseValidator: validate_response_Users_Replace_1,
 	},
 	{
-		pattern:      pattern_Users_List_0,
-		httpMethod:   "GET",
-		validator:    validate_Users_List_0,
-		allowUnknown: false,
+		pattern:           pattern_Users_List_0,
+		httpMethod:        "GET",
+		method:            "/examplepb.Users/List",
+		validator:         validate_Users_List_0,
+		allowUnknown:      false,
+		responseValidator: validate_response_Users_List_0,
 	},
 	{
-		pattern:      pattern_Users_List_1,
-		httpMethod:   "GET",
-		validator:    validate_Users_List_1,
-		allowUnknown: false,
+		pattern:           pattern_Users_List_1,
+		httpMethod:        "GET",
+		method:            "/examplepb.Users/List",
+		validator:         validate_Users_List_1,
+		allowUnknown:      false,
+		responseValidator: validate_response_Users_List_1,
 	},
 	{
-		pattern:      pattern_Users_UpdateExternalUser_0,
-		httpMethod:   "PUT",
-		validator:    validate_Users_UpdateExternalUser_0,
-		allowUnknown: false,
+		pattern:           pattern_Users_Search_0,
+		httpMethod:        "GET",
+		method:            "/examplepb.Users/Search",
+		validator:         validate_Users_Search_0,
+		allowUnknown:      false,
+		queryValidator:    validate_query_Users_Search_0,
+		responseValidator: validate_response_Users_Search_0,
 	},
 	{
-		pattern:      pattern_Users_UpdateExternalUser2_0,
-		httpMethod:   "PUT",
-		validator:    validate_Users_UpdateExternalUser2_0,
-		allowUnknown: false,
+		pattern:           pattern_Users_UpdateExternalUser_0,
+		httpMethod:        "PUT",
+		method:            "/examplepb.Users/UpdateExternalUser",
+		validator:         validate_Users_UpdateExternalUser_0,
+		allowUnknown:      false,
+		responseValidator: validate_response_Users_UpdateExternalUser_0,
 	},
 	{
-		pattern:      pattern_Profiles_Create_0,
-		httpMethod:   "POST",
-		validator:    validate_Profiles_Create_0,
-		allowUnknown: false,
+		pattern:           pattern_Users_UpdateExternalUser2_0,
+		httpMethod:        "PUT",
+		method:            "/examplepb.Users/UpdateExternalUser2",
+		validator:         validate_Users_UpdateExternalUser2_0,
+		allowUnknown:      false,
+		responseValidator: validate_response_Users_UpdateExternalUser2_0,
 	},
 	{
-		pattern:      pattern_Profiles_Update_0,
-		httpMethod:   "PUT",
-		validator:    validate_Profiles_Update_0,
-		allowUnknown: true,
+		pattern:           pattern_Profiles_Create_0,
+		httpMethod:        "POST",
+		method:            "/examplepb.Profiles/Create",
+		validator:         validate_Profiles_Create_0,
+		allowUnknown:      false,
+		defaulter:         default_Profiles_Create_0,
+		formValidator:     validate_form_Profiles_Create_0,
+		responseValidator: validate_response_Profiles_Create_0,
 	},
 	{
-		pattern:      pattern_Groups_Create_0,
-		httpMethod:   "POST",
-		validator:    validate_Groups_Create_0,
-		allowUnknown: true,
+		pattern:           pattern_Profiles_Update_0,
+		httpMethod:        "PUT",
+		method:            "/examplepb.Profiles/Update",
+		validator:         validate_Profiles_Update_0,
+		allowUnknown:      true,
+		defaulter:         default_Profiles_Update_0,
+		formValidator:     validate_form_Profiles_Update_0,
+		responseValidator: validate_response_Profiles_Update_0,
 	},
 	{
-		pattern:      pattern_Groups_Update_0,
-		httpMethod:   "PUT",
-		validator:    validate_Groups_Update_0,
-		allowUnknown: true,
+		pattern:           pattern_Groups_Create_0,
+		httpMethod:        "POST",
+		method:            "/examplepb.Groups/Create",
+		validator:         validate_Groups_Create_0,
+		allowUnknown:      true,
+		formValidator:     validate_form_Groups_Create_0,
+		responseValidator: validate_response_Groups_Create_0,
 	},
 	{
-		pattern:      pattern_Groups_ValidatedList_0,
-		httpMethod:   "GET",
-		validator:    validate_Groups_ValidatedList_0,
-		allowUnknown: false,
+		pattern:           pattern_Groups_Update_0,
+		httpMethod:        "PUT",
+		method:            "/examplepb.Groups/Update",
+		validator:         validate_Groups_Update_0,
+		allowUnknown:      true,
+		formValidator:     validate_form_Groups_Update_0,
+		responseValidator: validate_response_Groups_Update_0,
 	},
 	{
-		pattern:      pattern_Groups_ValidatedList_1,
-		httpMethod:   "GET",
-		validator:    validate_Groups_ValidatedList_1,
-		allowUnknown: false,
+		pattern:           pattern_Groups_Search_0,
+		httpMethod:        "GET",
+		method:            "/examplepb.Groups/Search",
+		validator:         validate_Groups_Search_0,
+		allowUnknown:      true,
+		queryValidator:    validate_query_Groups_Search_0,
+		responseValidator: validate_response_Groups_Search_0,
 	},
 	{
-		pattern:      pattern_Groups_ValidateWKT_0,
-		httpMethod:   "PUT",
-		validator:    validate_Groups_ValidateWKT_0,
-		allowUnknown: false,
+		pattern:           pattern_Groups_ValidatedList_0,
+		httpMethod:        "GET",
+		method:            "/examplepb.Groups/ValidatedList",
+		validator:         validate_Groups_ValidatedList_0,
+		allowUnknown:      false,
+		responseValidator: validate_response_Groups_ValidatedList_0,
 	},
 	{
-		pattern:      pattern_Groups_ValidateWKT_1,
-		httpMethod:   "PUT",
-		validator:    validate_Groups_ValidateWKT_1,
-		allowUnknown: false,
+		pattern:           pattern_Groups_ValidatedList_1,
+		httpMethod:        "GET",
+		method:            "/examplepb.Groups/ValidatedList",
+		validator:         validate_Groups_ValidatedList_1,
+		allowUnknown:      false,
+		responseValidator: validate_response_Groups_ValidatedList_1,
+	},
+	{
+		pattern:           pattern_Groups_ValidateWKT_0,
+		httpMethod:        "PUT",
+		method:            "/examplepb.Groups/ValidateWKT",
+		validator:         validate_Groups_ValidateWKT_0,
+		allowUnknown:      false,
+		responseValidator: validate_response_Groups_ValidateWKT_0,
+	},
+	{
+		pattern:           pattern_Groups_ValidateWKT_1,
+		httpMethod:        "PUT",
+		method:            "/examplepb.Groups/ValidateWKT",
+		validator:         validate_Groups_ValidateWKT_1,
+		allowUnknown:      false,
+		responseValidator: validate_response_Groups_ValidateWKT_1,
+	},
+	{
+		pattern:           pattern_Compat_CreateAddressOrGroup_0,
+		httpMethod:        "POST",
+		method:            "/examplepb.Compat/CreateAddressOrGroup",
+		validator:         validate_Compat_CreateAddressOrGroup_0,
+		allowUnknown:      false,
+		responseValidator: validate_response_Compat_CreateAddressOrGroup_0,
+	},
+	{
+		pattern:           pattern_Compat_CreateProfileOrGroup_0,
+		httpMethod:        "POST",
+		method:            "/examplepb.Compat/CreateProfileOrGroup",
+		validator:         validate_Compat_CreateProfileOrGroup_0,
+		allowUnknown:      false,
+		responseValidator: validate_response_Compat_CreateProfileOrGroup_0,
+	},
+	{
+		pattern:           pattern_Tables_Import_0,
+		httpMethod:        "POST",
+		method:            "/examplepb.Tables/Import",
+		validator:         validate_Tables_Import_0,
+		allowUnknown:      false,
+		formValidator:     validate_form_Tables_Import_0,
+		responseValidator: validate_response_Tables_Import_0,
+	},
+	{
+		pattern:           pattern_Configs_Apply_0,
+		httpMethod:        "POST",
+		method:            "/examplepb.Configs/Apply",
+		validator:         validate_Configs_Apply_0,
+		allowUnknown:      false,
+		formValidator:     validate_form_Configs_Apply_0,
+		responseValidator: validate_response_Configs_Apply_0,
+	},
+	{
+		pattern:           pattern_Configs_Apply_1,
+		httpMethod:        "POST",
+		method:            "/examplepb.Configs/Apply",
+		validator:         validate_Configs_Apply_1,
+		allowUnknown:      false,
+		formValidator:     validate_form_Configs_Apply_1,
+		responseValidator: validate_response_Configs_Apply_1,
+	},
+	{
+		pattern:           pattern_Queries_Run_0,
+		httpMethod:        "SEARCH",
+		method:            "/examplepb.Queries/Run",
+		validator:         validate_Queries_Run_0,
+		allowUnknown:      false,
+		defaulter:         default_Queries_Run_0,
+		formValidator:     validate_form_Queries_Run_0,
+		responseValidator: validate_response_Queries_Run_0,
+	},
+	{
+		pattern:           pattern_Queries_Run_1,
+		httpMethod:        "POST",
+		method:            "/examplepb.Queries/Run",
+		validator:         validate_Queries_Run_1,
+		allowUnknown:      false,
+		defaulter:         default_Queries_Run_1,
+		formValidator:     validate_form_Queries_Run_1,
+		responseValidator: validate_response_Queries_Run_1,
+	},
+	{
+		pattern:           pattern_Queries_Run_2,
+		httpMethod:        "LINK",
+		method:            "/examplepb.Queries/Run",
+		validator:         validate_Queries_Run_2,
+		allowUnknown:      false,
+		defaulter:         default_Queries_Run_2,
+		formValidator:     validate_form_Queries_Run_2,
+		responseValidator: validate_response_Queries_Run_2,
 	},
 
 	// patterns for file example/examplepb/example_multi.proto
 	{
-		pattern:      pattern_Users2_Create2_0,
-		httpMethod:   "POST",
-		validator:    validate_Users2_Create2_0,
-		allowUnknown: false,
+		pattern:           pattern_Users2_Create2_0,
+		httpMethod:        "POST",
+		method:            "/examplepb.Users2/Create2",
+		validator:         validate_Users2_Create2_0,
+		allowUnknown:      false,
+		formValidator:     validate_form_Users2_Create2_0,
+		responseValidator: validate_response_Users2_Create2_0,
 	},
 
+	// patterns for file example/examplepb/example_proto2.proto
+
 	// patterns for file example/examplepb/examplepb.proto
 
 }
 
+// validate_PatternsByMethod holds indexes of validate_Patterns by HTTP method, so
+// that a request is matched only against patterns of its method.
+var validate_PatternsByMethod = map[string][]int{
+	"POST":   {0, 11, 13, 20, 21, 22, 23, 24, 26, 28},
+	"PUT":    {1, 4, 9, 10, 12, 14, 18, 19},
+	"PATCH":  {2, 5},
+	"GET":    {3, 6, 7, 8, 15, 16, 17},
+	"SEARCH": {25},
+	"LINK":   {27},
+}
+
 // AtlasValidateAnnotator parses JSON input and validates unknown fields
 // based on 'allow_unknown_fields' options specified in proto file.
+// Validators and hooks get a context derived from ctx, so its values, deadline
+// and cancellation are preserved, runtime.Options of ctx tune validation.
 func AtlasValidateAnnotator(ctx context.Context, r *http.Request) metadata.MD {
 	md := make(metadata.MD)
-	for _, v := range validate_Patterns {
-		if r.Method == v.httpMethod && runtime1.PatternMatch(v.pattern, r.URL.Path) {
+	errorHeader := runtime1.ErrorHeader(ctx, "Atlas-Validation-Error")
+	for _, i := range validate_PatternsByMethod[r.Method] {
+		v := validate_Patterns[i]
+		if pathVars, ok := runtime1.PatternVariables(v.pattern, r.URL.Path); ok {
+			md.Set("Atlas-Validation-Method", v.method)
+			md.Set("Atlas-Validation-Allow-Unknown", strconv.FormatBool(v.allowUnknown))
 			var b []byte
 			var err error
 			if b, err = ioutil.ReadAll(r.Body); err != nil {
-				md.Set("Atlas-Validation-Error", "invalid value: unable to parse body")
+				md.Set(errorHeader, "invalid value: unable to parse body")
 				return md
 			}
 			r.Body = ioutil.NopCloser(bytes.NewReader(b))
-			ctx := context.WithValue(context.WithValue(context.Background(), runtime1.HTTPMethodContextKey, r.Method), runtime1.AllowUnknownContextKey, v.allowUnknown)
-			if err = v.validator(ctx, b); err != nil {
-				md.Set("Atlas-Validation-Error", err.Error())
+			ctx := context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, r.Method), runtime1.AllowUnknownContextKey, v.allowUnknown)
+			ctx = context.WithValue(context.WithValue(ctx, runtime1.PathVariablesContextKey, pathVars), runtime1.HTTPPathContextKey, r.URL.Path)
+			ctx = runtime1.WithFoldPackage(ctx, "examplepb")
+			form := v.formValidator != nil && runtime1.IsFormContentType(r.Header.Get("Content-Type"))
+			if !form {
+				if b, err = runtime1.RelaxJSON(b); err != nil {
+					md.Set(errorHeader, "invalid value: unable to parse body")
+					return md
+				}
+				r.Body = ioutil.NopCloser(bytes.NewReader(b))
+				r.ContentLength = int64(len(b))
+			}
+			var cacheKey string
+			if !form {
+				cacheKey = runtime1.ValidationCacheKey(ctx, "examplepb:"+v.pattern.String()+" "+r.URL.Path, r.Method, v.allowUnknown, r.URL.RawQuery, b)
+			}
+			if cacheKey != "" {
+				ctx = runtime1.TrackHooks(ctx)
+			}
+			if !runtime1.ValidationCached(cacheKey) {
+				if form {
+					// form bodies are not normalized, their keys are matched case-sensitively
+					err = runtime1.ValidateForm(runtime1.WithFoldPackage(ctx, ""), b, v.formValidator)
+				} else if err = v.validator(ctx, b); err == nil && v.queryValidator != nil {
+					err = v.queryValidator(ctx, r.URL.Query())
+				}
+				if err != nil {
+					md.Set(errorHeader, err.Error())
+					return md
+				}
+				if !runtime1.HooksCalled(ctx) {
+					runtime1.CacheValidation(cacheKey)
+				}
+			}
+			if !form {
+				var normalized []string
+				if b, normalized, err = runtime1.Normalize(ctx, b, v.defaulter); err != nil {
+					md.Set(errorHeader, err.Error())
+					return md
+				}
+				if len(normalized) != 0 {
+					md.Set("Atlas-Validation-Normalized", normalized...)
+					r.Body = ioutil.NopCloser(bytes.NewReader(b))
+					r.ContentLength = int64(len(b))
+				}
 			}
 			break
 		}
 	}
 	return md
 }
+
+// AtlasValidateReader validates a JSON body read from r of a request with HTTP method
+// and URL path the same way AtlasValidateAnnotator does, without an *http.Request.
+// Reading stops at the end of the body value, a request that matches no pattern
+// is not validated and r is not read. It is not a streaming validator: the body
+// value is buffered as a whole before validation, as by AtlasValidateAnnotator.
+func AtlasValidateReader(ctx context.Context, method, path string, r io.Reader) error {
+	for _, i := range validate_PatternsByMethod[method] {
+		v := validate_Patterns[i]
+		pathVars, ok := runtime1.PatternVariables(v.pattern, path)
+		if !ok {
+			continue
+		}
+		b, err := ioutil.ReadAll(r)
+		if err == nil {
+			b, err = runtime1.RelaxJSON(b)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid value: unable to parse body")
+		}
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, v.allowUnknown)
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.PathVariablesContextKey, pathVars), runtime1.HTTPPathContextKey, path)
+		return v.validator(ctx, b)
+	}
+	return nil
+}
+
+// ValidateCreateUserRequest validates body of a request with CreateUserRequest input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateCreateUserRequest(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "POST":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Users_Create_0(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for CreateUserRequest", method)
+}
+
+// ValidateUpdateUserRequest validates body of a request with UpdateUserRequest input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateUpdateUserRequest(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "PUT":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Users_Update_0(ctx, body)
+	case "PATCH":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Users_Update_1(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for UpdateUserRequest", method)
+}
+
+// ValidateEmptyRequest validates body of a request with EmptyRequest input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateEmptyRequest(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "GET":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Users_Get_0(ctx, body)
+	case "POST":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Compat_CreateAddressOrGroup_0(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for EmptyRequest", method)
+}
+
+// ValidateListUsersRequest validates body of a request with ListUsersRequest input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateListUsersRequest(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "GET":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Users_Search_0(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for ListUsersRequest", method)
+}
+
+// ValidateUser validates body of a request with User input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateUser(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "PUT":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Users_UpdateExternalUser_0(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for User", method)
+}
+
+// ValidateProfile validates body of a request with Profile input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateProfile(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "POST":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Profiles_Create_0(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for Profile", method)
+}
+
+// ValidateUpdateProfileRequest validates body of a request with UpdateProfileRequest input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateUpdateProfileRequest(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "PUT":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, true)
+		return validate_Profiles_Update_0(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for UpdateProfileRequest", method)
+}
+
+// ValidateGroup validates body of a request with Group input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateGroup(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "POST":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, true)
+		return validate_Groups_Create_0(ctx, body)
+	case "PUT":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, true)
+		return validate_Groups_Update_0(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for Group", method)
+}
+
+// ValidateTable validates body of a request with Table input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateTable(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "POST":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Tables_Import_0(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for Table", method)
+}
+
+// ValidateRawConfig validates body of a request with RawConfig input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateRawConfig(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "POST":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Configs_Apply_0(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for RawConfig", method)
+}
+
+// ValidateSearchQuery validates body of a request with SearchQuery input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateSearchQuery(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "SEARCH":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Queries_Run_0(ctx, body)
+	case "POST":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Queries_Run_1(ctx, body)
+	case "LINK":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Queries_Run_2(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for SearchQuery", method)
+}
+
+// ValidateUser2 validates body of a request with User2 input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateUser2(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "POST":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_Users2_Create2_0(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for User2", method)
+}
+
+// AtlasValidateResponse validates a JSON body of a response to r against the output
+// message of a method whose pattern r matches, e.g. in tests or a response interceptor.
+// Rules scoped to operations (deny, read_only, required) do not apply to responses,
+// a response to a request that matches no pattern or whose Accept header does not
+// allow JSON is not validated.
+func AtlasValidateResponse(ctx context.Context, r *http.Request, body []byte) error {
+	if !runtime1.AcceptsJSON(r.Header.Get("Accept")) {
+		return nil
+	}
+	for _, i := range validate_PatternsByMethod[r.Method] {
+		v := validate_Patterns[i]
+		if pathVars, ok := runtime1.PatternVariables(v.pattern, r.URL.Path); ok {
+			// operation scoped rules are matched against a method in context, a response has none
+			ctx := context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, ""), runtime1.AllowUnknownContextKey, v.allowUnknown)
+			ctx = context.WithValue(ctx, runtime1.PathVariablesContextKey, pathVars)
+			ctx = context.WithValue(ctx, runtime1.HTTPPathContextKey, r.URL.Path)
+			return v.responseValidator(ctx, body)
+		}
+	}
+	return nil
+}
+
+var validate_Methods = map[string]struct {
+	httpMethod   string
+	httpBody     string
+	bodyArray    bool
+	allowUnknown bool
+	validator    func(context.Context, json.RawMessage) error
+}{
+	"/examplepb.Users/Create":                {httpMethod: "POST", httpBody: "payload", bodyArray: false, allowUnknown: false, validator: validate_Users_Create_0},
+	"/examplepb.Users/Update":                {httpMethod: "PUT", httpBody: "payload", bodyArray: false, allowUnknown: false, validator: validate_Users_Update_0},
+	"/examplepb.Users/Get":                   {httpMethod: "GET", httpBody: "", bodyArray: false, allowUnknown: false, validator: validate_Users_Get_0},
+	"/examplepb.Users/Replace":               {httpMethod: "PUT", httpBody: "*", bodyArray: false, allowUnknown: false, validator: validate_Users_Replace_0},
+	"/examplepb.Users/List":                  {httpMethod: "GET", httpBody: "", bodyArray: false, allowUnknown: false, validator: validate_Users_List_0},
+	"/examplepb.Users/Search":                {httpMethod: "GET", httpBody: "", bodyArray: false, allowUnknown: false, validator: validate_Users_Search_0},
+	"/examplepb.Users/UpdateExternalUser":    {httpMethod: "PUT", httpBody: "external_user", bodyArray: false, allowUnknown: false, validator: validate_Users_UpdateExternalUser_0},
+	"/examplepb.Users/UpdateExternalUser2":   {httpMethod: "PUT", httpBody: "*", bodyArray: false, allowUnknown: false, validator: validate_Users_UpdateExternalUser2_0},
+	"/examplepb.Profiles/Create":             {httpMethod: "POST", httpBody: "*", bodyArray: false, allowUnknown: false, validator: validate_Profiles_Create_0},
+	"/examplepb.Profiles/Update":             {httpMethod: "PUT", httpBody: "payload", bodyArray: false, allowUnknown: true, validator: validate_Profiles_Update_0},
+	"/examplepb.Groups/Create":               {httpMethod: "POST", httpBody: "*", bodyArray: false, allowUnknown: true, validator: validate_Groups_Create_0},
+	"/examplepb.Groups/Update":               {httpMethod: "PUT", httpBody: "*", bodyArray: false, allowUnknown: true, validator: validate_Groups_Update_0},
+	"/examplepb.Groups/Search":               {httpMethod: "GET", httpBody: "", bodyArray: false, allowUnknown: true, validator: validate_Groups_Search_0},
+	"/examplepb.Groups/ValidatedList":        {httpMethod: "GET", httpBody: "", bodyArray: false, allowUnknown: false, validator: validate_Groups_ValidatedList_0},
+	"/examplepb.Groups/ValidateWKT":          {httpMethod: "PUT", httpBody: "*", bodyArray: false, allowUnknown: false, validator: validate_Groups_ValidateWKT_0},
+	"/examplepb.Compat/CreateAddressOrGroup": {httpMethod: "POST", httpBody: "*", bodyArray: false, allowUnknown: false, validator: validate_Compat_CreateAddressOrGroup_0},
+	"/examplepb.Compat/CreateProfileOrGroup": {httpMethod: "POST", httpBody: "*", bodyArray: false, allowUnknown: false, validator: validate_Compat_CreateProfileOrGroup_0},
+	"/examplepb.Tables/Import":               {httpMethod: "POST", httpBody: "*", bodyArray: false, allowUnknown: false, validator: validate_Tables_Import_0},
+	"/examplepb.Configs/Apply":               {httpMethod: "POST", httpBody: "*", bodyArray: false, allowUnknown: false, validator: validate_Configs_Apply_0},
+	"/examplepb.Queries/Run":                 {httpMethod: "SEARCH", httpBody: "*", bodyArray: false, allowUnknown: false, validator: validate_Queries_Run_0},
+	"/examplepb.Users2/Create2":              {httpMethod: "POST", httpBody: "*", bodyArray: false, allowUnknown: false, validator: validate_Users2_Create2_0},
+}
+
+// AtlasValidateInterceptor returns a gRPC server interceptor that validates a request
+// of a method bound to HTTP the same way AtlasValidateAnnotator validates its JSON body,
+// the request is marshaled to JSON and checked as a body of the first binding.
+func AtlasValidateInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		v, ok := validate_Methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		b, err := runtime1.MarshalRequestBody(req, v.httpBody)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if v.bodyArray && string(b) == "{}" {
+			// an empty repeated field is marshaled as absent
+			b = json.RawMessage("[]")
+		}
+		vctx := context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, v.httpMethod), runtime1.AllowUnknownContextKey, v.allowUnknown)
+		vctx = context.WithValue(vctx, runtime1.MarshaledContextKey, true)
+		if err = v.validator(vctx, b); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AtlasValidateSelfTest verifies that validators of all patterns are set up
+// and do not panic on an empty request, it is intended to be called at startup.
+func AtlasValidateSelfTest() error {
+	for _, v := range validate_Patterns {
+		if err := runtime1.SelfTest(v.pattern, v.httpMethod, v.allowUnknown, v.validator, v.defaulter, v.queryValidator, v.formValidator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AtlasValidatePattern describes an HTTP binding validated by AtlasValidateAnnotator.
+type AtlasValidatePattern struct {
+	HTTPMethod string
+	// AllowUnknown reports whether unknown fields of a body are accepted.
+	AllowUnknown bool
+	Pattern      runtime.Pattern
+}
+
+// AtlasValidatePatterns returns HTTP bindings validated by AtlasValidateAnnotator in
+// order they are matched.
+func AtlasValidatePatterns() []AtlasValidatePattern {
+	patterns := make([]AtlasValidatePattern, 0, len(validate_Patterns))
+	for _, v := range validate_Patterns {
+		patterns = append(patterns, AtlasValidatePattern{HTTPMethod: v.httpMethod, AllowUnknown: v.allowUnknown, Pattern: v.pattern})
+	}
+	return patterns
+}
+
+// RegisterAtlasValidators registers validators of HTTP bindings validated by
+// AtlasValidateAnnotator with reg in order they are matched, a registered validator
+// validates a body the same way AtlasValidateReader does for a matching path, a body
+// of a path that does not match the pattern of the validator is not validated.
+func RegisterAtlasValidators(reg runtime1.Registrar) {
+	for _, v := range validate_Patterns {
+		v := v
+		reg.RegisterValidator(v.httpMethod, v.pattern, func(ctx context.Context, path string, body json.RawMessage) error {
+			pathVars, ok := runtime1.PatternVariables(v.pattern, path)
+			if !ok {
+				return nil
+			}
+			body, err := runtime1.RelaxJSON(body)
+			if err != nil {
+				return fmt.Errorf("invalid value: unable to parse body")
+			}
+			ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, v.httpMethod), runtime1.AllowUnknownContextKey, v.allowUnknown)
+			ctx = context.WithValue(context.WithValue(ctx, runtime1.PathVariablesContextKey, pathVars), runtime1.HTTPPathContextKey, path)
+			return v.validator(ctx, body)
+		})
+	}
+}
+
+// AtlasValidateConstraints describes validation rules declared with options of
+// fields, keyed by a full name of a field, e.g. to feed generation of API docs.
+var AtlasValidateConstraints = map[string]runtime1.FieldConstraints{
+	"examplepb.Address.state":                    {Denied: []string{"PATCH", "POST", "PUT"}},
+	"examplepb.Address.region":                   {InSet: "regions"},
+	"examplepb.Address.languages":                {InSet: "languages"},
+	"examplepb.Contact.Email.address":            {Required: []string{"POST"}},
+	"examplepb.Contact.Phone.number":             {Required: []string{"POST"}},
+	"examplepb.ContactInfo.address":              {NonNullable: true},
+	"examplepb.Credentials.username":             {Required: []string{"PATCH", "POST", "PUT"}},
+	"examplepb.Credentials.version":              {Required: []string{"POST"}},
+	"examplepb.Group.id":                         {Required: []string{"PATCH", "PUT"}},
+	"examplepb.Group.name":                       {Required: []string{"POST"}},
+	"examplepb.Group.notes":                      {MaxFieldBytes: 64},
+	"examplepb.Group.tags":                       {MaxLength: 8},
+	"examplepb.Group.avatar":                     {MaxLength: 4},
+	"examplepb.ListUsersRequest.page_size":       {Min: runtime1.Float64(1), Max: runtime1.Float64(100)},
+	"examplepb.ListUsersRequest.filter":          {Pattern: "^[a-z_]+(==|!=).+$", MaxLength: 32},
+	"examplepb.ListUsersRequest.created_before":  {MaxFutureSkew: "1h"},
+	"examplepb.Measurement.age":                  {Min: runtime1.Float64(0), Max: runtime1.Float64(150)},
+	"examplepb.Measurement.offset":               {Min: runtime1.Float64(-9.007199254740992e+15), Max: runtime1.Float64(9.007199254740992e+15)},
+	"examplepb.Measurement.size":                 {Min: runtime1.Float64(1)},
+	"examplepb.Measurement.weights":              {Min: runtime1.Float64(0), Max: runtime1.Float64(0.5)},
+	"examplepb.Measurement.limit":                {Min: runtime1.Float64(1)},
+	"examplepb.Policy.Rule.labels":               {KeyPattern: "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"},
+	"examplepb.Policy.Rule.Condition.expression": {Required: []string{"POST"}},
+	"examplepb.Profile.name":                     {Denied: []string{"PATCH", "PUT"}},
+	"examplepb.Profile.notes":                    {Default: "\"n/a\""},
+	"examplepb.Profile.digest_schedule":          {Format: "cron"},
+	"examplepb.Profile.reminders":                {Format: "cron_seconds"},
+	"examplepb.Profile.color":                    {Format: "hex_color"},
+	"examplepb.Profile.device_mac":               {Format: "mac"},
+	"examplepb.Profile.email":                    {Pattern: "^[^@]+@[^@]+$"},
+	"examplepb.Schedule.end":                     {RequiredIf: "start"},
+	"examplepb.Schedule.timezone":                {Required: []string{"POST"}, RequiredIf: "start"},
+	"examplepb.Schedule.days":                    {MinItems: 1, MaxItems: 7},
+	"examplepb.Schedule.exceptions":              {MaxItems: 2},
+	"examplepb.SearchQuery.query":                {Required: []string{"LINK", "POST", "SEARCH"}},
+	"examplepb.SearchQuery.refresh":              {Denied: []string{"LINK", "SEARCH"}},
+	"examplepb.SearchQuery.owner":                {Required: []string{"PATCH", "POST", "PUT"}},
+	"examplepb.SearchQuery.created_by":           {Denied: []string{"LINK", "PATCH", "POST", "PUT", "SEARCH"}, ReadOnly: true},
+	"examplepb.SearchQuery.locale":               {Default: "\"en\"", InSet: "locales"},
+	"examplepb.SearchQuery.refresh_schedule":     {Default: "\"0 * * * *\"", Format: "cron"},
+	"examplepb.Settings.theme":                   {Required: []string{"PATCH", "POST"}},
+	"examplepb.Settings.time_zone":               {Required: []string{"PATCH", "PUT"}},
+	"examplepb.User.id":                          {Denied: []string{"POST"}},
+	"examplepb.User.name":                        {Required: []string{"PATCH", "POST", "PUT"}},
+	"examplepb.User.timestamp":                   {MaxFutureSkew: "5m"},
+	"examplepb.User.created_by":                  {Denied: []string{"LINK", "PATCH", "POST", "PUT", "SEARCH"}, ReadOnly: true},
+	"examplepb.User2.id":                         {Denied: []string{"POST"}},
+	"examplepb.User2.name":                       {Required: []string{"PATCH", "POST", "PUT"}},
+	"examplepb.User2.display_name":               {Required: []string{"POST"}},
+}
@@ -7,11 +7,18 @@ import bytes "bytes"
 import context "context"
 import fmt "fmt"
 import http "net/http"
+import io "io"
 import ioutil "io/ioutil"
 import json "encoding/json"
+import strconv "strconv"
+import url "net/url"
 import metadata "google.golang.org/grpc/metadata"
+import grpc "google.golang.org/grpc"
+import codes "google.golang.org/grpc/codes"
+import status "google.golang.org/grpc/status"
 import runtime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 import runtime1 "github.com/infobloxopen/protoc-gen-atlas-validate/runtime"
+import cel "github.com/infobloxopen/protoc-gen-atlas-validate/runtime/cel"
 import proto "github.com/gogo/protobuf/proto"
 import math "math"
 import _ "github.com/infobloxopen/protoc-gen-atlas-validate/options"
@@ -22,46 +29,158 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+var validate_Enum_ExternalUser_Role = &runtime1.Enum{
+	Name:     "Role",
+	Names:    map[string]int32{"ROLE_MEMBER": 0, "ROLE_OWNER": 1},
+	Prefix:   "ROLE_",
+	Variants: false,
+}
+
+var validate_Enum_Kind = &runtime1.Enum{
+	Name:     "Kind",
+	Names:    map[string]int32{"KIND_PERSON": 0, "KIND_SERVICE": 1},
+	Prefix:   "KIND_",
+	Variants: false,
+}
+
+// validate_ExternalAccounts_Create_0 is an entrypoint for validating "POST" HTTP request
+// that match *.pb.gw.go/pattern_ExternalAccounts_Create_0.
+func validate_ExternalAccounts_Create_0(ctx context.Context, r json.RawMessage) (err error) {
+	return validate_Object_ExternalAccount(ctx, r, "")
+}
+
+// default_ExternalAccounts_Create_0 injects default values into a body of "POST" HTTP request
+// that match *.pb.gw.go/pattern_ExternalAccounts_Create_0.
+func default_ExternalAccounts_Create_0(ctx context.Context, r json.RawMessage) (json.RawMessage, error) {
+	return default_Object_ExternalAccount(ctx, r, "")
+}
+
 // validate_Object_ExternalUser function validates a JSON for a given object.
 func validate_Object_ExternalUser(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&ExternalUser{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
 
 	var v map[string]json.RawMessage
 	if err = json.Unmarshal(r, &v); err != nil {
-		return fmt.Errorf("invalid value for %q: expected object.", path)
+		return runtime1.WithCode(fmt.Errorf("invalid value for %q: expected object.", path), runtime1.CodeTypeMismatch, path)
+	}
+
+	if runtime1.FoldsKeys(ctx, "external") {
+		if err = runtime1.FoldKeys(v, path, []string{"id", "name", "address", "addresses", "display_name", "displayName"}, runtime1.JoinPointer); err != nil {
+			return err
+		}
+	}
+
+	if vv, ok := v["displayName"]; ok {
+		if _, ok := v["display_name"]; ok {
+			return fmt.Errorf("field %q is set twice.", runtime1.JoinPointer(path, "display_name"))
+		}
+		delete(v, "displayName")
+		v["display_name"] = vv
 	}
 
+	if len(v) > 8 {
+		return fmt.Errorf("object %q has too many fields", path)
+	}
+
+	var errs []error
 	if err = validate_required_Object_ExternalUser(ctx, v, path); err != nil {
-		return err
+		errs = runtime1.AppendError(errs, err)
 	}
 
 	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
 
-	for k, _ := range v {
+	for _, k := range runtime1.SortedKeys(v) {
 		switch k {
 		case "id":
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPointer(path, k)); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPointer(path, k), "int32"); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
 		case "name":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPointer(path, k), "string"); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
 		case "address":
-			if v[k] == nil {
+			runtime1.MarkCovered(ctx, runtime1.JoinPointer(path, k))
+			if v[k] == nil || string(v[k]) == "null" {
 				continue
 			}
 			vv := v[k]
-			vvPath := runtime1.JoinPath(path, k)
+			vvPath := runtime1.JoinPointer(path, k)
 			if err = validate_Object_ExternalAddress(ctx, vv, vvPath); err != nil {
-				return err
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+		case "addresses":
+			runtime1.MarkCovered(ctx, runtime1.JoinPointer(path, k))
+			if v[k] == nil {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPointer(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(fmt.Errorf("invalid value for %q: expected array.", vArrPath), runtime1.CodeTypeMismatch, vArrPath))
+				continue
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			for i, vv := range vArr {
+				vvPath := runtime1.JoinPointerIndex(vArrPath, i)
+				if err = validate_Object_ExternalAddress(ctx, vv, vvPath); err != nil {
+					errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+					continue
+				}
+			}
+		case "display_name":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPointer(path, k), "string"); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
 			}
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+					continue
+				}
+				continue
+			}
 			if !allowUnknown {
-				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+				errs = runtime1.AppendError(errs, runtime1.WithCode(fmt.Errorf("unknown field %q.", runtime1.JoinPointer(path, k)), runtime1.CodeUnknownField, runtime1.JoinPointer(path, k)))
+				continue
 			}
 		}
 	}
+	if len(errs) != 0 {
+		return runtime1.JoinErrors(errs)
+	}
 	return nil
 }
 
@@ -70,7 +189,11 @@ func (_ *ExternalUser) AtlasValidateJSON(ctx context.Context, r json.RawMessage,
 	if hook, ok := interface{}(&ExternalUser{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -80,39 +203,133 @@ func (_ *ExternalUser) AtlasValidateJSON(ctx context.Context, r json.RawMessage,
 func validate_required_Object_ExternalUser(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
-	return nil
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	var errs []error
+	return runtime1.JoinErrors(errs)
+}
+
+// default_Object_ExternalUser function injects default values of absent fields into a JSON for a given object.
+func default_Object_ExternalUser(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(r, &v); err != nil || v == nil {
+		return r, nil
+	}
+
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	changed := false
+
+	if runtime1.NormalizeKeys(v, []string{"id", "name", "address", "addresses", "display_name", "displayName"}) {
+		changed = true
+	}
+
+	if vv, ok := v["address"]; ok {
+		vvPath := runtime1.JoinPointer(path, "address")
+		nv, err := default_Object_ExternalAddress(ctx, vv, vvPath)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(nv, vv) {
+			v["address"], changed = nv, true
+		}
+	}
+	if vv, ok := v["addresses"]; ok {
+		vvPath := runtime1.JoinPointer(path, "addresses")
+		var vArr []json.RawMessage
+		if err := json.Unmarshal(vv, &vArr); err == nil {
+			arrChanged := false
+			for i, vvv := range vArr {
+				nv, err := default_Object_ExternalAddress(ctx, vvv, runtime1.JoinPointerIndex(vvPath, i))
+				if err != nil {
+					return nil, err
+				}
+				if !bytes.Equal(nv, vvv) {
+					vArr[i], arrChanged = nv, true
+				}
+			}
+			if arrChanged {
+				if v["addresses"], err = json.Marshal(vArr); err != nil {
+					return nil, err
+				}
+				changed = true
+			}
+		}
+	}
+
+	if !changed {
+		return r, nil
+	}
+	return json.Marshal(v)
 }
 
 // validate_Object_ExternalUser_Parent function validates a JSON for a given object.
 func validate_Object_ExternalUser_Parent(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&ExternalUser_Parent{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
 
 	var v map[string]json.RawMessage
 	if err = json.Unmarshal(r, &v); err != nil {
-		return fmt.Errorf("invalid value for %q: expected object.", path)
+		return runtime1.WithCode(fmt.Errorf("invalid value for %q: expected object.", path), runtime1.CodeTypeMismatch, path)
 	}
 
+	if runtime1.FoldsKeys(ctx, "external") {
+		if err = runtime1.FoldKeys(v, path, []string{"name"}, runtime1.JoinPointer); err != nil {
+			return err
+		}
+	}
+
+	if len(v) > 8 {
+		return fmt.Errorf("object %q has too many fields", path)
+	}
+
+	var errs []error
 	if err = validate_required_Object_ExternalUser_Parent(ctx, v, path); err != nil {
-		return err
+		errs = runtime1.AppendError(errs, err)
 	}
 
 	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
 
-	for k, _ := range v {
+	for _, k := range runtime1.SortedKeys(v) {
 		switch k {
 		case "name":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPointer(path, k), "string"); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+					continue
+				}
+				continue
+			}
 			if !allowUnknown {
-				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+				errs = runtime1.AppendError(errs, runtime1.WithCode(fmt.Errorf("unknown field %q.", runtime1.JoinPointer(path, k)), runtime1.CodeUnknownField, runtime1.JoinPointer(path, k)))
+				continue
 			}
 		}
 	}
+	if len(errs) != 0 {
+		return runtime1.JoinErrors(errs)
+	}
 	return nil
 }
 
@@ -121,7 +338,11 @@ func (_ *ExternalUser_Parent) AtlasValidateJSON(ctx context.Context, r json.RawM
 	if hook, ok := interface{}(&ExternalUser_Parent{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -131,42 +352,127 @@ func (_ *ExternalUser_Parent) AtlasValidateJSON(ctx context.Context, r json.RawM
 func validate_required_Object_ExternalUser_Parent(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
-	return nil
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	var errs []error
+	return runtime1.JoinErrors(errs)
+}
+
+// default_Object_ExternalUser_Parent function injects default values of absent fields into a JSON for a given object.
+func default_Object_ExternalUser_Parent(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(r, &v); err != nil || v == nil {
+		return r, nil
+	}
+
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	changed := false
+
+	if runtime1.NormalizeKeys(v, []string{"name"}) {
+		changed = true
+	}
+
+	if !changed {
+		return r, nil
+	}
+	return json.Marshal(v)
 }
 
 // validate_Object_ExternalAddress function validates a JSON for a given object.
 func validate_Object_ExternalAddress(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&ExternalAddress{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
 
 	var v map[string]json.RawMessage
 	if err = json.Unmarshal(r, &v); err != nil {
-		return fmt.Errorf("invalid value for %q: expected object.", path)
+		return runtime1.WithCode(fmt.Errorf("invalid value for %q: expected object.", path), runtime1.CodeTypeMismatch, path)
+	}
+
+	if runtime1.FoldsKeys(ctx, "external") {
+		if err = runtime1.FoldKeys(v, path, []string{"country", "state", "city", "zip"}, runtime1.JoinPointer); err != nil {
+			return err
+		}
 	}
 
+	if len(v) > 8 {
+		return fmt.Errorf("object %q has too many fields", path)
+	}
+
+	var errs []error
 	if err = validate_required_Object_ExternalAddress(ctx, v, path); err != nil {
-		return err
+		errs = runtime1.AppendError(errs, err)
 	}
 
 	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
 
-	for k, _ := range v {
+	for _, k := range runtime1.SortedKeys(v) {
 		switch k {
 		case "country":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPointer(path, k), "string"); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
 		case "state":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPointer(path, k), "string"); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
 		case "city":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPointer(path, k), "string"); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
 		case "zip":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPointer(path, k), "string"); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
 		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+					continue
+				}
+				continue
+			}
 			if !allowUnknown {
-				return fmt.Errorf("unknown field %q.", runtime1.JoinPath(path, k))
+				errs = runtime1.AppendError(errs, runtime1.WithCode(fmt.Errorf("unknown field %q.", runtime1.JoinPointer(path, k)), runtime1.CodeUnknownField, runtime1.JoinPointer(path, k)))
+				continue
 			}
 		}
 	}
+	if len(errs) != 0 {
+		return runtime1.JoinErrors(errs)
+	}
 	return nil
 }
 
@@ -175,7 +481,11 @@ func (_ *ExternalAddress) AtlasValidateJSON(ctx context.Context, r json.RawMessa
 	if hook, ok := interface{}(&ExternalAddress{}).(interface {
 		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
 	}); ok {
+		runtime1.MarkHookCalled(ctx)
 		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
 			return err
 		}
 	}
@@ -185,39 +495,452 @@ func (_ *ExternalAddress) AtlasValidateJSON(ctx context.Context, r json.RawMessa
 func validate_required_Object_ExternalAddress(ctx context.Context, v map[string]json.RawMessage, path string) error {
 	method := runtime1.HTTPMethodFromContext(ctx)
 	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	var errs []error
+	return runtime1.JoinErrors(errs)
+}
+
+// default_Object_ExternalAddress function injects default values of absent fields into a JSON for a given object.
+func default_Object_ExternalAddress(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(r, &v); err != nil || v == nil {
+		return r, nil
+	}
+
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	changed := false
+
+	if runtime1.NormalizeKeys(v, []string{"country", "state", "city", "zip"}) {
+		changed = true
+	}
+
+	if !changed {
+		return r, nil
+	}
+	return json.Marshal(v)
+}
+
+var cel_Object_ExternalAccount_0 = cel.MustCompile("!has(this.login) || this.login != 'root'")
+
+// validate_Object_ExternalAccount function validates a JSON for a given object.
+func validate_Object_ExternalAccount(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if ctx, err = runtime1.EnterObject(ctx, path); err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ExternalAccount{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(r, &v); err != nil {
+		return runtime1.WithCode(fmt.Errorf("invalid value for %q: expected object.", path), runtime1.CodeTypeMismatch, path)
+	}
+
+	if runtime1.FoldsKeys(ctx, "external") {
+		if err = runtime1.FoldKeys(v, path, []string{"id", "login", "addresses", "display_name", "displayName"}, runtime1.JoinPointer); err != nil {
+			return err
+		}
+	}
+
+	if vv, ok := v["displayName"]; ok {
+		if _, ok := v["display_name"]; ok {
+			return fmt.Errorf("field %q is set twice.", runtime1.JoinPointer(path, "display_name"))
+		}
+		delete(v, "displayName")
+		v["display_name"] = vv
+	}
+
+	if len(v) > 8 {
+		return fmt.Errorf("object %q has too many fields", path)
+	}
+
+	var errs []error
+	if err = validate_required_Object_ExternalAccount(ctx, v, path); err != nil {
+		errs = runtime1.AppendError(errs, err)
+	}
+
+	allowUnknown := runtime1.AllowUnknownFromContext(ctx)
+
+	for _, k := range runtime1.SortedKeys(v) {
+		switch k {
+		case "id":
+			runtime1.MarkCovered(ctx, runtime1.JoinPointer(path, k))
+			method := runtime1.HTTPMethodFromContext(ctx)
+			if method == "POST" {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(fmt.Errorf("field %q is unsupported for %q operation.", k, method), runtime1.CodeDenied, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateNotBoolean(v[k], runtime1.JoinPointer(path, k)); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPointer(path, k), "int32"); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
+		case "login":
+			runtime1.MarkCovered(ctx, runtime1.JoinPointer(path, k))
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPointer(path, k), "string"); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
+		case "addresses":
+			runtime1.MarkCovered(ctx, runtime1.JoinPointer(path, k))
+			if v[k] == nil {
+				continue
+			}
+			var vArr []json.RawMessage
+			vArrPath := runtime1.JoinPointer(path, k)
+			if err = json.Unmarshal(v[k], &vArr); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(fmt.Errorf("invalid value for %q: expected array.", vArrPath), runtime1.CodeTypeMismatch, vArrPath))
+				continue
+			}
+			if err = runtime1.CountElements(ctx, len(vArr)); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			for i, vv := range vArr {
+				vvPath := runtime1.JoinPointerIndex(vArrPath, i)
+				if err = validate_Object_ExternalAddress(ctx, vv, vvPath); err != nil {
+					errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+					continue
+				}
+			}
+		case "display_name":
+			if err = runtime1.CountText(ctx, v[k]); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+				continue
+			}
+			if err = runtime1.ValidateScalar(v[k], runtime1.JoinPointer(path, k), "string"); err != nil {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeTypeMismatch, runtime1.JoinPointer(path, k)))
+				continue
+			}
+		default:
+			if handler := runtime1.GetUnknownFieldHandler(); handler != nil {
+				if err = handler(ctx, path, k, v[k]); err != nil {
+					errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, runtime1.JoinPointer(path, k)))
+					continue
+				}
+				continue
+			}
+			if !allowUnknown {
+				errs = runtime1.AppendError(errs, runtime1.WithCode(fmt.Errorf("unknown field %q.", runtime1.JoinPointer(path, k)), runtime1.CodeUnknownField, runtime1.JoinPointer(path, k)))
+				continue
+			}
+		}
+	}
+	if err = cel.Validate(cel_Object_ExternalAccount_0, r, path, "login may not be root."); err != nil {
+		errs = runtime1.AppendError(errs, runtime1.WithCode(err, runtime1.CodeInvalid, path))
+	}
+	if len(errs) != 0 {
+		return runtime1.JoinErrors(errs)
+	}
 	return nil
 }
 
+// AtlasValidateJSON function validates a JSON for object ExternalAccount.
+func (_ *ExternalAccount) AtlasValidateJSON(ctx context.Context, r json.RawMessage, path string) (err error) {
+	if hook, ok := interface{}(&ExternalAccount{}).(interface {
+		AtlasJSONValidate(context.Context, json.RawMessage, string) (json.RawMessage, error)
+	}); ok {
+		runtime1.MarkHookCalled(ctx)
+		if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {
+			if err == runtime1.ErrSkipValidation {
+				return nil
+			}
+			return err
+		}
+	}
+	return validate_Object_ExternalAccount(ctx, r, path)
+}
+
+func validate_required_Object_ExternalAccount(ctx context.Context, v map[string]json.RawMessage, path string) error {
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	marshaled := runtime1.MarshaledFromContext(ctx)
+	_ = marshaled
+	var errs []error
+	if _, ok := v["login"]; !ok && !marshaled && (method == "POST") {
+		errs = runtime1.AppendError(errs, runtime1.WithCode(fmt.Errorf("field %q is required for %q operation.", runtime1.JoinPointer(path, "login"), method), runtime1.CodeRequired, runtime1.JoinPointer(path, "login")))
+	}
+	return runtime1.JoinErrors(errs)
+}
+
+// default_Object_ExternalAccount function injects default values of absent fields into a JSON for a given object.
+func default_Object_ExternalAccount(ctx context.Context, r json.RawMessage, path string) (json.RawMessage, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(r, &v); err != nil || v == nil {
+		return r, nil
+	}
+
+	method := runtime1.HTTPMethodFromContext(ctx)
+	_ = method
+	changed := false
+
+	if runtime1.NormalizeKeys(v, []string{"id", "login", "addresses", "display_name", "displayName"}) {
+		changed = true
+	}
+
+	if vv, ok := v["addresses"]; ok {
+		vvPath := runtime1.JoinPointer(path, "addresses")
+		var vArr []json.RawMessage
+		if err := json.Unmarshal(vv, &vArr); err == nil {
+			arrChanged := false
+			for i, vvv := range vArr {
+				nv, err := default_Object_ExternalAddress(ctx, vvv, runtime1.JoinPointerIndex(vvPath, i))
+				if err != nil {
+					return nil, err
+				}
+				if !bytes.Equal(nv, vvv) {
+					vArr[i], arrChanged = nv, true
+				}
+			}
+			if arrChanged {
+				if v["addresses"], err = json.Marshal(vArr); err != nil {
+					return nil, err
+				}
+				changed = true
+			}
+		}
+	}
+	if !runtime1.HasKey(v, true, "display_name", "displayName") {
+		v["display_name"] = json.RawMessage("\"`anonymous`\"")
+		changed = true
+	}
+
+	if !changed {
+		return r, nil
+	}
+	return json.Marshal(v)
+}
+
 var validate_Patterns = []struct {
 	pattern    runtime.Pattern
 	httpMethod string
-	validator  func(context.Context, json.RawMessage) error
+	// method is a gRPC name of the method the pattern is bound to.
+	method    string
+	validator func(context.Context, json.RawMessage) error
 	// Included for introspection purpose.
 	allowUnknown bool
+	// defaulter injects default values into a valid body, nil if there is nothing to inject.
+	defaulter func(context.Context, json.RawMessage) (json.RawMessage, error)
+	// queryValidator validates query parameters of a request without body, nil if they are not validated.
+	queryValidator func(context.Context, url.Values) error
+	// formValidator validates a form-urlencoded body, nil if such bodies are not validated.
+	formValidator func(context.Context, url.Values) error
 }{
 	// patterns for file example/external/external.proto
+	{
+		pattern:      pattern_ExternalAccounts_Create_0,
+		httpMethod:   "POST",
+		method:       "/external.ExternalAccounts/Create",
+		validator:    validate_ExternalAccounts_Create_0,
+		allowUnknown: false,
+		defaulter:    default_ExternalAccounts_Create_0,
+	},
+}
 
+// validate_PatternsByMethod holds indexes of validate_Patterns by HTTP method, so
+// that a request is matched only against patterns of its method.
+var validate_PatternsByMethod = map[string][]int{
+	"POST": {0},
 }
 
 // AtlasValidateAnnotator parses JSON input and validates unknown fields
 // based on 'allow_unknown_fields' options specified in proto file.
+// Validators and hooks get a context derived from ctx, so its values, deadline
+// and cancellation are preserved, runtime.Options of ctx tune validation.
+// A request is validated only if its X-Atlas-Validate header is true.
 func AtlasValidateAnnotator(ctx context.Context, r *http.Request) metadata.MD {
 	md := make(metadata.MD)
-	for _, v := range validate_Patterns {
-		if r.Method == v.httpMethod && runtime1.PatternMatch(v.pattern, r.URL.Path) {
+	if optIn, _ := strconv.ParseBool(r.Header.Get("X-Atlas-Validate")); !optIn {
+		return md
+	}
+	errorHeader := runtime1.ErrorHeader(ctx, "Atlas-Validation-Error")
+	for _, i := range validate_PatternsByMethod[r.Method] {
+		v := validate_Patterns[i]
+		if pathVars, ok := runtime1.PatternVariables(v.pattern, r.URL.Path); ok {
+			md.Set("Atlas-Validation-Method", v.method)
+			md.Set("Atlas-Validation-Allow-Unknown", strconv.FormatBool(v.allowUnknown))
 			var b []byte
 			var err error
 			if b, err = ioutil.ReadAll(r.Body); err != nil {
-				md.Set("Atlas-Validation-Error", "invalid value: unable to parse body")
+				md.Set(errorHeader, "invalid value: unable to parse body")
 				return md
 			}
 			r.Body = ioutil.NopCloser(bytes.NewReader(b))
-			ctx := context.WithValue(context.WithValue(context.Background(), runtime1.HTTPMethodContextKey, r.Method), runtime1.AllowUnknownContextKey, v.allowUnknown)
-			if err = v.validator(ctx, b); err != nil {
-				md.Set("Atlas-Validation-Error", err.Error())
+			ctx := context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, r.Method), runtime1.AllowUnknownContextKey, v.allowUnknown)
+			ctx = context.WithValue(context.WithValue(ctx, runtime1.PathVariablesContextKey, pathVars), runtime1.HTTPPathContextKey, r.URL.Path)
+			ctx = runtime1.WithFoldPackage(ctx, "external")
+			form := v.formValidator != nil && runtime1.IsFormContentType(r.Header.Get("Content-Type"))
+			var cacheKey string
+			if !form {
+				cacheKey = runtime1.ValidationCacheKey(ctx, "external:"+v.pattern.String()+" "+r.URL.Path, r.Method, v.allowUnknown, r.URL.RawQuery, b)
+			}
+			if cacheKey != "" {
+				ctx = runtime1.TrackHooks(ctx)
+			}
+			if !runtime1.ValidationCached(cacheKey) {
+				if form {
+					// form bodies are not normalized, their keys are matched case-sensitively
+					err = runtime1.ValidateForm(runtime1.WithFoldPackage(ctx, ""), b, v.formValidator)
+				} else if err = v.validator(ctx, b); err == nil && v.queryValidator != nil {
+					err = v.queryValidator(ctx, r.URL.Query())
+				}
+				if err != nil {
+					md.Set(errorHeader, runtime1.ErrorsJSON(runtime1.ReportedErrors(ctx, err)))
+					return md
+				}
+				if !runtime1.HooksCalled(ctx) {
+					runtime1.CacheValidation(cacheKey)
+				}
+			}
+			if !form {
+				var normalized []string
+				if b, normalized, err = runtime1.Normalize(ctx, b, v.defaulter); err != nil {
+					md.Set(errorHeader, err.Error())
+					return md
+				}
+				if len(normalized) != 0 {
+					md.Set("Atlas-Validation-Normalized", normalized...)
+					r.Body = ioutil.NopCloser(bytes.NewReader(b))
+					r.ContentLength = int64(len(b))
+				}
 			}
 			break
 		}
 	}
 	return md
 }
+
+// AtlasValidateReader validates a JSON body read from r of a request with HTTP method
+// and URL path the same way AtlasValidateAnnotator does, without an *http.Request.
+// Reading stops at the end of the body value, a request that matches no pattern
+// is not validated and r is not read. It is not a streaming validator: the body
+// value is buffered as a whole before validation, as by AtlasValidateAnnotator.
+func AtlasValidateReader(ctx context.Context, method, path string, r io.Reader) error {
+	for _, i := range validate_PatternsByMethod[method] {
+		v := validate_Patterns[i]
+		pathVars, ok := runtime1.PatternVariables(v.pattern, path)
+		if !ok {
+			continue
+		}
+		b, err := runtime1.ReadJSON(r)
+		if err != nil {
+			return err
+		}
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, v.allowUnknown)
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.PathVariablesContextKey, pathVars), runtime1.HTTPPathContextKey, path)
+		return v.validator(ctx, b)
+	}
+	return nil
+}
+
+// ValidateExternalAccount validates body of a request with ExternalAccount input for HTTP method,
+// e.g. "POST", the same way AtlasValidateAnnotator does it.
+func ValidateExternalAccount(ctx context.Context, body []byte, method string) error {
+	switch method {
+	case "POST":
+		ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, method), runtime1.AllowUnknownContextKey, false)
+		return validate_ExternalAccounts_Create_0(ctx, body)
+	}
+	return fmt.Errorf("%q operation is not supported for ExternalAccount", method)
+}
+
+var validate_Methods = map[string]struct {
+	httpMethod   string
+	httpBody     string
+	bodyArray    bool
+	allowUnknown bool
+	validator    func(context.Context, json.RawMessage) error
+}{
+	"/external.ExternalAccounts/Create": {httpMethod: "POST", httpBody: "*", bodyArray: false, allowUnknown: false, validator: validate_ExternalAccounts_Create_0},
+}
+
+// AtlasValidateInterceptor returns a gRPC server interceptor that validates a request
+// of a method bound to HTTP the same way AtlasValidateAnnotator validates its JSON body,
+// the request is marshaled to JSON and checked as a body of the first binding.
+func AtlasValidateInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		v, ok := validate_Methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		b, err := runtime1.MarshalRequestBody(req, v.httpBody)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if v.bodyArray && string(b) == "{}" {
+			// an empty repeated field is marshaled as absent
+			b = json.RawMessage("[]")
+		}
+		vctx := context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, v.httpMethod), runtime1.AllowUnknownContextKey, v.allowUnknown)
+		vctx = context.WithValue(vctx, runtime1.MarshaledContextKey, true)
+		if err = v.validator(vctx, b); err != nil {
+			return nil, status.Error(codes.InvalidArgument, runtime1.ErrorsJSON(runtime1.ReportedErrors(ctx, err)))
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AtlasValidateSelfTest verifies that validators of all patterns are set up
+// and do not panic on an empty request, it is intended to be called at startup.
+func AtlasValidateSelfTest() error {
+	for _, v := range validate_Patterns {
+		if err := runtime1.SelfTest(v.pattern, v.httpMethod, v.allowUnknown, v.validator, v.defaulter, v.queryValidator, v.formValidator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AtlasValidatePattern describes an HTTP binding validated by AtlasValidateAnnotator.
+type AtlasValidatePattern struct {
+	HTTPMethod string
+	// AllowUnknown reports whether unknown fields of a body are accepted.
+	AllowUnknown bool
+	Pattern      runtime.Pattern
+}
+
+// AtlasValidatePatterns returns HTTP bindings validated by AtlasValidateAnnotator in
+// order they are matched.
+func AtlasValidatePatterns() []AtlasValidatePattern {
+	patterns := make([]AtlasValidatePattern, 0, len(validate_Patterns))
+	for _, v := range validate_Patterns {
+		patterns = append(patterns, AtlasValidatePattern{HTTPMethod: v.httpMethod, AllowUnknown: v.allowUnknown, Pattern: v.pattern})
+	}
+	return patterns
+}
+
+// RegisterAtlasValidators registers validators of HTTP bindings validated by
+// AtlasValidateAnnotator with reg in order they are matched, a registered validator
+// validates a body the same way AtlasValidateReader does for a matching path, a body
+// of a path that does not match the pattern of the validator is not validated.
+func RegisterAtlasValidators(reg runtime1.Registrar) {
+	for _, v := range validate_Patterns {
+		v := v
+		reg.RegisterValidator(v.httpMethod, v.pattern, func(ctx context.Context, path string, body json.RawMessage) error {
+			pathVars, ok := runtime1.PatternVariables(v.pattern, path)
+			if !ok {
+				return nil
+			}
+			ctx = context.WithValue(context.WithValue(ctx, runtime1.HTTPMethodContextKey, v.httpMethod), runtime1.AllowUnknownContextKey, v.allowUnknown)
+			ctx = context.WithValue(context.WithValue(ctx, runtime1.PathVariablesContextKey, pathVars), runtime1.HTTPPathContextKey, path)
+			return v.validator(ctx, body)
+		})
+	}
+}
@@ -10,6 +10,7 @@ It is generated from these files:
 It has these top-level messages:
 	ExternalUser
 	ExternalAddress
+	ExternalAccount
 */
 package external
 
@@ -19,6 +20,11 @@ import math "math"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 import _ "github.com/infobloxopen/protoc-gen-atlas-validate/options"
 
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
@@ -30,10 +36,54 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Kind int32
+
+const (
+	Kind_KIND_PERSON  Kind = 0
+	Kind_KIND_SERVICE Kind = 1
+)
+
+var Kind_name = map[int32]string{
+	0: "KIND_PERSON",
+	1: "KIND_SERVICE",
+}
+var Kind_value = map[string]int32{
+	"KIND_PERSON":  0,
+	"KIND_SERVICE": 1,
+}
+
+func (x Kind) String() string {
+	return proto.EnumName(Kind_name, int32(x))
+}
+func (Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type ExternalUser_Role int32
+
+const (
+	ExternalUser_ROLE_MEMBER ExternalUser_Role = 0
+	ExternalUser_ROLE_OWNER  ExternalUser_Role = 1
+)
+
+var ExternalUser_Role_name = map[int32]string{
+	0: "ROLE_MEMBER",
+	1: "ROLE_OWNER",
+}
+var ExternalUser_Role_value = map[string]int32{
+	"ROLE_MEMBER": 0,
+	"ROLE_OWNER":  1,
+}
+
+func (x ExternalUser_Role) String() string {
+	return proto.EnumName(ExternalUser_Role_name, int32(x))
+}
+func (ExternalUser_Role) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
 type ExternalUser struct {
-	Id      int32            `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	Name    string           `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	Address *ExternalAddress `protobuf:"bytes,4,opt,name=address" json:"address,omitempty"`
+	Id          int32              `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name        string             `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Address     *ExternalAddress   `protobuf:"bytes,4,opt,name=address" json:"address,omitempty"`
+	Addresses   []*ExternalAddress `protobuf:"bytes,5,rep,name=addresses" json:"addresses,omitempty"`
+	DisplayName string             `protobuf:"bytes,6,opt,name=display_name,json=displayName" json:"display_name,omitempty"`
 }
 
 func (m *ExternalUser) Reset()                    { *m = ExternalUser{} }
@@ -62,6 +112,20 @@ func (m *ExternalUser) GetAddress() *ExternalAddress {
 	return nil
 }
 
+func (m *ExternalUser) GetAddresses() []*ExternalAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *ExternalUser) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
 type ExternalUser_Parent struct {
 	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 }
@@ -118,33 +182,165 @@ func (m *ExternalAddress) GetZip() string {
 	return ""
 }
 
+type ExternalAccount struct {
+	Id          int32              `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Login       string             `protobuf:"bytes,2,opt,name=login" json:"login,omitempty"`
+	Addresses   []*ExternalAddress `protobuf:"bytes,3,rep,name=addresses" json:"addresses,omitempty"`
+	DisplayName string             `protobuf:"bytes,4,opt,name=display_name,json=displayName" json:"display_name,omitempty"`
+}
+
+func (m *ExternalAccount) Reset()                    { *m = ExternalAccount{} }
+func (m *ExternalAccount) String() string            { return proto.CompactTextString(m) }
+func (*ExternalAccount) ProtoMessage()               {}
+func (*ExternalAccount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ExternalAccount) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ExternalAccount) GetLogin() string {
+	if m != nil {
+		return m.Login
+	}
+	return ""
+}
+
+func (m *ExternalAccount) GetAddresses() []*ExternalAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *ExternalAccount) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ExternalUser)(nil), "external.ExternalUser")
 	proto.RegisterType((*ExternalUser_Parent)(nil), "external.ExternalUser.Parent")
 	proto.RegisterType((*ExternalAddress)(nil), "external.ExternalAddress")
+	proto.RegisterType((*ExternalAccount)(nil), "external.ExternalAccount")
+	proto.RegisterEnum("external.Kind", Kind_name, Kind_value)
+	proto.RegisterEnum("external.ExternalUser_Role", ExternalUser_Role_name, ExternalUser_Role_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ExternalAccounts service
+
+type ExternalAccountsClient interface {
+	Create(ctx context.Context, in *ExternalAccount, opts ...grpc.CallOption) (*ExternalAccount, error)
+}
+
+type externalAccountsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewExternalAccountsClient(cc *grpc.ClientConn) ExternalAccountsClient {
+	return &externalAccountsClient{cc}
+}
+
+func (c *externalAccountsClient) Create(ctx context.Context, in *ExternalAccount, opts ...grpc.CallOption) (*ExternalAccount, error) {
+	out := new(ExternalAccount)
+	err := grpc.Invoke(ctx, "/external.ExternalAccounts/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ExternalAccounts service
+
+type ExternalAccountsServer interface {
+	Create(context.Context, *ExternalAccount) (*ExternalAccount, error)
+}
+
+func RegisterExternalAccountsServer(s *grpc.Server, srv ExternalAccountsServer) {
+	s.RegisterService(&_ExternalAccounts_serviceDesc, srv)
+}
+
+func _ExternalAccounts_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalAccountsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/external.ExternalAccounts/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalAccountsServer).Create(ctx, req.(*ExternalAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ExternalAccounts_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "external.ExternalAccounts",
+	HandlerType: (*ExternalAccountsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ExternalAccounts_Create_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "example/external/external.proto",
 }
 
 func init() { proto.RegisterFile("example/external/external.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xcf, 0x4b, 0x3b, 0x31,
-	0x10, 0xc5, 0xd9, 0xfe, 0xfc, 0x36, 0x5f, 0x51, 0x09, 0x1e, 0xd6, 0x52, 0xb0, 0xf4, 0xd4, 0x4b,
-	0x1b, 0xb0, 0x47, 0x4f, 0x0a, 0x9e, 0x44, 0x91, 0x05, 0x2f, 0x5e, 0x64, 0xba, 0x3b, 0xae, 0x81,
-	0x34, 0x13, 0x92, 0xa9, 0xb4, 0xde, 0xfd, 0xbf, 0xa5, 0xd9, 0xa6, 0x42, 0x6f, 0xde, 0xde, 0x9b,
-	0xbc, 0xf9, 0x64, 0x92, 0x11, 0x57, 0xb8, 0x81, 0x95, 0x33, 0xa8, 0x70, 0xc3, 0xe8, 0x2d, 0x98,
-	0x83, 0x98, 0x3b, 0x4f, 0x4c, 0xf2, 0x5f, 0xf2, 0xc3, 0x51, 0x4d, 0x54, 0x1b, 0x54, 0xe0, 0xb4,
-	0x02, 0x6b, 0x89, 0x81, 0x35, 0xd9, 0xd0, 0xe4, 0x86, 0x4f, 0xb5, 0xe6, 0x8f, 0xf5, 0x72, 0x5e,
-	0xd2, 0x4a, 0x69, 0xfb, 0x4e, 0x4b, 0x43, 0x1b, 0x72, 0x68, 0x55, 0x3c, 0x2e, 0x67, 0x35, 0xda,
-	0x19, 0xb0, 0x81, 0x30, 0xfb, 0x04, 0xa3, 0x2b, 0x60, 0x54, 0xe4, 0x22, 0x40, 0xc5, 0xf2, 0x5b,
-	0x2a, 0x37, 0xbc, 0xc9, 0x77, 0x26, 0x4e, 0xee, 0xf7, 0x57, 0xbf, 0x04, 0xf4, 0xf2, 0x54, 0xb4,
-	0x74, 0x95, 0x67, 0xe3, 0x6c, 0xda, 0x2d, 0x5a, 0xba, 0x92, 0x52, 0x74, 0x2c, 0xac, 0x30, 0x6f,
-	0x8d, 0xb3, 0xe9, 0xa0, 0x88, 0x5a, 0x2e, 0x44, 0x1f, 0xaa, 0xca, 0x63, 0x08, 0x79, 0x67, 0x9c,
-	0x4d, 0xff, 0x5f, 0x5f, 0xce, 0x0f, 0xcf, 0x49, 0xb0, 0xdb, 0x26, 0x50, 0xa4, 0xe4, 0x70, 0x24,
-	0x7a, 0xcf, 0xe0, 0xd1, 0xf2, 0x01, 0x99, 0xfd, 0x22, 0x27, 0xb5, 0x38, 0x3b, 0xea, 0x94, 0xb9,
-	0xe8, 0x97, 0xb4, 0xb6, 0xec, 0xb7, 0xfb, 0x64, 0xb2, 0xf2, 0x42, 0x74, 0x03, 0x03, 0xa7, 0xa1,
-	0x1a, 0xb3, 0xc3, 0x96, 0x9a, 0xb7, 0x79, 0xbb, 0xc1, 0xee, 0xb4, 0x3c, 0x17, 0xed, 0x2f, 0xed,
-	0xe2, 0x94, 0x83, 0x62, 0x27, 0xef, 0x1e, 0x5f, 0x1f, 0xfe, 0xfe, 0x85, 0xc7, 0xdb, 0xbb, 0x49,
-	0x62, 0xd9, 0x8b, 0x4d, 0x8b, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0xb1, 0x6e, 0xa3, 0xe1,
-	0x01, 0x00, 0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6a, 0xdb, 0x4c,
+	0x14, 0xb5, 0xe4, 0x9f, 0x24, 0x37, 0xf9, 0x12, 0x31, 0x5f, 0x69, 0x64, 0x93, 0x50, 0x45, 0x9b,
+	0x38, 0x81, 0x58, 0x90, 0x40, 0x0b, 0x29, 0x5d, 0xd4, 0x89, 0xa0, 0xc1, 0x8d, 0x1d, 0x14, 0xd2,
+	0x42, 0xa1, 0x38, 0x63, 0x6b, 0x2a, 0x0f, 0x48, 0x33, 0xaa, 0x66, 0x5c, 0xec, 0x92, 0x55, 0xe9,
+	0xaa, 0xdb, 0x3e, 0x90, 0x1f, 0xa2, 0x3b, 0xaf, 0xfb, 0x20, 0x45, 0x23, 0xcb, 0x6e, 0x4c, 0x5b,
+	0xc8, 0xee, 0xdc, 0x33, 0x77, 0xce, 0x3d, 0x73, 0x86, 0x0b, 0x4f, 0xc8, 0x08, 0x47, 0x71, 0x48,
+	0x1c, 0x32, 0x92, 0x24, 0x61, 0x38, 0x9c, 0x83, 0x46, 0x9c, 0x70, 0xc9, 0xd1, 0x6a, 0x5e, 0xd7,
+	0x76, 0x02, 0xce, 0x83, 0x90, 0x38, 0x38, 0xa6, 0x0e, 0x66, 0x8c, 0x4b, 0x2c, 0x29, 0x67, 0x22,
+	0xeb, 0xab, 0xb5, 0x03, 0x2a, 0x07, 0xc3, 0x5e, 0xa3, 0xcf, 0x23, 0x87, 0xb2, 0x0f, 0xbc, 0x17,
+	0xf2, 0x11, 0x8f, 0x09, 0x73, 0xd4, 0x71, 0xff, 0x28, 0x20, 0xec, 0x08, 0xcb, 0x10, 0x8b, 0xa3,
+	0x4f, 0x38, 0xa4, 0x3e, 0x96, 0xc4, 0xe1, 0xb1, 0x12, 0x70, 0x14, 0xdd, 0xcd, 0xe9, 0x4c, 0xcf,
+	0xfe, 0xa6, 0xc3, 0x86, 0x3b, 0x1b, 0x7d, 0x23, 0x48, 0x82, 0x36, 0x41, 0xa7, 0xbe, 0xa9, 0x59,
+	0x5a, 0xbd, 0xec, 0xe9, 0xd4, 0x47, 0x08, 0x4a, 0x0c, 0x47, 0xc4, 0xd4, 0x2d, 0xad, 0xbe, 0xe6,
+	0x29, 0x8c, 0x4e, 0x60, 0x05, 0xfb, 0x7e, 0x42, 0x84, 0x30, 0x4b, 0x96, 0x56, 0x5f, 0x3f, 0xae,
+	0x36, 0xe6, 0xcf, 0xc9, 0xc5, 0x5e, 0x66, 0x0d, 0x5e, 0xde, 0x89, 0x9e, 0xc1, 0xda, 0x0c, 0x12,
+	0x61, 0x96, 0xad, 0xe2, 0xbf, 0xaf, 0x2d, 0x7a, 0xd1, 0x1e, 0x6c, 0xf8, 0x54, 0xc4, 0x21, 0x1e,
+	0x77, 0x95, 0x93, 0x8a, 0x72, 0xb2, 0x3e, 0xe3, 0xda, 0x38, 0x22, 0xb5, 0x1d, 0xa8, 0x5c, 0xe1,
+	0x84, 0x30, 0x39, 0xb7, 0xab, 0x2d, 0xec, 0xda, 0xfb, 0x50, 0xf2, 0x78, 0x48, 0xd0, 0x16, 0xac,
+	0x7b, 0x9d, 0xd7, 0x6e, 0xf7, 0xd2, 0xbd, 0x6c, 0xba, 0x9e, 0x51, 0x40, 0x9b, 0x00, 0x8a, 0xe8,
+	0xbc, 0x6d, 0xbb, 0x9e, 0xa1, 0xd9, 0x01, 0x6c, 0x2d, 0xf9, 0x40, 0x26, 0xac, 0xf4, 0xf9, 0x90,
+	0xc9, 0x64, 0x3c, 0x93, 0xcc, 0x4b, 0xf4, 0x08, 0xca, 0x42, 0x62, 0x99, 0x27, 0x93, 0x15, 0xe9,
+	0xfc, 0x3e, 0x95, 0x63, 0xb3, 0x98, 0xcd, 0x4f, 0x31, 0x32, 0xa0, 0xf8, 0x99, 0xc6, 0x2a, 0xaa,
+	0x35, 0x2f, 0x85, 0xf6, 0x57, 0xfd, 0xb7, 0x49, 0x7d, 0xa5, 0x88, 0xb6, 0x17, 0xc1, 0x37, 0x57,
+	0xa6, 0x93, 0x6a, 0x11, 0xb4, 0x82, 0xfa, 0x81, 0x5d, 0x28, 0x87, 0x3c, 0xa0, 0x2c, 0x1b, 0x94,
+	0x9d, 0x21, 0xad, 0xe0, 0x65, 0xec, 0xfd, 0x5c, 0x8b, 0x0f, 0xc8, 0xf5, 0xe9, 0x52, 0xae, 0xca,
+	0x5f, 0xf3, 0xff, 0xe9, 0xa4, 0xba, 0x55, 0xfb, 0xcf, 0xbe, 0xc5, 0x8c, 0xb3, 0x71, 0xc4, 0x87,
+	0xe2, 0xd6, 0xbe, 0x17, 0xf6, 0xe9, 0xab, 0xe9, 0xa4, 0x7a, 0x0e, 0x4d, 0xa8, 0xef, 0x0d, 0xb0,
+	0xa8, 0xcb, 0x01, 0x15, 0x0d, 0xe5, 0xe4, 0xc0, 0xba, 0xbb, 0xb3, 0x16, 0xa5, 0xb5, 0xf7, 0xc2,
+	0xda, 0x4f, 0x38, 0x97, 0xfb, 0xe8, 0x71, 0x46, 0x44, 0x78, 0x6c, 0x31, 0x2e, 0xad, 0x1e, 0xb1,
+	0x52, 0xbe, 0x71, 0x78, 0x00, 0xa5, 0x16, 0x65, 0x7e, 0xfa, 0x31, 0xad, 0x8b, 0xf6, 0x79, 0xf7,
+	0xca, 0xf5, 0xae, 0x3b, 0x6d, 0xa3, 0x80, 0x0c, 0xd8, 0x50, 0xc4, 0xb5, 0xeb, 0xbd, 0xb9, 0x38,
+	0x73, 0x0d, 0xed, 0xf8, 0x23, 0x18, 0x4b, 0x81, 0x09, 0xf4, 0x1e, 0x2a, 0x67, 0x09, 0x49, 0x53,
+	0xff, 0xd3, 0x83, 0xb3, 0xae, 0xda, 0xdf, 0x8f, 0xec, 0xdd, 0x2f, 0x3f, 0x7e, 0x7e, 0xd7, 0xb7,
+	0x6d, 0xb4, 0xd8, 0x4a, 0x3c, 0xd3, 0x3e, 0xd5, 0x0e, 0x9b, 0x37, 0xd3, 0x49, 0xb5, 0x64, 0x68,
+	0xe6, 0xea, 0xbb, 0xd6, 0xc3, 0x97, 0x6e, 0x79, 0xdf, 0x9f, 0xe7, 0xa0, 0x57, 0x51, 0x97, 0x4e,
+	0x7e, 0x0d, 0x00, 0x09, 0x6a, 0x3e, 0x33, 0x13, 0x04, 0x00, 0x00,
 }
@@ -0,0 +1,93 @@
+package protobufgopb
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/infobloxopen/protoc-gen-atlas-validate/runtime"
+	"github.com/infobloxopen/protoc-gen-atlas-validate/runtime/protobufgo"
+	"google.golang.org/protobuf/types/known/typepb"
+)
+
+func TestProtobufGoOptions(t *testing.T) {
+	tests := []struct {
+		method   string
+		input    string
+		expected string
+	}{
+		{method: "POST", input: `{"name": "first", "color": "COLOR_RED"}`},
+		{method: "POST", input: `{"id": 1, "name": "first"}`, expected: `field "id" is unsupported for "POST" operation.`},
+		{method: "POST", input: `{"color": 1}`, expected: `field "name" is required for "POST" operation.`},
+		{method: "PUT", input: `{"name": "more than sixteen characters"}`, expected: `field "name" exceeds max length 16`},
+		{method: "PUT", input: `{"color": "COLOR_BLUE"}`, expected: `invalid value for "color": "COLOR_BLUE" is not a valid Color`},
+	}
+
+	for n, test := range tests {
+		ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, test.method)
+		err := (&Item{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+}
+
+func TestProtobufGoRegisteredEnums(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "PUT")
+
+	// google.protobuf.Field.Kind is generated on google.golang.org/protobuf and is
+	// resolved with protoregistry by its full name
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"kind": "TYPE_STRING", "kinds": ["TYPE_INT32", 9]}`},
+		{input: `{"kind": null, "kinds": null}`},
+		{input: `{"kind": "TYPE_TEXT"}`, expected: `invalid value for "kind": "TYPE_TEXT" is not a valid Kind`},
+		{input: `{"kinds": ["TYPE_BOOL", 42]}`, expected: `invalid value for "kinds.[1]": 42 is not a valid Kind`},
+	}
+
+	for n, test := range tests {
+		err := (&Item{}).AtlasValidateJSON(ctx, json.RawMessage(test.input), "")
+		if test.expected == "" && err != nil {
+			t.Errorf(" %d test failed, error %s \n", n+1, err.Error())
+		}
+		if test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf(" %d test failed, expected %s, got %v \n", n+1, test.expected, err)
+		}
+	}
+
+	// an enum that is not registered is not checked
+	if err := protobufgo.ValidateRegisteredEnum(json.RawMessage(`"TYPE_TEXT"`), "kind", "protobufgopb.Unregistered"); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+}
+
+func TestProtobufGoMarshalRequestBody(t *testing.T) {
+	ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, "PUT")
+	req := &Item{Name: "first", Color: Item_COLOR_RED, Kinds: []typepb.Field_Kind{typepb.Field_TYPE_STRING}}
+
+	b, err := protobufgo.MarshalRequestBody(req, "*")
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	var v map[string]interface{}
+	if err = json.Unmarshal(b, &v); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if v["name"] != "first" || v["color"] != "COLOR_RED" || len(v) != 3 {
+		t.Errorf("unexpected body %s", b)
+	}
+
+	if err = (&Item{}).AtlasValidateJSON(ctx, b, ""); err != nil {
+		t.Errorf("unexpected error %s for body %s", err, b)
+	}
+
+	if b, err = protobufgo.MarshalRequestBody(req, "name"); err != nil || string(b) != `"first"` {
+		t.Errorf("expected %s, got %s (%v)", `"first"`, b, err)
+	}
+}
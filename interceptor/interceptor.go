@@ -11,17 +11,26 @@ import (
 
 const (
 	ValidationErrorMetaKey = "Atlas-Validation-Error"
+	NormalizedMetaKey      = "Atlas-Validation-Normalized"
+	MethodMetaKey          = "Atlas-Validation-Method"
+	AllowUnknownMetaKey    = "Atlas-Validation-Allow-Unknown"
 )
 
 // ValidationClientInterceptor extracts validation error from metadata
 // and throws InvalidArgumentError if error is not empty.
 func ValidationClientInterceptor() grpc.UnaryClientInterceptor {
+	return ValidationClientInterceptorWithKey(ValidationErrorMetaKey)
+}
+
+// ValidationClientInterceptorWithKey is ValidationClientInterceptor for validators
+// generated with error_header parameter, key is the value of the parameter.
+func ValidationClientInterceptorWithKey(key string) grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) (err error) {
 		if req == nil {
 			return
 		}
 
-		if err := GetAtlasValidationError(ctx); err != nil {
+		if err := GetAtlasValidationErrorWithKey(ctx, key); err != nil {
 			return status.Error(codes.InvalidArgument, err.Error())
 		}
 
@@ -30,12 +39,17 @@ func ValidationClientInterceptor() grpc.UnaryClientInterceptor {
 }
 
 func GetAtlasValidationError(ctx context.Context) error {
+	return GetAtlasValidationErrorWithKey(ctx, ValidationErrorMetaKey)
+}
+
+// GetAtlasValidationErrorWithKey returns validation error reported with metadata key.
+func GetAtlasValidationErrorWithKey(ctx context.Context, key string) error {
 	imd, _ := metadata.FromIncomingContext(ctx)
 	omd, _ := metadata.FromOutgoingContext(ctx)
 
 	md := metadata.Join(imd, omd)
 
-	errors := md.Get(ValidationErrorMetaKey)
+	errors := md.Get(key)
 
 	if len(errors) > 0 && errors[0] != "" {
 		return fmt.Errorf(errors[0])
@@ -43,3 +57,31 @@ func GetAtlasValidationError(ctx context.Context) error {
 
 	return nil
 }
+
+// GetAtlasValidationNormalized returns names of normalization transforms that
+// changed a request body, in the order they ran.
+func GetAtlasValidationNormalized(ctx context.Context) []string {
+	imd, _ := metadata.FromIncomingContext(ctx)
+	omd, _ := metadata.FromOutgoingContext(ctx)
+
+	return metadata.Join(imd, omd).Get(NormalizedMetaKey)
+}
+
+// GetAtlasValidationMethod returns a gRPC name of the method (e.g.
+// "/examplepb.Users/Update") whose pattern the annotator matched a request to, and
+// the effective allow_unknown_fields option of the method. The name is empty if
+// no pattern matched.
+func GetAtlasValidationMethod(ctx context.Context) (method string, allowUnknown bool) {
+	imd, _ := metadata.FromIncomingContext(ctx)
+	omd, _ := metadata.FromOutgoingContext(ctx)
+
+	md := metadata.Join(imd, omd)
+	if v := md.Get(MethodMetaKey); len(v) != 0 {
+		method = v[0]
+	}
+	if v := md.Get(AllowUnknownMetaKey); len(v) != 0 {
+		allowUnknown = v[0] == "true"
+	}
+
+	return method, allowUnknown
+}
@@ -8,5 +8,10 @@ import (
 func main() {
 	plugin := &plugin.Plugin{}
 	response := command.GeneratePlugin(command.Read(), plugin, ".pb.atlas.validate.go")
+	tests := plugin.TestFiles(response.File)
+	response.File = append(plugin.SingleFile(response.File), tests...)
+	// supported_features = FEATURE_PROTO3_OPTIONAL, plugin.CodeGeneratorResponse
+	// predates the field
+	response.XXX_unrecognized = append(response.XXX_unrecognized, 2<<3, 1)
 	command.Write(response)
 }
@@ -11,6 +11,12 @@ It has these top-level messages:
 	AtlasValidateFileOption
 	AtlasValidateMethodOption
 	AtlasValidateServiceOption
+	AtlasValidateMessageOption
+	AtlasValidateAtLeastOneOf
+	AtlasValidateMutuallyExclusive
+	AtlasValidateRequiredForType
+	AtlasValidateExpression
+	AtlasValidateEnumOption
 	AtlasValidateFieldOption
 */
 package options
@@ -40,28 +46,53 @@ const (
 	AtlasValidateFieldOption_update AtlasValidateFieldOption_Operation = 1
 	// Field allow only on replace operation
 	AtlasValidateFieldOption_replace AtlasValidateFieldOption_Operation = 2
+	// Field allow only on operations bound with custom HTTP verbs of google.api.http
+	// rules (e.g. HEAD, OPTIONS or SEARCH), verbs of all rules of a request are used
+	AtlasValidateFieldOption_custom AtlasValidateFieldOption_Operation = 3
 )
 
 var AtlasValidateFieldOption_Operation_name = map[int32]string{
 	0: "create",
 	1: "update",
 	2: "replace",
+	3: "custom",
 }
 var AtlasValidateFieldOption_Operation_value = map[string]int32{
 	"create":  0,
 	"update":  1,
 	"replace": 2,
+	"custom":  3,
 }
 
 func (x AtlasValidateFieldOption_Operation) String() string {
 	return proto.EnumName(AtlasValidateFieldOption_Operation_name, int32(x))
 }
 func (AtlasValidateFieldOption_Operation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorAtlasValidate, []int{3, 0}
+	return fileDescriptorAtlasValidate, []int{9, 0}
 }
 
 type AtlasValidateFileOption struct {
 	AllowUnknownFields bool `protobuf:"varint,1,opt,name=allow_unknown_fields,json=allowUnknownFields,proto3" json:"allow_unknown_fields,omitempty"`
+	// Render error paths as RFC 6901 JSON Pointers (e.g. "/address/city", "/groups/0")
+	// instead of the dotted form (e.g. "address.city", "groups.[0]").
+	JsonPointerPaths bool `protobuf:"varint,2,opt,name=json_pointer_paths,json=jsonPointerPaths,proto3" json:"json_pointer_paths,omitempty"`
+	// Maximum number of fields of an object of any message in the file, messages can
+	// override it with atlas_validate.message option. Zero means no limit.
+	MaxFields uint32 `protobuf:"varint,3,opt,name=max_fields,json=maxFields,proto3" json:"max_fields,omitempty"`
+	// Do not count a present empty string ("") as satisfying required option of a
+	// string field, by default presence of the field is enough.
+	RequiredRejectsEmptyString bool `protobuf:"varint,4,opt,name=required_rejects_empty_string,json=requiredRejectsEmptyString,proto3" json:"required_rejects_empty_string,omitempty"`
+	// Accept fields under their json_name (e.g. "displayName" for display_name) in
+	// addition to proto names, errors refer to fields by proto names in both cases.
+	MatchJsonNames bool `protobuf:"varint,5,opt,name=match_json_names,json=matchJsonNames,proto3" json:"match_json_names,omitempty"`
+	// Maximum number of array elements decoded across a whole request body of any
+	// method in the file, elements of nested arrays are counted as well. Methods can
+	// override it with atlas_validate.method option. Zero means no limit.
+	MaxTotalElements uint32 `protobuf:"varint,6,opt,name=max_total_elements,json=maxTotalElements,proto3" json:"max_total_elements,omitempty"`
+	// Maximum aggregate size in bytes of raw JSON values of string fields (including
+	// repeated ones and maps with string values) across a whole request body of any
+	// method in the file. Zero means no limit.
+	MaxTotalTextBytes uint32 `protobuf:"varint,7,opt,name=max_total_text_bytes,json=maxTotalTextBytes,proto3" json:"max_total_text_bytes,omitempty"`
 }
 
 func (m *AtlasValidateFileOption) Reset()         { *m = AtlasValidateFileOption{} }
@@ -78,8 +109,58 @@ func (m *AtlasValidateFileOption) GetAllowUnknownFields() bool {
 	return false
 }
 
+func (m *AtlasValidateFileOption) GetJsonPointerPaths() bool {
+	if m != nil {
+		return m.JsonPointerPaths
+	}
+	return false
+}
+
+func (m *AtlasValidateFileOption) GetMaxFields() uint32 {
+	if m != nil {
+		return m.MaxFields
+	}
+	return 0
+}
+
+func (m *AtlasValidateFileOption) GetRequiredRejectsEmptyString() bool {
+	if m != nil {
+		return m.RequiredRejectsEmptyString
+	}
+	return false
+}
+
+func (m *AtlasValidateFileOption) GetMatchJsonNames() bool {
+	if m != nil {
+		return m.MatchJsonNames
+	}
+	return false
+}
+
+func (m *AtlasValidateFileOption) GetMaxTotalElements() uint32 {
+	if m != nil {
+		return m.MaxTotalElements
+	}
+	return 0
+}
+
+func (m *AtlasValidateFileOption) GetMaxTotalTextBytes() uint32 {
+	if m != nil {
+		return m.MaxTotalTextBytes
+	}
+	return 0
+}
+
 type AtlasValidateMethodOption struct {
 	AllowUnknownFields bool `protobuf:"varint,1,opt,name=allow_unknown_fields,json=allowUnknownFields,proto3" json:"allow_unknown_fields,omitempty"`
+	// Fully-qualified message types (e.g. "examplepb.User") that are tried instead
+	// of the input type. The body is valid when it validates against exactly one of
+	// the one_of types or against at least one of the any_of types.
+	OneOf []string `protobuf:"bytes,2,rep,name=one_of,json=oneOf" json:"one_of,omitempty"`
+	AnyOf []string `protobuf:"bytes,3,rep,name=any_of,json=anyOf" json:"any_of,omitempty"`
+	// Maximum number of array elements decoded across a request body of the method,
+	// if set it overrides max_total_elements of atlas_validate.file option.
+	MaxTotalElements uint32 `protobuf:"varint,4,opt,name=max_total_elements,json=maxTotalElements,proto3" json:"max_total_elements,omitempty"`
 }
 
 func (m *AtlasValidateMethodOption) Reset()         { *m = AtlasValidateMethodOption{} }
@@ -96,6 +177,27 @@ func (m *AtlasValidateMethodOption) GetAllowUnknownFields() bool {
 	return false
 }
 
+func (m *AtlasValidateMethodOption) GetOneOf() []string {
+	if m != nil {
+		return m.OneOf
+	}
+	return nil
+}
+
+func (m *AtlasValidateMethodOption) GetAnyOf() []string {
+	if m != nil {
+		return m.AnyOf
+	}
+	return nil
+}
+
+func (m *AtlasValidateMethodOption) GetMaxTotalElements() uint32 {
+	if m != nil {
+		return m.MaxTotalElements
+	}
+	return 0
+}
+
 type AtlasValidateServiceOption struct {
 	AllowUnknownFields bool `protobuf:"varint,1,opt,name=allow_unknown_fields,json=allowUnknownFields,proto3" json:"allow_unknown_fields,omitempty"`
 }
@@ -114,16 +216,321 @@ func (m *AtlasValidateServiceOption) GetAllowUnknownFields() bool {
 	return false
 }
 
+type AtlasValidateMessageOption struct {
+	// CEL expressions evaluated against the decoded JSON object bound to "this",
+	// they are generated only if the plugin is run with cel=true parameter.
+	Cel []*AtlasValidateExpression `protobuf:"bytes,1,rep,name=cel" json:"cel,omitempty"`
+	// Maximum number of fields (including unknown ones) of an object of the message,
+	// if set it overrides max_fields of atlas_validate.file option.
+	MaxFields uint32 `protobuf:"varint,2,opt,name=max_fields,json=maxFields,proto3" json:"max_fields,omitempty"`
+	// Names of fields that are not declared in the message but are accepted and not
+	// validated, e.g. metadata added by clients such as "_links" or "_etag".
+	IgnoreExtraFields []string `protobuf:"bytes,3,rep,name=ignore_extra_fields,json=ignoreExtraFields" json:"ignore_extra_fields,omitempty"`
+	// Name of a string or enum field of the message whose value (e.g. "webhook") selects
+	// a set of required_for_type fields that must be present in an object.
+	Discriminator   string                          `protobuf:"bytes,4,opt,name=discriminator,proto3" json:"discriminator,omitempty"`
+	RequiredForType []*AtlasValidateRequiredForType `protobuf:"bytes,5,rep,name=required_for_type,json=requiredForType" json:"required_for_type,omitempty"`
+	// Groups of fields at least one of which must be present in an object, e.g.
+	// {fields: ["phone", "email"], operations: [create]}.
+	AtLeastOneOf []*AtlasValidateAtLeastOneOf `protobuf:"bytes,6,rep,name=at_least_one_of,json=atLeastOneOf" json:"at_least_one_of,omitempty"`
+	// Groups of fields at most one of which may be set (not null) in an object, e.g.
+	// {fields: ["phone", "email"]}.
+	MutuallyExclusive []*AtlasValidateMutuallyExclusive `protobuf:"bytes,7,rep,name=mutually_exclusive,json=mutuallyExclusive" json:"mutually_exclusive,omitempty"`
+	// Name of a google.protobuf.FieldMask field of the message, fields that are not in
+	// its paths are not required for update operation if it is set in an object.
+	UpdateMaskField string `protobuf:"bytes,8,opt,name=update_mask_field,json=updateMaskField,proto3" json:"update_mask_field,omitempty"`
+}
+
+func (m *AtlasValidateMessageOption) Reset()         { *m = AtlasValidateMessageOption{} }
+func (m *AtlasValidateMessageOption) String() string { return proto.CompactTextString(m) }
+func (*AtlasValidateMessageOption) ProtoMessage()    {}
+func (*AtlasValidateMessageOption) Descriptor() ([]byte, []int) {
+	return fileDescriptorAtlasValidate, []int{3}
+}
+
+func (m *AtlasValidateMessageOption) GetCel() []*AtlasValidateExpression {
+	if m != nil {
+		return m.Cel
+	}
+	return nil
+}
+
+func (m *AtlasValidateMessageOption) GetMaxFields() uint32 {
+	if m != nil {
+		return m.MaxFields
+	}
+	return 0
+}
+
+func (m *AtlasValidateMessageOption) GetIgnoreExtraFields() []string {
+	if m != nil {
+		return m.IgnoreExtraFields
+	}
+	return nil
+}
+
+func (m *AtlasValidateMessageOption) GetDiscriminator() string {
+	if m != nil {
+		return m.Discriminator
+	}
+	return ""
+}
+
+func (m *AtlasValidateMessageOption) GetRequiredForType() []*AtlasValidateRequiredForType {
+	if m != nil {
+		return m.RequiredForType
+	}
+	return nil
+}
+
+func (m *AtlasValidateMessageOption) GetAtLeastOneOf() []*AtlasValidateAtLeastOneOf {
+	if m != nil {
+		return m.AtLeastOneOf
+	}
+	return nil
+}
+
+func (m *AtlasValidateMessageOption) GetMutuallyExclusive() []*AtlasValidateMutuallyExclusive {
+	if m != nil {
+		return m.MutuallyExclusive
+	}
+	return nil
+}
+
+func (m *AtlasValidateMessageOption) GetUpdateMaskField() string {
+	if m != nil {
+		return m.UpdateMaskField
+	}
+	return ""
+}
+
+type AtlasValidateAtLeastOneOf struct {
+	// Names of fields of the message.
+	Fields []string `protobuf:"bytes,1,rep,name=fields" json:"fields,omitempty"`
+	// Operations the group is required for, all of them if empty.
+	Operations []AtlasValidateFieldOption_Operation `protobuf:"varint,2,rep,packed,name=operations,enum=atlas_validate.AtlasValidateFieldOption_Operation" json:"operations,omitempty"`
+}
+
+func (m *AtlasValidateAtLeastOneOf) Reset()         { *m = AtlasValidateAtLeastOneOf{} }
+func (m *AtlasValidateAtLeastOneOf) String() string { return proto.CompactTextString(m) }
+func (*AtlasValidateAtLeastOneOf) ProtoMessage()    {}
+func (*AtlasValidateAtLeastOneOf) Descriptor() ([]byte, []int) {
+	return fileDescriptorAtlasValidate, []int{4}
+}
+
+func (m *AtlasValidateAtLeastOneOf) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *AtlasValidateAtLeastOneOf) GetOperations() []AtlasValidateFieldOption_Operation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+type AtlasValidateMutuallyExclusive struct {
+	// Names of fields of the message.
+	Fields []string `protobuf:"bytes,1,rep,name=fields" json:"fields,omitempty"`
+}
+
+func (m *AtlasValidateMutuallyExclusive) Reset()         { *m = AtlasValidateMutuallyExclusive{} }
+func (m *AtlasValidateMutuallyExclusive) String() string { return proto.CompactTextString(m) }
+func (*AtlasValidateMutuallyExclusive) ProtoMessage()    {}
+func (*AtlasValidateMutuallyExclusive) Descriptor() ([]byte, []int) {
+	return fileDescriptorAtlasValidate, []int{5}
+}
+
+func (m *AtlasValidateMutuallyExclusive) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type AtlasValidateRequiredForType struct {
+	// Value of the discriminator field as it appears in JSON, enums are matched by name.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Names of fields that are required if the discriminator field has the value.
+	Fields []string `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+}
+
+func (m *AtlasValidateRequiredForType) Reset()         { *m = AtlasValidateRequiredForType{} }
+func (m *AtlasValidateRequiredForType) String() string { return proto.CompactTextString(m) }
+func (*AtlasValidateRequiredForType) ProtoMessage()    {}
+func (*AtlasValidateRequiredForType) Descriptor() ([]byte, []int) {
+	return fileDescriptorAtlasValidate, []int{6}
+}
+
+func (m *AtlasValidateRequiredForType) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AtlasValidateRequiredForType) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type AtlasValidateExpression struct {
+	// CEL expression that must result in true, e.g. "!has(this.end) || this.end > this.start".
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Error message that is returned if the expression is not satisfied.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *AtlasValidateExpression) Reset()         { *m = AtlasValidateExpression{} }
+func (m *AtlasValidateExpression) String() string { return proto.CompactTextString(m) }
+func (*AtlasValidateExpression) ProtoMessage()    {}
+func (*AtlasValidateExpression) Descriptor() ([]byte, []int) {
+	return fileDescriptorAtlasValidate, []int{7}
+}
+
+func (m *AtlasValidateExpression) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *AtlasValidateExpression) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type AtlasValidateEnumOption struct {
+	// Accept names that differ from declared ones in case or in the common prefix of
+	// the enum values (e.g. "active" or "ACTIVE" for STATUS_ACTIVE), such names are
+	// replaced with declared ones in the request body.
+	AllowPrefixVariants bool `protobuf:"varint,1,opt,name=allow_prefix_variants,json=allowPrefixVariants,proto3" json:"allow_prefix_variants,omitempty"`
+}
+
+func (m *AtlasValidateEnumOption) Reset()         { *m = AtlasValidateEnumOption{} }
+func (m *AtlasValidateEnumOption) String() string { return proto.CompactTextString(m) }
+func (*AtlasValidateEnumOption) ProtoMessage()    {}
+func (*AtlasValidateEnumOption) Descriptor() ([]byte, []int) {
+	return fileDescriptorAtlasValidate, []int{8}
+}
+
+func (m *AtlasValidateEnumOption) GetAllowPrefixVariants() bool {
+	if m != nil {
+		return m.AllowPrefixVariants
+	}
+	return false
+}
+
 type AtlasValidateFieldOption struct {
 	Deny     []AtlasValidateFieldOption_Operation `protobuf:"varint,1,rep,packed,name=deny,enum=atlas_validate.AtlasValidateFieldOption_Operation" json:"deny,omitempty"`
 	Required []AtlasValidateFieldOption_Operation `protobuf:"varint,2,rep,packed,name=required,enum=atlas_validate.AtlasValidateFieldOption_Operation" json:"required,omitempty"`
+	// JSON literal that is injected into the request body when the field is absent,
+	// e.g. default: "\"guest\"" or default: "10". The field is not injected for
+	// denied operations, and a required field must always be supplied by the client.
+	// A literal that does not pass checks of the field's options fails generation.
+	Default string `protobuf:"bytes,3,opt,name=default,proto3" json:"default,omitempty"`
+	// Maximum duration (e.g. "5m") a google.protobuf.Timestamp field may be ahead of
+	// runtime.Now().
+	MaxFutureSkew string `protobuf:"bytes,4,opt,name=max_future_skew,json=maxFutureSkew,proto3" json:"max_future_skew,omitempty"`
+	// Name of a string format the value must conform to: "cron" (minute, hour, day of
+	// month, month, day of week), "cron_seconds" (seconds followed by the "cron" fields),
+	// "mac" (EUI-48 or EUI-64 address) or a format registered with runtime.RegisterFormat.
+	Format string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
+	// Maximum size in bytes of the raw JSON value of the field (e.g. a quoted string
+	// with escapes or a whole array), zero means no limit.
+	MaxFieldBytes uint32 `protobuf:"varint,6,opt,name=max_field_bytes,json=maxFieldBytes,proto3" json:"max_field_bytes,omitempty"`
+	// Name of a set of allowed values registered with runtime.RegisterValueSet, the
+	// set is looked up at validation time and can be changed without regeneration.
+	InSet string `protobuf:"bytes,7,opt,name=in_set,json=inSet,proto3" json:"in_set,omitempty"`
+	// Name of a variable of the google.api.http path template (e.g. "payload.id" for
+	// "/users/{payload.id}") the field value must equal to if the field is present and
+	// the matched path has the variable.
+	PathVariable string `protobuf:"bytes,8,opt,name=path_variable,json=pathVariable,proto3" json:"path_variable,omitempty"`
+	// Maximum length of a string field in characters or of a bytes field in decoded
+	// bytes, zero means no limit.
+	MaxLength uint32 `protobuf:"varint,9,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	// Inclusive lower bound of a value of a numeric field, e.g. {min: 0, max: 150}.
+	//
+	// Types that are valid to be assigned to MinBound:
+	//	*AtlasValidateFieldOption_Min
+	MinBound isAtlasValidateFieldOption_MinBound `protobuf_oneof:"min_bound"`
+	// Inclusive upper bound of a value of a numeric field.
+	//
+	// Types that are valid to be assigned to MaxBound:
+	//	*AtlasValidateFieldOption_Max
+	MaxBound isAtlasValidateFieldOption_MaxBound `protobuf_oneof:"max_bound"`
+	// Allow unknown fields in objects nested in a message or map field regardless
+	// of allow_unknown_fields option of a method.
+	AllowUnknownFields bool `protobuf:"varint,12,opt,name=allow_unknown_fields,json=allowUnknownFields,proto3" json:"allow_unknown_fields,omitempty"`
+	// Name of a field of the same message that makes the field required when it is
+	// present, e.g. {required_if: "start"} for an "end" field. If required operations
+	// are set as well, the field is required only for them when the other one is set.
+	RequiredIf string `protobuf:"bytes,13,opt,name=required_if,json=requiredIf,proto3" json:"required_if,omitempty"`
+	// Minimum and maximum number of elements of a repeated field, zero means no
+	// limit. A null array is not checked, use required option to demand the field.
+	MinItems uint32 `protobuf:"varint,14,opt,name=min_items,json=minItems,proto3" json:"min_items,omitempty"`
+	MaxItems uint32 `protobuf:"varint,15,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	// Field may appear in responses but never in bodies of create, replace, update
+	// and custom operations, it implies deny of all of them.
+	ReadOnly bool `protobuf:"varint,16,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// Regular expression (RE2 syntax) a value of a string field must match, e.g.
+	// {pattern: "^[^@]+@[^@]+$"}. An invalid expression fails generation.
+	Pattern string `protobuf:"bytes,17,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Message field may not be JSON null, by default null is the same as an absent
+	// field and is not validated.
+	NonNullable bool `protobuf:"varint,18,opt,name=non_nullable,json=nonNullable,proto3" json:"non_nullable,omitempty"`
+	// Reject unknown fields in objects nested in a message or map field regardless
+	// of allow_unknown_fields option of a method, allow_unknown_fields option of a
+	// field nested in it still applies.
+	Strict bool `protobuf:"varint,19,opt,name=strict,proto3" json:"strict,omitempty"`
+	// Regular expression (RE2 syntax) each key of a string-keyed map field must
+	// match, e.g. {key_pattern: "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"}. An invalid
+	// expression fails generation.
+	KeyPattern string `protobuf:"bytes,20,opt,name=key_pattern,json=keyPattern,proto3" json:"key_pattern,omitempty"`
 }
 
 func (m *AtlasValidateFieldOption) Reset()         { *m = AtlasValidateFieldOption{} }
 func (m *AtlasValidateFieldOption) String() string { return proto.CompactTextString(m) }
 func (*AtlasValidateFieldOption) ProtoMessage()    {}
 func (*AtlasValidateFieldOption) Descriptor() ([]byte, []int) {
-	return fileDescriptorAtlasValidate, []int{3}
+	return fileDescriptorAtlasValidate, []int{9}
+}
+
+type isAtlasValidateFieldOption_MinBound interface {
+	isAtlasValidateFieldOption_MinBound()
+}
+type isAtlasValidateFieldOption_MaxBound interface {
+	isAtlasValidateFieldOption_MaxBound()
+}
+
+type AtlasValidateFieldOption_Min struct {
+	Min float64 `protobuf:"fixed64,10,opt,name=min,proto3,oneof"`
+}
+type AtlasValidateFieldOption_Max struct {
+	Max float64 `protobuf:"fixed64,11,opt,name=max,proto3,oneof"`
+}
+
+func (*AtlasValidateFieldOption_Min) isAtlasValidateFieldOption_MinBound() {}
+func (*AtlasValidateFieldOption_Max) isAtlasValidateFieldOption_MaxBound() {}
+
+func (m *AtlasValidateFieldOption) GetMinBound() isAtlasValidateFieldOption_MinBound {
+	if m != nil {
+		return m.MinBound
+	}
+	return nil
+}
+func (m *AtlasValidateFieldOption) GetMaxBound() isAtlasValidateFieldOption_MaxBound {
+	if m != nil {
+		return m.MaxBound
+	}
+	return nil
 }
 
 func (m *AtlasValidateFieldOption) GetDeny() []AtlasValidateFieldOption_Operation {
@@ -140,6 +547,208 @@ func (m *AtlasValidateFieldOption) GetRequired() []AtlasValidateFieldOption_Oper
 	return nil
 }
 
+func (m *AtlasValidateFieldOption) GetDefault() string {
+	if m != nil {
+		return m.Default
+	}
+	return ""
+}
+
+func (m *AtlasValidateFieldOption) GetMaxFutureSkew() string {
+	if m != nil {
+		return m.MaxFutureSkew
+	}
+	return ""
+}
+
+func (m *AtlasValidateFieldOption) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *AtlasValidateFieldOption) GetMaxFieldBytes() uint32 {
+	if m != nil {
+		return m.MaxFieldBytes
+	}
+	return 0
+}
+
+func (m *AtlasValidateFieldOption) GetInSet() string {
+	if m != nil {
+		return m.InSet
+	}
+	return ""
+}
+
+func (m *AtlasValidateFieldOption) GetPathVariable() string {
+	if m != nil {
+		return m.PathVariable
+	}
+	return ""
+}
+
+func (m *AtlasValidateFieldOption) GetMaxLength() uint32 {
+	if m != nil {
+		return m.MaxLength
+	}
+	return 0
+}
+
+func (m *AtlasValidateFieldOption) GetMin() float64 {
+	if x, ok := m.GetMinBound().(*AtlasValidateFieldOption_Min); ok {
+		return x.Min
+	}
+	return 0
+}
+
+func (m *AtlasValidateFieldOption) GetMax() float64 {
+	if x, ok := m.GetMaxBound().(*AtlasValidateFieldOption_Max); ok {
+		return x.Max
+	}
+	return 0
+}
+
+func (m *AtlasValidateFieldOption) GetAllowUnknownFields() bool {
+	if m != nil {
+		return m.AllowUnknownFields
+	}
+	return false
+}
+
+func (m *AtlasValidateFieldOption) GetRequiredIf() string {
+	if m != nil {
+		return m.RequiredIf
+	}
+	return ""
+}
+
+func (m *AtlasValidateFieldOption) GetMinItems() uint32 {
+	if m != nil {
+		return m.MinItems
+	}
+	return 0
+}
+
+func (m *AtlasValidateFieldOption) GetMaxItems() uint32 {
+	if m != nil {
+		return m.MaxItems
+	}
+	return 0
+}
+
+func (m *AtlasValidateFieldOption) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *AtlasValidateFieldOption) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *AtlasValidateFieldOption) GetNonNullable() bool {
+	if m != nil {
+		return m.NonNullable
+	}
+	return false
+}
+
+func (m *AtlasValidateFieldOption) GetStrict() bool {
+	if m != nil {
+		return m.Strict
+	}
+	return false
+}
+
+func (m *AtlasValidateFieldOption) GetKeyPattern() string {
+	if m != nil {
+		return m.KeyPattern
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*AtlasValidateFieldOption) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _AtlasValidateFieldOption_OneofMarshaler, _AtlasValidateFieldOption_OneofUnmarshaler, _AtlasValidateFieldOption_OneofSizer, []interface{}{
+		(*AtlasValidateFieldOption_Min)(nil),
+		(*AtlasValidateFieldOption_Max)(nil),
+	}
+}
+
+func _AtlasValidateFieldOption_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*AtlasValidateFieldOption)
+	// min_bound
+	switch x := m.MinBound.(type) {
+	case *AtlasValidateFieldOption_Min:
+		_ = b.EncodeVarint(10<<3 | proto.WireFixed64)
+		_ = b.EncodeFixed64(math.Float64bits(x.Min))
+	case nil:
+	default:
+		return fmt.Errorf("AtlasValidateFieldOption.MinBound has unexpected type %T", x)
+	}
+	// max_bound
+	switch x := m.MaxBound.(type) {
+	case *AtlasValidateFieldOption_Max:
+		_ = b.EncodeVarint(11<<3 | proto.WireFixed64)
+		_ = b.EncodeFixed64(math.Float64bits(x.Max))
+	case nil:
+	default:
+		return fmt.Errorf("AtlasValidateFieldOption.MaxBound has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _AtlasValidateFieldOption_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*AtlasValidateFieldOption)
+	switch tag {
+	case 10: // min_bound.min
+		if wire != proto.WireFixed64 {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeFixed64()
+		m.MinBound = &AtlasValidateFieldOption_Min{math.Float64frombits(x)}
+		return true, err
+	case 11: // max_bound.max
+		if wire != proto.WireFixed64 {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeFixed64()
+		m.MaxBound = &AtlasValidateFieldOption_Max{math.Float64frombits(x)}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _AtlasValidateFieldOption_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*AtlasValidateFieldOption)
+	// min_bound
+	switch x := m.MinBound.(type) {
+	case *AtlasValidateFieldOption_Min:
+		n += proto.SizeVarint(10<<3 | proto.WireFixed64)
+		n += 8
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	// max_bound
+	switch x := m.MaxBound.(type) {
+	case *AtlasValidateFieldOption_Max:
+		n += proto.SizeVarint(11<<3 | proto.WireFixed64)
+		n += 8
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
 var E_File = &proto.ExtensionDesc{
 	ExtendedType:  (*google_protobuf.FileOptions)(nil),
 	ExtensionType: (*AtlasValidateFileOption)(nil),
@@ -167,6 +776,24 @@ var E_Service = &proto.ExtensionDesc{
 	Filename:      "github.com/infobloxopen/protoc-gen-atlas-validate/options/atlas_validate.proto",
 }
 
+var E_Message = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.MessageOptions)(nil),
+	ExtensionType: (*AtlasValidateMessageOption)(nil),
+	Field:         52219,
+	Name:          "atlas_validate.message",
+	Tag:           "bytes,52219,opt,name=message",
+	Filename:      "github.com/infobloxopen/protoc-gen-atlas-validate/options/atlas_validate.proto",
+}
+
+var E_Enum = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.EnumOptions)(nil),
+	ExtensionType: (*AtlasValidateEnumOption)(nil),
+	Field:         52219,
+	Name:          "atlas_validate.enum",
+	Tag:           "bytes,52219,opt,name=enum",
+	Filename:      "github.com/infobloxopen/protoc-gen-atlas-validate/options/atlas_validate.proto",
+}
+
 var E_Field = &proto.ExtensionDesc{
 	ExtendedType:  (*google_protobuf.FieldOptions)(nil),
 	ExtensionType: (*AtlasValidateFieldOption)(nil),
@@ -180,11 +807,19 @@ func init() {
 	proto.RegisterType((*AtlasValidateFileOption)(nil), "atlas_validate.AtlasValidateFileOption")
 	proto.RegisterType((*AtlasValidateMethodOption)(nil), "atlas_validate.AtlasValidateMethodOption")
 	proto.RegisterType((*AtlasValidateServiceOption)(nil), "atlas_validate.AtlasValidateServiceOption")
+	proto.RegisterType((*AtlasValidateMessageOption)(nil), "atlas_validate.AtlasValidateMessageOption")
+	proto.RegisterType((*AtlasValidateAtLeastOneOf)(nil), "atlas_validate.AtlasValidateAtLeastOneOf")
+	proto.RegisterType((*AtlasValidateMutuallyExclusive)(nil), "atlas_validate.AtlasValidateMutuallyExclusive")
+	proto.RegisterType((*AtlasValidateRequiredForType)(nil), "atlas_validate.AtlasValidateRequiredForType")
+	proto.RegisterType((*AtlasValidateExpression)(nil), "atlas_validate.AtlasValidateExpression")
+	proto.RegisterType((*AtlasValidateEnumOption)(nil), "atlas_validate.AtlasValidateEnumOption")
 	proto.RegisterType((*AtlasValidateFieldOption)(nil), "atlas_validate.AtlasValidateFieldOption")
 	proto.RegisterEnum("atlas_validate.AtlasValidateFieldOption_Operation", AtlasValidateFieldOption_Operation_name, AtlasValidateFieldOption_Operation_value)
 	proto.RegisterExtension(E_File)
 	proto.RegisterExtension(E_Method)
 	proto.RegisterExtension(E_Service)
+	proto.RegisterExtension(E_Message)
+	proto.RegisterExtension(E_Enum)
 	proto.RegisterExtension(E_Field)
 }
 
@@ -193,32 +828,83 @@ func init() {
 }
 
 var fileDescriptorAtlasValidate = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xdf, 0x6e, 0x94, 0x40,
-	0x14, 0xc6, 0xa5, 0xad, 0xdb, 0x7a, 0x9a, 0x34, 0x9b, 0x89, 0x89, 0xd8, 0xf8, 0x87, 0xec, 0x8d,
-	0x68, 0xb2, 0xd0, 0xac, 0x77, 0x78, 0x55, 0x4d, 0xf6, 0xc6, 0x74, 0x9b, 0x60, 0xf4, 0x42, 0x2f,
-	0xc8, 0x00, 0x07, 0x3a, 0x71, 0x3a, 0x83, 0xc3, 0xd0, 0xea, 0x93, 0xf8, 0x82, 0xbe, 0x85, 0x37,
-	0x86, 0x61, 0x59, 0x96, 0x5d, 0x5d, 0x1b, 0xae, 0x98, 0x9c, 0xc3, 0xf7, 0xfb, 0x0e, 0xf3, 0x1d,
-	0x60, 0x91, 0x33, 0x7d, 0x55, 0xc5, 0x5e, 0x22, 0xaf, 0x7d, 0x26, 0x32, 0x19, 0x73, 0xf9, 0x5d,
-	0x16, 0x28, 0xfc, 0x42, 0x49, 0x2d, 0x93, 0x69, 0x8e, 0x62, 0x4a, 0x35, 0xa7, 0xe5, 0xf4, 0x86,
-	0x72, 0x96, 0x52, 0x8d, 0xbe, 0x2c, 0x34, 0x93, 0xa2, 0xf4, 0x4d, 0x39, 0x6a, 0xcb, 0x9e, 0x11,
-	0x90, 0x93, 0x7e, 0xf5, 0xd4, 0xc9, 0xa5, 0xcc, 0x39, 0x36, 0xb8, 0xb8, 0xca, 0xfc, 0x14, 0xcb,
-	0x44, 0xb1, 0x42, 0x4b, 0xd5, 0x28, 0x26, 0xef, 0xe1, 0xd1, 0x79, 0xad, 0xf9, 0xb4, 0x94, 0xcc,
-	0x19, 0xc7, 0x4b, 0x63, 0x41, 0xce, 0xe0, 0x21, 0xe5, 0x5c, 0xde, 0x46, 0x95, 0xf8, 0x2a, 0xe4,
-	0xad, 0x88, 0x32, 0x86, 0x3c, 0x2d, 0x6d, 0xcb, 0xb1, 0xdc, 0xa3, 0x90, 0x98, 0xde, 0xc7, 0xa6,
-	0x35, 0x37, 0x9d, 0xc9, 0x05, 0x3c, 0xee, 0xc1, 0x2e, 0x50, 0x5f, 0xc9, 0x74, 0x30, 0x6e, 0x01,
-	0xa7, 0x3d, 0xdc, 0x07, 0x54, 0x37, 0x2c, 0x19, 0x3e, 0xde, 0x2f, 0x0b, 0xec, 0x8d, 0x8f, 0x45,
-	0xde, 0x8e, 0x37, 0x87, 0x83, 0x14, 0xc5, 0x0f, 0xdb, 0x72, 0xf6, 0xdd, 0x93, 0xd9, 0xcc, 0xdb,
-	0xb8, 0xdf, 0x7f, 0xe9, 0xbc, 0xcb, 0x02, 0x15, 0xad, 0x4f, 0xa1, 0xd1, 0x93, 0x05, 0x1c, 0x29,
-	0xfc, 0x56, 0x31, 0x85, 0xa9, 0xbd, 0x37, 0x98, 0xb5, 0x62, 0x4c, 0xce, 0xe0, 0xc1, 0xaa, 0x4c,
-	0x00, 0x46, 0x89, 0x42, 0xaa, 0x71, 0x7c, 0xaf, 0x3e, 0x57, 0x45, 0x4d, 0x18, 0x5b, 0xe4, 0x18,
-	0x0e, 0x15, 0x16, 0x9c, 0x26, 0x38, 0xde, 0x0b, 0xbe, 0xc0, 0x41, 0xc6, 0x38, 0x92, 0x27, 0x5e,
-	0x93, 0xbe, 0xd7, 0xa6, 0xef, 0x75, 0xe1, 0x96, 0xf6, 0xef, 0x9f, 0xfb, 0x8e, 0xe5, 0x1e, 0xcf,
-	0x5e, 0xfc, 0x67, 0xba, 0x56, 0x11, 0x1a, 0x68, 0x90, 0xc0, 0xe8, 0xda, 0xa4, 0x4a, 0x9e, 0x6d,
-	0xe1, 0xd7, 0xe3, 0xee, 0x0c, 0x5e, 0xee, 0x34, 0x58, 0xd7, 0x84, 0x4b, 0x74, 0x90, 0xc3, 0x61,
-	0xd9, 0x64, 0x4d, 0x9e, 0x6f, 0xb9, 0xf4, 0xb6, 0xa0, 0xb3, 0x79, 0xb5, 0xd3, 0xa6, 0x27, 0x0a,
-	0x5b, 0x7a, 0x10, 0xc1, 0x7d, 0xb3, 0x35, 0xe4, 0xe9, 0x5f, 0xee, 0x6a, 0x95, 0x4b, 0x67, 0xe2,
-	0xde, 0x35, 0xca, 0xb0, 0xe1, 0xbe, 0x7d, 0xf7, 0xf9, 0x7c, 0xf0, 0x2f, 0xfe, 0x66, 0xf9, 0x8c,
-	0x47, 0xe6, 0xd5, 0xd7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x57, 0xbd, 0x35, 0xc3, 0x2e, 0x04,
+	// 1234 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdb, 0x72, 0x1b, 0x45,
+	0x13, 0x8e, 0x2c, 0x5b, 0xb6, 0xc6, 0x27, 0x79, 0x92, 0xfc, 0xd9, 0x3f, 0xe4, 0x60, 0x04, 0x05,
+	0x22, 0x95, 0xc8, 0x94, 0xb9, 0x01, 0xc3, 0x8d, 0x43, 0xd9, 0x45, 0x52, 0xf1, 0xa1, 0xd6, 0x21,
+	0x45, 0x41, 0x51, 0x53, 0x23, 0xa9, 0x25, 0x4f, 0xb4, 0x3b, 0xb3, 0xcc, 0xcc, 0xda, 0xbb, 0xd7,
+	0x5c, 0xf0, 0x08, 0x3c, 0x03, 0x57, 0xbc, 0x14, 0x6f, 0xc1, 0x0d, 0x35, 0x3d, 0xbb, 0x3a, 0xc5,
+	0x16, 0x29, 0x73, 0x65, 0xf5, 0xd7, 0xdd, 0xdf, 0xf4, 0x76, 0xf7, 0x74, 0x8f, 0xc9, 0xf1, 0x40,
+	0xd8, 0xf3, 0xb4, 0xd3, 0xee, 0xaa, 0x78, 0x47, 0xc8, 0xbe, 0xea, 0x44, 0x2a, 0x53, 0x09, 0xc8,
+	0x9d, 0x44, 0x2b, 0xab, 0xba, 0xcf, 0x06, 0x20, 0x9f, 0x71, 0x1b, 0x71, 0xf3, 0xec, 0x82, 0x47,
+	0xa2, 0xc7, 0x2d, 0xec, 0xa8, 0xc4, 0x0a, 0x25, 0xcd, 0x0e, 0xc2, 0xac, 0x84, 0xdb, 0xe8, 0x40,
+	0x37, 0xa6, 0xd1, 0xfb, 0xdb, 0x03, 0xa5, 0x06, 0x11, 0x78, 0xba, 0x4e, 0xda, 0xdf, 0xe9, 0x81,
+	0xe9, 0x6a, 0x91, 0x58, 0xa5, 0xbd, 0x47, 0xf3, 0xaf, 0x05, 0x72, 0x6f, 0xdf, 0x39, 0xbd, 0x29,
+	0x7c, 0x0e, 0x45, 0x04, 0x27, 0x78, 0x06, 0xfd, 0x9c, 0xdc, 0xe1, 0x51, 0xa4, 0x2e, 0x59, 0x2a,
+	0x87, 0x52, 0x5d, 0x4a, 0xd6, 0x17, 0x10, 0xf5, 0x4c, 0x50, 0xd9, 0xae, 0xb4, 0x56, 0x42, 0x8a,
+	0xba, 0xef, 0xbd, 0xea, 0x10, 0x35, 0xf4, 0x29, 0xa1, 0x6f, 0x8d, 0x92, 0x2c, 0x51, 0x42, 0x5a,
+	0xd0, 0x2c, 0xe1, 0xf6, 0xdc, 0x04, 0x0b, 0x68, 0xdf, 0x70, 0x9a, 0x53, 0xaf, 0x38, 0x75, 0x38,
+	0x7d, 0x48, 0x48, 0xcc, 0xb3, 0x92, 0xb5, 0xba, 0x5d, 0x69, 0xad, 0x87, 0xf5, 0x98, 0x67, 0x05,
+	0xd9, 0x3e, 0x79, 0xa8, 0xe1, 0x97, 0x54, 0x68, 0xe8, 0x31, 0x0d, 0x6f, 0xa1, 0x6b, 0x0d, 0x83,
+	0x38, 0xb1, 0x39, 0x33, 0x56, 0x0b, 0x39, 0x08, 0x16, 0x91, 0xf7, 0x7e, 0x69, 0x14, 0x7a, 0x9b,
+	0x03, 0x67, 0x72, 0x86, 0x16, 0xb4, 0x45, 0x1a, 0x31, 0xb7, 0xdd, 0x73, 0x86, 0x51, 0x49, 0x1e,
+	0x83, 0x09, 0x96, 0xd0, 0x6b, 0x03, 0xf1, 0x97, 0x46, 0xc9, 0x63, 0x87, 0xba, 0xc8, 0x5d, 0x2c,
+	0x56, 0x59, 0x1e, 0x31, 0x88, 0x20, 0x06, 0x69, 0x4d, 0x50, 0xc3, 0x98, 0x1a, 0x31, 0xcf, 0x5e,
+	0x3b, 0xc5, 0x41, 0x81, 0xd3, 0x1d, 0x72, 0x67, 0x6c, 0x6d, 0x21, 0xb3, 0xac, 0x93, 0x5b, 0x30,
+	0xc1, 0x32, 0xda, 0x6f, 0x95, 0xf6, 0xaf, 0x21, 0xb3, 0xcf, 0x9d, 0xa2, 0xf9, 0x47, 0x85, 0xfc,
+	0x7f, 0x2a, 0xcd, 0x47, 0x60, 0xcf, 0x55, 0xef, 0xc6, 0x89, 0xbe, 0x4b, 0x6a, 0x4a, 0x02, 0x53,
+	0xfd, 0x60, 0x61, 0xbb, 0xda, 0xaa, 0x87, 0x4b, 0x4a, 0xc2, 0x49, 0xdf, 0xc1, 0x5c, 0xe6, 0x0e,
+	0xae, 0x7a, 0x98, 0xcb, 0xfc, 0xa4, 0x7f, 0xcd, 0xc7, 0x2d, 0x5e, 0xfd, 0x71, 0xcd, 0x63, 0x72,
+	0x7f, 0x2a, 0xd4, 0x33, 0xd0, 0x17, 0xa2, 0x7b, 0xe3, 0xa6, 0x68, 0xfe, 0xba, 0x38, 0x43, 0x78,
+	0x04, 0xc6, 0xf0, 0x41, 0x49, 0xf8, 0x15, 0xa9, 0x76, 0x21, 0x0a, 0x2a, 0xdb, 0xd5, 0xd6, 0xea,
+	0xee, 0xa7, 0xed, 0x99, 0xbe, 0x9e, 0x72, 0x3c, 0xc8, 0x12, 0x0d, 0xc6, 0x08, 0x25, 0x43, 0xe7,
+	0x33, 0xd3, 0x40, 0x0b, 0xb3, 0x0d, 0xd4, 0x26, 0xb7, 0xc5, 0x40, 0x2a, 0x0d, 0x0c, 0x32, 0xab,
+	0xf9, 0xb8, 0xd1, 0x5c, 0x6a, 0xb6, 0xbc, 0xea, 0xc0, 0x69, 0x0a, 0xfb, 0x8f, 0xc9, 0x7a, 0x4f,
+	0xb8, 0xfb, 0x11, 0x0b, 0xc9, 0xad, 0xd2, 0x98, 0xa1, 0x7a, 0x38, 0x0d, 0xd2, 0x1f, 0xc8, 0xd6,
+	0xa8, 0x2d, 0xfb, 0x4a, 0x33, 0x9b, 0x27, 0x10, 0x2c, 0x61, 0xf4, 0x4f, 0xe7, 0x46, 0x1f, 0x16,
+	0x5e, 0x87, 0x4a, 0xbf, 0xce, 0x13, 0x08, 0x37, 0xf5, 0x34, 0x40, 0x4f, 0xc9, 0x26, 0xb7, 0x2c,
+	0x02, 0x6e, 0x2c, 0x2b, 0xaa, 0x5b, 0x43, 0xde, 0xcf, 0xe6, 0xf2, 0xee, 0xdb, 0x57, 0xce, 0xe5,
+	0xc4, 0x75, 0x40, 0xb8, 0xc6, 0x27, 0x24, 0xfa, 0x33, 0xa1, 0x71, 0x6a, 0x53, 0x1e, 0x45, 0x39,
+	0x83, 0xac, 0x1b, 0xa5, 0x46, 0x5c, 0x40, 0xb0, 0x8c, 0xa4, 0xed, 0xb9, 0xa4, 0x47, 0x85, 0xdb,
+	0x41, 0xe9, 0x15, 0x6e, 0xc5, 0xb3, 0x10, 0x7d, 0x42, 0xb6, 0xd2, 0xc4, 0x59, 0xb3, 0x98, 0x9b,
+	0xa1, 0xcf, 0x6f, 0xb0, 0x82, 0x49, 0xdb, 0xf4, 0x8a, 0x23, 0x6e, 0x86, 0x98, 0xdd, 0xe6, 0x6f,
+	0xb3, 0x37, 0x60, 0x32, 0x6c, 0xfa, 0x3f, 0x52, 0x1b, 0xf5, 0x91, 0xab, 0x4e, 0x21, 0xd1, 0x90,
+	0x10, 0x95, 0x80, 0xe6, 0x38, 0xf3, 0xb0, 0xd7, 0x37, 0x76, 0x77, 0xe7, 0x06, 0x8e, 0xa7, 0xf9,
+	0xd6, 0x6a, 0x9f, 0x94, 0xae, 0xe1, 0x04, 0x4b, 0xf3, 0x4b, 0xf2, 0x68, 0xfe, 0xa7, 0x5e, 0x17,
+	0x4d, 0xf3, 0x25, 0x79, 0x30, 0xaf, 0xa2, 0x94, 0x92, 0x45, 0xec, 0x86, 0x0a, 0xa6, 0x00, 0x7f,
+	0x4f, 0x70, 0x2d, 0x4c, 0x71, 0x9d, 0x91, 0x7b, 0xd7, 0xf4, 0x36, 0x7d, 0x44, 0x08, 0x8c, 0xa4,
+	0x82, 0x6c, 0x02, 0xa1, 0x01, 0x59, 0x8e, 0xfd, 0x15, 0xc2, 0x9e, 0xaf, 0x87, 0xa5, 0xd8, 0x3c,
+	0x9a, 0x25, 0x95, 0x69, 0x5c, 0x5c, 0xb3, 0x5d, 0x72, 0xd7, 0xdf, 0xdb, 0x44, 0x43, 0x5f, 0x64,
+	0xec, 0x82, 0x6b, 0xc1, 0xdd, 0x18, 0xf0, 0x17, 0xf7, 0x36, 0x2a, 0x4f, 0x51, 0xf7, 0xa6, 0x50,
+	0x35, 0xff, 0xac, 0x91, 0xe0, 0xba, 0xe4, 0xd2, 0x43, 0xb2, 0xd8, 0x03, 0x99, 0x07, 0x95, 0x1b,
+	0x17, 0x05, 0xfd, 0xe9, 0x31, 0x59, 0x29, 0x2f, 0xc2, 0x7f, 0x28, 0xf0, 0x88, 0xc3, 0x65, 0xa7,
+	0x07, 0x7d, 0x9e, 0x46, 0x16, 0x57, 0x4a, 0x3d, 0x2c, 0x45, 0xfa, 0x09, 0xd9, 0xc4, 0x71, 0x91,
+	0xda, 0x54, 0x03, 0x33, 0x43, 0xb8, 0x2c, 0x6f, 0xb8, 0x9b, 0x19, 0x88, 0x9e, 0x0d, 0xe1, 0x12,
+	0x4b, 0xa6, 0x74, 0xcc, 0x2d, 0xee, 0x8a, 0x7a, 0x58, 0x48, 0x23, 0x7f, 0x17, 0x40, 0x31, 0xf0,
+	0xfd, 0x82, 0x58, 0x2f, 0x67, 0x0e, 0x0e, 0x7b, 0x37, 0x85, 0x85, 0x64, 0x06, 0x2c, 0xee, 0x83,
+	0x7a, 0xb8, 0x24, 0xe4, 0x19, 0x58, 0xfa, 0x11, 0x59, 0x77, 0xfb, 0xd0, 0x67, 0xbe, 0x13, 0x41,
+	0x71, 0x53, 0xd6, 0x1c, 0xf8, 0xa6, 0xc0, 0xca, 0x91, 0x16, 0x81, 0x1c, 0xd8, 0xf3, 0xa0, 0x3e,
+	0x1a, 0x69, 0xaf, 0x10, 0xa0, 0x94, 0x54, 0x63, 0x21, 0x03, 0xb2, 0x5d, 0x69, 0x55, 0xbe, 0xbb,
+	0x15, 0x3a, 0x01, 0x31, 0x9e, 0x05, 0xab, 0x88, 0x55, 0x42, 0x27, 0x5c, 0x3b, 0xa5, 0xd7, 0xae,
+	0xdd, 0x28, 0x8f, 0xc9, 0xea, 0x68, 0xac, 0x89, 0x7e, 0xb0, 0xee, 0xbb, 0xae, 0x84, 0x5e, 0xf4,
+	0xe9, 0x07, 0xa4, 0x1e, 0x0b, 0xc9, 0x84, 0x85, 0xd8, 0x04, 0x1b, 0x18, 0xd8, 0x4a, 0x2c, 0xe4,
+	0x0b, 0x27, 0xa3, 0x92, 0x67, 0x85, 0x72, 0xb3, 0x50, 0xf2, 0x6c, 0xa4, 0xd4, 0xc0, 0x7b, 0x4c,
+	0xc9, 0x28, 0x0f, 0x1a, 0x18, 0xc1, 0x8a, 0x03, 0x4e, 0x64, 0x94, 0xbb, 0x72, 0x25, 0xdc, 0x5a,
+	0xd0, 0x32, 0xd8, 0xf2, 0xe5, 0x2a, 0x44, 0xfa, 0x21, 0x59, 0x93, 0x6e, 0x6b, 0xa7, 0x51, 0x84,
+	0xe9, 0xa2, 0xe8, 0xb9, 0x2a, 0x95, 0x3c, 0x2e, 0x20, 0x57, 0x29, 0x63, 0xb5, 0xe8, 0xda, 0xe0,
+	0x36, 0x2a, 0x0b, 0xc9, 0x7d, 0xcc, 0x10, 0x72, 0x56, 0x12, 0xdf, 0xf1, 0x1f, 0x33, 0x84, 0xfc,
+	0xd4, 0x23, 0xcd, 0x6f, 0x48, 0x7d, 0xd4, 0x3b, 0x94, 0x90, 0x5a, 0x57, 0x03, 0xb7, 0xd0, 0xb8,
+	0xe5, 0x7e, 0xfb, 0xc9, 0xd5, 0xa8, 0xd0, 0x55, 0xb2, 0xac, 0x21, 0x89, 0x78, 0x17, 0x1a, 0x0b,
+	0x68, 0x94, 0x1a, 0xab, 0xe2, 0x46, 0xf5, 0xf9, 0xaa, 0x4f, 0x45, 0x47, 0xa5, 0xb2, 0x87, 0x02,
+	0xcf, 0xbc, 0xb0, 0xf7, 0x13, 0x59, 0xec, 0x8b, 0x08, 0xe8, 0x83, 0xb6, 0x7f, 0x79, 0xb5, 0xcb,
+	0x97, 0x57, 0x7b, 0xfc, 0xae, 0x32, 0xc1, 0xdf, 0xbf, 0xbb, 0xce, 0xfc, 0xb7, 0x6d, 0x37, 0xf6,
+	0x08, 0x91, 0x74, 0xaf, 0x4b, 0x6a, 0x31, 0x3e, 0x1b, 0xe8, 0xa3, 0x77, 0xe8, 0x27, 0xdf, 0x13,
+	0xe3, 0x03, 0xe6, 0x2f, 0x8e, 0x49, 0x9f, 0xb0, 0xa0, 0xde, 0x1b, 0x90, 0x65, 0xe3, 0x17, 0x3e,
+	0x7d, 0xfc, 0xce, 0x29, 0x53, 0x4f, 0x81, 0xf1, 0x31, 0x4f, 0xe6, 0x1e, 0x33, 0xe5, 0x14, 0x96,
+	0xec, 0xee, 0xa0, 0x62, 0x6c, 0x5d, 0x71, 0xd0, 0xd4, 0x13, 0xe1, 0x7d, 0x0f, 0x9a, 0x72, 0x1a,
+	0x0d, 0x45, 0x57, 0x13, 0x90, 0x69, 0x7c, 0x45, 0x4d, 0xc6, 0xe3, 0xf1, 0x7d, 0x6b, 0x32, 0xf6,
+	0x08, 0x91, 0x74, 0x8f, 0x91, 0x25, 0xbc, 0x5a, 0xf4, 0xe1, 0x15, 0x15, 0x1f, 0x0d, 0xaa, 0x31,
+	0x7d, 0xeb, 0x7d, 0x67, 0x5b, 0xe8, 0x79, 0x9f, 0x7f, 0xfb, 0xe3, 0xfe, 0x8d, 0xff, 0x49, 0xf8,
+	0xba, 0xf8, 0xdb, 0xa9, 0xa1, 0xe9, 0x17, 0xff, 0x0c, 0x00, 0xfa, 0x3a, 0xd2, 0x09, 0x70, 0x0c,
 	0x00, 0x00,
 }
@@ -0,0 +1,95 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// atLeastOneOf describes a group of at_least_one_of message option.
+type atLeastOneOf struct {
+	fields []*descriptor.FieldDescriptorProto
+	// methods are HTTP methods the group is required for, all of them if empty.
+	methods []string
+}
+
+// names function returns a Go literal of names of fields of the group.
+func (g atLeastOneOf) names() string {
+	var names []string
+	for _, f := range g.fields {
+		names = append(names, fmt.Sprintf("%q", f.GetName()))
+	}
+
+	return "[]string{" + strings.Join(names, ", ") + "}"
+}
+
+// requiredFor function tells whether the group is required for an HTTP method.
+func (g atLeastOneOf) requiredFor(method string) bool {
+	for _, m := range g.methods {
+		if m == method {
+			return true
+		}
+	}
+
+	return len(g.methods) == 0
+}
+
+// getAtLeastOneOf function returns groups of at_least_one_of option of a message,
+// the option must list fields of the message.
+func (p *Plugin) getAtLeastOneOf(o *descriptor.DescriptorProto, t string) []atLeastOneOf {
+	var groups []atLeastOneOf
+	for _, g := range p.messageOption(o.Options).GetAtLeastOneOf() {
+		if len(g.GetFields()) == 0 {
+			p.Fail(`at_least_one_of option of message "`, t, `" contains a group without fields`)
+		}
+
+		group := atLeastOneOf{methods: p.GetRequiredMethods(g.GetOperations())}
+		for _, fn := range g.GetFields() {
+			var fd *descriptor.FieldDescriptorProto
+			for _, f := range o.GetField() {
+				if f.GetName() == fn {
+					fd = f
+				}
+			}
+			if fd == nil {
+				p.Fail(`at_least_one_of option of message "`, t, `" refers to unknown field `, fn)
+			}
+			group.fields = append(group.fields, fd)
+		}
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// renderAtLeastOneOf function generates checks of at_least_one_of groups within
+// validate_required_Object_ function: an object must contain a field of each group
+// required for the method, JSON null counts as a present field. A group with a field
+// without presence is not checked in a body marshaled from a proto message.
+func (p *Plugin) renderAtLeastOneOf(o *descriptor.DescriptorProto, t string) {
+
+	fmtPkg := p.Import(fmtPkgPath)
+
+	for _, g := range p.getAtLeastOneOf(o, t) {
+		var (
+			absent   []string
+			implicit bool
+		)
+		for _, f := range g.fields {
+			absent = append(absent, `v["`+f.GetName()+`"] == nil`)
+			implicit = implicit || p.implicitPresence(o, f)
+		}
+		cond := strings.Join(absent, ` && `)
+		if implicit {
+			cond += ` && !marshaled`
+		}
+		if len(g.methods) != 0 {
+			cond += ` && (method == "` + strings.Join(g.methods, `" || method == "`) + `")`
+		}
+
+		p.P(`if `, p.ruleGuard(o, g.fields[0], "at_least_one_of"), cond, ` {`)
+		p.renderObjectError(p.withCode("CodeRequired", `path`, fmtPkg.Use(), `.Errorf("at least one of %v is required", `, g.names(), `)`)...)
+		p.P(`}`)
+	}
+}
@@ -0,0 +1,170 @@
+package plugin
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// wktSamples holds JSON values of well-known types used in sample bodies of
+// benchmarks, types that are not listed (e.g. Any) are left out of them.
+var wktSamples = map[string]string{
+	".google.protobuf.Timestamp": `"2018-10-01T12:00:00Z"`,
+	".google.protobuf.Duration":  `"1s"`,
+	".google.protobuf.FieldMask": `"name"`,
+	".google.protobuf.Struct":    `{"a": 1}`,
+	".google.protobuf.ListValue": `[1]`,
+	".google.protobuf.Value":     `"a"`,
+	".google.protobuf.Empty":     `{}`,
+}
+
+// scalarSamples maps kinds of runtime.ValidateScalar to JSON values used in
+// sample bodies of benchmarks.
+var scalarSamples = map[string]string{
+	"bool":   `true`,
+	"int32":  `1`,
+	"int64":  `"1"`,
+	"uint32": `1`,
+	"uint64": `"1"`,
+	"float":  `1.5`,
+	"double": `1.5`,
+	"string": `"a"`,
+	"bytes":  `"YQ=="`,
+}
+
+// renderObjectBenchmark function writes a benchmark of validate_Object_ function
+// of a message generated with gen_benchmarks=true parameter, see sampleObject for
+// a body it validates. A benchmark is skipped if the body is not valid.
+func (p *Plugin) renderObjectBenchmark(b *bytes.Buffer, o *descriptor.DescriptorProto, t string) {
+	fmt.Fprintf(b, "func Benchmark_validate_Object_%s(b *testing.B) {\n", t)
+	b.WriteString("ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, \"POST\")\n")
+	b.WriteString("ctx = context.WithValue(ctx, runtime.AllowUnknownContextKey, false)\n")
+	fmt.Fprintf(b, "body := json.RawMessage(`%s`)\n", p.sampleObject(o, map[*descriptor.DescriptorProto]bool{}))
+	fmt.Fprintf(b, "if err := validate_Object_%s(ctx, body, \"\"); err != nil {\n", t)
+	b.WriteString("b.Skipf(\"sample body is not valid: %s\", err)\n")
+	b.WriteString("}\n")
+	b.WriteString("b.ReportAllocs()\n")
+	b.WriteString("b.ResetTimer()\n")
+	b.WriteString("for i := 0; i < b.N; i++ {\n")
+	fmt.Fprintf(b, "_ = validate_Object_%s(ctx, body, \"\")\n", t)
+	b.WriteString("}\n")
+	b.WriteString("}\n\n")
+}
+
+// sampleObject function returns a JSON object of a message for a benchmark: a
+// value of every field whose options do not constrain it, only the first field of
+// each oneof and mutually_exclusive group, one element of repeated fields and maps
+// and nested objects of local messages that do not recur. Fields of other types
+// are left out.
+func (p *Plugin) sampleObject(o *descriptor.DescriptorProto, visiting map[*descriptor.DescriptorProto]bool) string {
+	visiting[o] = true
+	defer delete(visiting, o)
+
+	// fields of a oneof share a group with its index, mutually_exclusive groups
+	// follow them
+	groups := make(map[string][]int)
+	for i, g := range p.messageOption(o.Options).GetMutuallyExclusive() {
+		for _, fn := range g.GetFields() {
+			groups[fn] = append(groups[fn], len(o.GetOneofDecl())+i)
+		}
+	}
+	for _, f := range o.GetField() {
+		if f.OneofIndex != nil {
+			groups[f.GetName()] = append(groups[f.GetName()], int(f.GetOneofIndex()))
+		}
+	}
+
+	var fields []string
+	set := make(map[int]bool)
+	for _, f := range o.GetField() {
+		skip := false
+		for _, g := range groups[f.GetName()] {
+			skip = skip || set[g]
+		}
+		if skip {
+			continue
+		}
+
+		v, ok := p.sampleField(f, visiting)
+		if !ok {
+			continue
+		}
+		for _, g := range groups[f.GetName()] {
+			set[g] = true
+		}
+		fields = append(fields, fmt.Sprintf("%q: %s", f.GetName(), v))
+	}
+
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// sampleField function returns a JSON value of a field for sampleObject and
+// reports whether the field has one.
+func (p *Plugin) sampleField(f *descriptor.FieldDescriptorProto, visiting map[*descriptor.DescriptorProto]bool) (string, bool) {
+	fo := p.getFieldOption(f)
+	if len(fo.GetDeny()) != 0 || fo.GetReadOnly() || fo.GetFormat() != "" || fo.GetPattern() != "" || fo.GetKeyPattern() != "" || fo.GetInSet() != "" || fo.GetPathVariable() != "" || fo.GetMinBound() != nil || fo.GetMaxBound() != nil || fo.GetMinItems() > 1 {
+		return "", false
+	}
+
+	if p.IsMap(f) {
+		entry, ok := p.messages[f.GetTypeName()]
+		if !ok {
+			return "", false
+		}
+		key, value := entry.GetField()[0], entry.GetField()[1]
+		v, ok := p.sampleValue(value, visiting)
+		if !ok {
+			return "", false
+		}
+		k := "a"
+		if kind := p.scalarKind(key); isIntegerKind(kind) {
+			k = "1"
+		} else if kind == "bool" {
+			k = "true"
+		}
+		return fmt.Sprintf("{%q: %s}", k, v), true
+	}
+
+	v, ok := p.sampleValue(f, visiting)
+	if ok && f.IsRepeated() {
+		v = "[" + v + "]"
+	}
+
+	return v, ok
+}
+
+// sampleValue function returns a JSON value of a single element of a field for
+// sampleField and reports whether the field has one.
+func (p *Plugin) sampleValue(f *descriptor.FieldDescriptorProto, visiting map[*descriptor.DescriptorProto]bool) (string, bool) {
+	if f.IsEnum() {
+		if e, ok := p.enums[f.GetTypeName()]; ok && len(e.GetValue()) != 0 {
+			return fmt.Sprintf("%q", e.GetValue()[0].GetName()), true
+		}
+		return "", false
+	}
+
+	if kind := p.valueKind(f); kind == "string" && p.getFieldOption(f).GetMaxLength() == 0 && p.getFieldOption(f).GetMaxFieldBytes() == 0 {
+		// names tell string values apart for rules comparing fields
+		return fmt.Sprintf("%q", f.GetName()), true
+	} else if kind != "" {
+		return scalarSamples[kind], true
+	}
+
+	if !f.IsMessage() {
+		return "", false
+	}
+
+	if p.isWKT(f.GetTypeName()) {
+		v, ok := wktSamples[f.GetTypeName()]
+		return v, ok
+	}
+
+	m, ok := p.messages[f.GetTypeName()]
+	if !ok || !m.local || visiting[m.DescriptorProto] {
+		return "", false
+	}
+
+	return p.sampleObject(m.DescriptorProto, visiting), true
+}
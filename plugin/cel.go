@@ -0,0 +1,53 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+
+	av_opts "github.com/infobloxopen/protoc-gen-atlas-validate/options"
+)
+
+// getCELExpressions function returns CEL expressions of atlas_validate.message option
+// of a message, expressions are ignored unless the plugin is run with cel=true.
+func (p *Plugin) getCELExpressions(o *descriptor.DescriptorProto) []*av_opts.AtlasValidateExpression {
+	if !p.cel {
+		return nil
+	}
+
+	return p.messageOption(o.Options).GetCel()
+}
+
+// renderCELPrograms function generates package-level CEL programs of a message,
+// expressions are compiled here as well so that invalid ones fail generation.
+func (p *Plugin) renderCELPrograms(o *descriptor.DescriptorProto, t string) {
+
+	celPkg := p.Import(celPkgPath)
+
+	for i, e := range p.getCELExpressions(o) {
+		if err := compileCEL(e.GetExpression()); err != nil {
+			p.Fail(`invalid CEL expression for message "`, t, `": `, err.Error())
+		}
+
+		p.P(`var cel_Object_`, t, `_`, i, ` = `, celPkg.Use(), `.MustCompile(`, fmt.Sprintf("%q", e.GetExpression()), `)`)
+	}
+	p.P()
+}
+
+// renderCELChecks function generates evaluation of CEL programs of a message
+// within validate_Object_ function, a failure is an error of the object at path.
+func (p *Plugin) renderCELChecks(o *descriptor.DescriptorProto, t string) {
+
+	celPkg := p.Import(celPkgPath)
+
+	for i, e := range p.getCELExpressions(o) {
+		msg := e.GetMessage()
+		if msg == "" {
+			msg = fmt.Sprintf("expression %q is not satisfied.", e.GetExpression())
+		}
+
+		p.P(`if err = `, celPkg.Use(), `.Validate(cel_Object_`, t, `_`, i, `, r, path, `, fmt.Sprintf("%q", msg), `); err != nil {`)
+		p.renderObjectError(p.withCode("CodeInvalid", `path`, `err`)...)
+		p.P(`}`)
+	}
+}
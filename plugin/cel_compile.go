@@ -0,0 +1,16 @@
+//go:build cel
+// +build cel
+
+package plugin
+
+import "github.com/infobloxopen/protoc-gen-atlas-validate/runtime/cel"
+
+// celSupported reports whether the plugin is built with the cel tag, only then
+// cel-go is linked into the plugin binary.
+const celSupported = true
+
+// compileCEL function type-checks expression against google.protobuf.Struct.
+func compileCEL(expr string) error {
+	_, err := cel.Compile(expr)
+	return err
+}
@@ -0,0 +1,15 @@
+//go:build !cel
+// +build !cel
+
+package plugin
+
+import "errors"
+
+// celSupported reports whether the plugin is built with the cel tag, only then
+// cel-go is linked into the plugin binary.
+const celSupported = false
+
+// compileCEL function is never reached without the cel tag, Init rejects cel=true.
+func compileCEL(expr string) error {
+	return errors.New("plugin is built without cel tag")
+}
@@ -0,0 +1,65 @@
+package plugin
+
+// withCode function wraps an error expression into runtime.WithCode with a code
+// constant of runtime package (e.g. "CodeRequired") and a path expression of the
+// field if the plugin is run with error_codes=true parameter.
+func (p *Plugin) withCode(code, field string, args ...interface{}) []interface{} {
+	if !p.errorCodes {
+		return args
+	}
+
+	runtimePkg := p.Import(runtimePkgPath).Use()
+	return append(append([]interface{}{runtimePkg, `.WithCode(`}, args...), `, `, runtimePkg, `.`, code, `, `, field, `)`)
+}
+
+// renderFieldError function generates handling of an error within the field loop
+// of validate_Object_ function: the error is returned or, with error_mode=collect
+// parameter, collected and validation continues with a next value of the loop.
+// With error_codes=true parameter an error the check does not code itself (see
+// renderCodedFieldError) is reported with CodeInvalid for the field.
+func (p *Plugin) renderFieldError(args ...interface{}) {
+	p.renderCodedFieldError("CodeInvalid", p.joinPath()+`(path, k)`, args...)
+}
+
+// renderCodedFieldError function is renderFieldError for an error of a field at
+// a path expression with a code of runtime package, see withCode.
+func (p *Plugin) renderCodedFieldError(code, field string, args ...interface{}) {
+	args = p.withCode(code, field, args...)
+	if !p.collectErrors {
+		p.P(append([]interface{}{`return `}, args...)...)
+		return
+	}
+
+	p.P(append(append([]interface{}{`errs = `, p.Import(runtimePkgPath).Use(), `.AppendError(errs, `}, args...), `)`)...)
+	p.P(`continue`)
+}
+
+// renderObjectError function generates handling of an error outside of loops of
+// validate_Object_ and validate_required_Object_ functions: the error is returned
+// or, with error_mode=collect parameter, collected and validation goes on.
+func (p *Plugin) renderObjectError(args ...interface{}) {
+	if !p.collectErrors {
+		p.P(append([]interface{}{`return `}, args...)...)
+		return
+	}
+
+	p.P(append(append([]interface{}{`errs = `, p.Import(runtimePkgPath).Use(), `.AppendError(errs, `}, args...), `)`)...)
+}
+
+// renderRange function generates a loop header over keys of a JSON object m,
+// keys are iterated in sorted order with error_mode=collect parameter so that
+// collected errors are reported deterministically.
+func (p *Plugin) renderRange(key, value, m string) {
+	if !p.collectErrors {
+		if value == "" {
+			value = "_"
+		}
+		p.P(`for `, key, `, `, value, ` := range `, m, ` {`)
+		return
+	}
+
+	p.P(`for _, `, key, ` := range `, p.Import(runtimePkgPath).Use(), `.SortedKeys(`, m, `) {`)
+	if value != "" {
+		p.P(value, ` := `, m, `[`, key, `]`)
+	}
+}
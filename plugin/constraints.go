@@ -0,0 +1,110 @@
+package plugin
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// renderConstraints function generates AtlasValidateConstraints variable with
+// constraints=true parameter: runtime.FieldConstraints of fields of local messages
+// that declare validation rules, keyed by a full name of a field without leading
+// dot, e.g. "examplepb.User.name".
+func (p *Plugin) renderConstraints() {
+
+	runtimePkg := p.Import(runtimePkgPath)
+
+	var names []string
+	for name, m := range p.messages {
+		if m.local && !m.GetOptions().GetMapEntry() {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	p.P(`// AtlasValidateConstraints describes validation rules declared with options of`)
+	p.P(`// fields, keyed by a full name of a field, e.g. to feed generation of API docs.`)
+	p.P(`var AtlasValidateConstraints = map[string]`, runtimePkg.Use(), `.FieldConstraints{`)
+	for _, name := range names {
+		for _, f := range p.messages[name].GetField() {
+			if c := p.fieldConstraints(f); len(c) != 0 {
+				p.P(`"`, strings.TrimPrefix(name, "."), `.`, f.GetName(), `": {`, strings.Join(c, `, `), `},`)
+			}
+		}
+	}
+	p.P(`}`)
+	p.P()
+}
+
+// fieldConstraints function returns non-zero fields of a runtime.FieldConstraints
+// literal of a field.
+func (p *Plugin) fieldConstraints(f *descriptor.FieldDescriptorProto) []string {
+	fo := p.getFieldOption(f)
+	runtimePkg := p.Import(runtimePkgPath)
+
+	methods := func(m []string) string {
+		for i := range m {
+			m[i] = strconv.Quote(m[i])
+		}
+		return `[]string{` + strings.Join(m, `, `) + `}`
+	}
+
+	var c []string
+	if m := p.fieldRequiredMethods(f); len(m) != 0 {
+		c = append(c, `Required: `+methods(m))
+	}
+	if fo.GetRequiredIf() != "" {
+		c = append(c, `RequiredIf: `+strconv.Quote(fo.GetRequiredIf()))
+	}
+	if fo.GetReadOnly() {
+		c = append(c, `Denied: `+methods(p.writeMethods()), `ReadOnly: true`)
+	} else if m := p.GetDeniedMethods(fo.GetDeny()); len(m) != 0 {
+		c = append(c, `Denied: `+methods(m))
+	}
+	for _, s := range []struct{ name, value string }{
+		{"Default", fo.GetDefault()},
+		{"Format", fo.GetFormat()},
+		{"Pattern", fo.GetPattern()},
+		{"KeyPattern", fo.GetKeyPattern()},
+		{"InSet", fo.GetInSet()},
+	} {
+		if s.value != "" {
+			c = append(c, s.name+`: `+strconv.Quote(s.value))
+		}
+	}
+	for _, n := range []struct {
+		name  string
+		value uint32
+	}{
+		{"MaxLength", fo.GetMaxLength()},
+		{"MaxFieldBytes", fo.GetMaxFieldBytes()},
+	} {
+		if n.value != 0 {
+			c = append(c, n.name+`: `+strconv.Itoa(int(n.value)))
+		}
+	}
+	min, max := p.getBounds(f)
+	if min != nil {
+		c = append(c, `Min: `+runtimePkg.Use()+`.Float64(`+strconv.FormatFloat(*min, 'g', -1, 64)+`)`)
+	}
+	if max != nil {
+		c = append(c, `Max: `+runtimePkg.Use()+`.Float64(`+strconv.FormatFloat(*max, 'g', -1, 64)+`)`)
+	}
+	if fo.GetMinItems() != 0 {
+		c = append(c, `MinItems: `+strconv.Itoa(int(fo.GetMinItems())))
+	}
+	if fo.GetMaxItems() != 0 {
+		c = append(c, `MaxItems: `+strconv.Itoa(int(fo.GetMaxItems())))
+	}
+	if fo.GetMaxFutureSkew() != "" {
+		c = append(c, `MaxFutureSkew: `+strconv.Quote(fo.GetMaxFutureSkew()))
+	}
+	if fo.GetNonNullable() {
+		c = append(c, `NonNullable: true`)
+	}
+
+	return c
+}
@@ -0,0 +1,407 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+
+	av_opts "github.com/infobloxopen/protoc-gen-atlas-validate/options"
+	av_runtime "github.com/infobloxopen/protoc-gen-atlas-validate/runtime"
+)
+
+// getFieldOption function returns atlas_validate.field option of a field or nil if
+// the option is not specified.
+func (p *Plugin) getFieldOption(f *descriptor.FieldDescriptorProto) *av_opts.AtlasValidateFieldOption {
+	return p.fieldOption(f.Options)
+}
+
+// hasDefaults function reports whether a local message t or any local message
+// reachable through its fields (or values of its maps) declares a default value
+// or an enum field that accepts variants of names, such values are replaced with
+// declared names. With case_insensitive=true parameter every local message has
+// defaults, as its keys are replaced with declared names as well.
+func (p *Plugin) hasDefaults(t string, visited map[string]bool) bool {
+	if visited[t] || p.isWKT(t) {
+		return false
+	}
+	visited[t] = true
+
+	obj, ok := p.messages[t]
+	if !ok || !obj.local {
+		return false
+	}
+
+	// keys matched with case_insensitive=true parameter are renamed in the body
+	if p.caseInsensitive && len(obj.GetField()) != 0 {
+		return true
+	}
+
+	for _, f := range obj.GetField() {
+		if p.getFieldOption(f).GetDefault() != "" || p.variantEnum(f) != nil {
+			return true
+		}
+		if p.isMapField(f) {
+			f = p.mapValueField(f)
+		}
+		if f.IsMessage() && p.hasDefaults(f.GetTypeName(), visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkDefault function validates a default literal of a field at generation time
+// with the checks validators of the field apply to a request value: the JSON type,
+// enum values, min and max, max_length, pattern, built-in formats, min_items and
+// max_items and map keys. Value sets and formats registered at run time are checked
+// by runtimeDefaultChecks when the default is injected.
+func (p *Plugin) checkDefault(f *descriptor.FieldDescriptorProto, dv string) error {
+	r, path := json.RawMessage(dv), f.GetName()
+
+	if p.IsMap(f) {
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(r, &m); err != nil || m == nil {
+			return fmt.Errorf("invalid value for %q: expected object.", path)
+		}
+
+		kf, vf := p.mapEntryFields(f)
+		var re *regexp.Regexp
+		if expr := p.getKeyPattern(f); expr != "" {
+			re = regexp.MustCompile(expr)
+		}
+
+		var keys []string
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			if err := av_runtime.ValidateMapKey(k, path, p.scalarKind(kf)); err != nil {
+				return err
+			}
+			if re != nil {
+				if err := av_runtime.ValidateMapKeyPattern(k, path, re); err != nil {
+					return err
+				}
+			}
+			if err := p.checkDefaultValue(vf, m[k], path+"."+k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !f.IsRepeated() {
+		return p.checkDefaultValue(f, r, path)
+	}
+
+	var arr []json.RawMessage
+	if err := json.Unmarshal(r, &arr); err != nil || arr == nil {
+		return fmt.Errorf("invalid value for %q: expected array.", path)
+	}
+
+	favOpt := p.getFieldOption(f)
+	if n := int(favOpt.GetMinItems()); n != 0 && len(arr) < n {
+		return fmt.Errorf("field %q must have at least %d items", path, n)
+	}
+	if n := int(favOpt.GetMaxItems()); n != 0 && len(arr) > n {
+		return fmt.Errorf("field %q must have at most %d items", path, n)
+	}
+
+	for i, v := range arr {
+		if err := p.checkDefaultValue(f, v, fmt.Sprintf("%s.[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkDefaultValue function validates a single value of a default literal, a value
+// of a field or an element of a repeated one, values of a map are checked against
+// the value field of the map entry.
+func (p *Plugin) checkDefaultValue(f *descriptor.FieldDescriptorProto, r json.RawMessage, path string) error {
+	if f.IsEnum() {
+		e, ok := p.enums[f.GetTypeName()]
+		if !ok {
+			return nil
+		}
+		// injected values are not canonicalized, variants of names are not accepted
+		names := make(map[string]int32)
+		for _, v := range e.GetValue() {
+			names[v.GetName()] = v.GetNumber()
+		}
+		return av_runtime.ValidateEnum(r, path, &av_runtime.Enum{Name: e.GetName(), Names: names})
+	}
+
+	if kind := wktKinds[f.GetTypeName()]; kind != "" {
+		return av_runtime.ValidateWKT(r, path, kind)
+	}
+
+	kind := p.valueKind(f)
+	if kind == "" {
+		var v map[string]json.RawMessage
+		if string(r) != "null" && json.Unmarshal(r, &v) != nil {
+			return fmt.Errorf("invalid value for %q: expected object.", path)
+		}
+		return nil
+	}
+
+	errs := []error{av_runtime.ValidateScalar(r, path, kind)}
+	if kind == "bytes" {
+		errs = append(errs, av_runtime.ValidateBase64(r, path))
+	}
+	if min, max := p.getBounds(f); min != nil || max != nil {
+		if min != nil {
+			errs = append(errs, av_runtime.ValidateMin(r, path, kind, *min))
+		}
+		if max != nil {
+			errs = append(errs, av_runtime.ValidateMax(r, path, kind, *max))
+		}
+	}
+	if n := p.getFieldOption(f).GetMaxLength(); n != 0 {
+		errs = append(errs, av_runtime.ValidateMaxLength(r, path, int(n), kind))
+	}
+	if expr := p.getPattern(f); expr != "" {
+		errs = append(errs, av_runtime.ValidatePattern(r, path, regexp.MustCompile(expr)))
+	}
+	if name := p.getFormat(f); name != "" && av_runtime.HasFormat(name) {
+		errs = append(errs, av_runtime.ValidateFormat(r, path, name))
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runtimeDefaultChecks function generates checks of a default literal of a field
+// that depend on registrations done at run time: in_set option and a format that
+// is not built in. A default that does not pass them fails the request.
+func (p *Plugin) runtimeDefaultChecks(f *descriptor.FieldDescriptorProto, dv string) {
+
+	var (
+		jsonPkg    = p.Import(jsonPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	var checks []string
+	if name := p.getInSet(f); name != "" {
+		checks = append(checks, `.ValidateInSet(%s, %s, "`+name+`")`)
+	}
+	if name := p.getFormat(f); name != "" && !av_runtime.HasFormat(name) {
+		checks = append(checks, `.ValidateFormat(%s, %s, "`+name+`")`)
+	}
+	if len(checks) == 0 {
+		return
+	}
+
+	values := []json.RawMessage{json.RawMessage(dv)}
+	if f.IsRepeated() {
+		// checkDefault has already validated the array
+		values = nil
+		json.Unmarshal([]byte(dv), &values)
+	}
+
+	for i, v := range values {
+		value := jsonPkg.Use() + ".RawMessage(" + strconv.Quote(string(v)) + ")"
+		path := p.joinPath() + `(path, "` + f.GetName() + `")`
+		if f.IsRepeated() {
+			path = fmt.Sprintf("%s(%s, %d)", p.joinIndex(), path, i)
+		}
+		for _, check := range checks {
+			p.P(`if err := `, runtimePkg.Use(), fmt.Sprintf(check, value, path), `; err != nil {`)
+			p.P(`return nil, err`)
+			p.P(`}`)
+		}
+	}
+}
+
+// defaultKeys function returns quoted names a value of a field is accepted under
+// by validators: the proto name and the json name with match_json_names option.
+// A default is injected only if none of them is set, with case_insensitive=true
+// parameter in any case.
+func (p *Plugin) defaultKeys(f *descriptor.FieldDescriptorProto) []string {
+	names := []string{strconv.Quote(f.GetName())}
+	if p.matchJSONNames() && f.GetJsonName() != "" && f.GetJsonName() != f.GetName() {
+		names = append(names, strconv.Quote(f.GetJsonName()))
+	}
+
+	return names
+}
+
+// renderDefaultLookup function generates a block over a value vv of a field set in v
+// under any of names validators accept, see defaultKeys, and returns an expression
+// of the key the value is set under.
+func (p *Plugin) renderDefaultLookup(f *descriptor.FieldDescriptorProto) string {
+	names := p.defaultKeys(f)
+	if len(names) == 1 {
+		p.P(`if vv, ok := v[`, names[0], `]; ok {`)
+		return names[0]
+	}
+
+	p.P(`for _, name := range []string{`, strings.Join(names, ", "), `} {`)
+	p.P(`vv, ok := v[name]`)
+	p.P(`if !ok {`)
+	p.P(`continue`)
+	p.P(`}`)
+	return `name`
+}
+
+// renderDefaultObjectMethod function generates default_Object_ function that injects
+// default values of absent fields into a JSON for a given object. The function
+// follows AtlasJSONValidate hook signature and returns the original JSON if nothing
+// was injected. With case_insensitive=true parameter keys that match fields only
+// case-insensitively are renamed to declared names first, the same way validators
+// match them, so grpc-gateway gets the fields validators have checked.
+func (p *Plugin) renderDefaultObjectMethod(o *descriptor.DescriptorProto, t string) {
+
+	var (
+		bytesPkg   = p.Import(bytesPkgPath)
+		jsonPkg    = p.Import(jsonPkgPath)
+		ctxPkg     = p.Import(ctxPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	p.P(`// default_Object_`, t, ` function injects default values of absent fields into a JSON for a given object.`)
+	p.P(`func default_Object_`, t, `(ctx `, ctxPkg.Use(), `.Context, r `, jsonPkg.Use(), `.RawMessage, path string) (`, jsonPkg.Use(), `.RawMessage, error) {`)
+	p.P(`var v map[string]`, jsonPkg.Use(), `.RawMessage`)
+	p.P(`if err := `, jsonPkg.Use(), `.Unmarshal(r, &v); err != nil || v == nil {`)
+	p.P(`return r, nil`)
+	p.P(`}`)
+	p.P()
+	p.P(`method := `, runtimePkg.Use(), `.HTTPMethodFromContext(ctx)`)
+	p.P(`_ = method`)
+	p.P(`changed := false`)
+	p.P()
+	if names := p.foldNames(o); p.caseInsensitive && len(names) != 0 {
+		p.P(`if `, runtimePkg.Use(), `.NormalizeKeys(v, []string{`, strings.Join(names, ", "), `}) {`)
+		p.P(`changed = true`)
+		p.P(`}`)
+		p.P()
+	}
+
+	for _, f := range o.GetField() {
+
+		favOpt := p.getFieldOption(f)
+
+		if dv := favOpt.GetDefault(); dv != "" {
+			if !json.Valid([]byte(dv)) {
+				p.Fail(`invalid default value for field "`, t, `.`, f.GetName(), `": `, dv, ` is not a valid JSON`)
+			}
+			if err := p.checkDefault(f, dv); err != nil {
+				p.Fail(`invalid default value for field "`, t, `.`, f.GetName(), `": `, err.Error())
+			}
+
+			absent := []interface{}{`_, ok := v["`, f.GetName(), `"]; !ok`}
+			if names := p.defaultKeys(f); len(names) > 1 || p.caseInsensitive {
+				absent = []interface{}{`!`, runtimePkg.Use(), `.HasKey(v, `, p.caseInsensitive, `, `, strings.Join(names, ", "), `)`}
+			}
+			if methods := p.GetDeniedMethods(favOpt.GetDeny()); len(methods) != 0 {
+				cond := strings.Join(methods, `" && method != "`)
+				p.P(append(append([]interface{}{`if `}, absent...), ` && method != "`, cond, `" {`)...)
+			} else {
+				p.P(append(append([]interface{}{`if `}, absent...), ` {`)...)
+			}
+			p.runtimeDefaultChecks(f, dv)
+			p.P(`v["`, f.GetName(), `"] = `, jsonPkg.Use(), `.RawMessage(`, strconv.Quote(dv), `)`)
+			p.P(`changed = true`)
+			p.P(`}`)
+			continue
+		}
+
+		if e := p.variantEnum(f); e != nil {
+			vf := f
+			if p.IsMap(f) {
+				_, vf = p.mapEntryFields(f)
+			}
+			key := p.renderDefaultLookup(f)
+			p.P(`if nv, ok := `, runtimePkg.Use(), `.CanonicalEnum(vv, `, p.enumVarName(vf), `); ok {`)
+			p.P(`v[`, key, `], changed = nv, true`)
+			p.P(`}`)
+			p.P(`}`)
+			continue
+		}
+
+		vf := f
+		if p.IsMap(f) {
+			vf = p.mapValueField(f)
+		}
+		if !vf.IsMessage() || !p.hasDefaults(vf.GetTypeName(), make(map[string]bool)) {
+			continue
+		}
+
+		ft := p.TypeName(p.objectNamed(vf.GetTypeName()))
+
+		key := p.renderDefaultLookup(f)
+		p.P(`vvPath := `, p.joinPath(), `(path, "`, f.GetName(), `")`)
+		if p.IsMap(f) {
+			p.P(`var vMap map[string]`, jsonPkg.Use(), `.RawMessage`)
+			p.P(`if err := `, jsonPkg.Use(), `.Unmarshal(vv, &vMap); err == nil {`)
+			p.P(`mapChanged := false`)
+			p.P(`for kk, vvv := range vMap {`)
+			p.P(`nv, err := default_Object_`, ft, `(ctx, vvv, `, p.joinPath(), `(vvPath, kk))`)
+			p.P(`if err != nil {`)
+			p.P(`return nil, err`)
+			p.P(`}`)
+			p.P(`if !`, bytesPkg.Use(), `.Equal(nv, vvv) {`)
+			p.P(`vMap[kk], mapChanged = nv, true`)
+			p.P(`}`)
+			p.P(`}`)
+			p.P(`if mapChanged {`)
+			p.P(`if v[`, key, `], err = `, jsonPkg.Use(), `.Marshal(vMap); err != nil {`)
+			p.P(`return nil, err`)
+			p.P(`}`)
+			p.P(`changed = true`)
+			p.P(`}`)
+			p.P(`}`)
+		} else if f.IsRepeated() {
+			p.P(`var vArr []`, jsonPkg.Use(), `.RawMessage`)
+			p.P(`if err := `, jsonPkg.Use(), `.Unmarshal(vv, &vArr); err == nil {`)
+			p.P(`arrChanged := false`)
+			p.P(`for i, vvv := range vArr {`)
+			p.P(`nv, err := default_Object_`, ft, `(ctx, vvv, `, p.joinIndex(), `(vvPath, i))`)
+			p.P(`if err != nil {`)
+			p.P(`return nil, err`)
+			p.P(`}`)
+			p.P(`if !`, bytesPkg.Use(), `.Equal(nv, vvv) {`)
+			p.P(`vArr[i], arrChanged = nv, true`)
+			p.P(`}`)
+			p.P(`}`)
+			p.P(`if arrChanged {`)
+			p.P(`if v[`, key, `], err = `, jsonPkg.Use(), `.Marshal(vArr); err != nil {`)
+			p.P(`return nil, err`)
+			p.P(`}`)
+			p.P(`changed = true`)
+			p.P(`}`)
+			p.P(`}`)
+		} else {
+			p.P(`nv, err := default_Object_`, ft, `(ctx, vv, vvPath)`)
+			p.P(`if err != nil {`)
+			p.P(`return nil, err`)
+			p.P(`}`)
+			p.P(`if !`, bytesPkg.Use(), `.Equal(nv, vv) {`)
+			p.P(`v[`, key, `], changed = nv, true`)
+			p.P(`}`)
+		}
+		p.P(`}`)
+	}
+
+	p.P()
+	p.P(`if !changed {`)
+	p.P(`return r, nil`)
+	p.P(`}`)
+	p.P(`return `, jsonPkg.Use(), `.Marshal(v)`)
+	p.P(`}`)
+	p.P()
+}
@@ -0,0 +1,107 @@
+package plugin
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+
+	av_opts "github.com/infobloxopen/protoc-gen-atlas-validate/options"
+)
+
+// getDiscriminator function returns discriminator field and required_for_type
+// option of a message or nil if the message has no discriminator.
+func (p *Plugin) getDiscriminator(o *descriptor.DescriptorProto, t string) (*descriptor.FieldDescriptorProto, []*av_opts.AtlasValidateRequiredForType) {
+	mavOpt := p.messageOption(o.Options)
+	name, types := mavOpt.GetDiscriminator(), mavOpt.GetRequiredForType()
+	if name == "" {
+		if len(types) != 0 {
+			p.Fail(`required_for_type option of message "`, t, `" requires discriminator option`)
+		}
+		return nil, nil
+	}
+
+	fields := make(map[string]*descriptor.FieldDescriptorProto)
+	for _, f := range o.GetField() {
+		fields[f.GetName()] = f
+	}
+
+	df, ok := fields[name]
+	if !ok {
+		p.Fail(`discriminator option of message "`, t, `" refers to unknown field `, name)
+	}
+	if df.IsRepeated() || (df.GetType() != descriptor.FieldDescriptorProto_TYPE_STRING && df.GetType() != descriptor.FieldDescriptorProto_TYPE_ENUM) {
+		p.Fail(`discriminator option of message "`, t, `" must refer to a singular string or enum field, field `, name, ` is not`)
+	}
+
+	for i, rt := range types {
+		for _, other := range types[:i] {
+			if other.GetType() == rt.GetType() {
+				p.Fail(`required_for_type option of message "`, t, `" contains duplicate type `, rt.GetType())
+			}
+		}
+		for _, fn := range rt.GetFields() {
+			if _, ok := fields[fn]; !ok {
+				p.Fail(`required_for_type option of message "`, t, `" refers to unknown field `, fn)
+			}
+		}
+	}
+
+	return df, types
+}
+
+// renderRequiredForType function generates checks of fields required for a value
+// of the discriminator field within validate_required_Object_ function.
+func (p *Plugin) renderRequiredForType(o *descriptor.DescriptorProto, t string) {
+
+	df, types := p.getDiscriminator(o, t)
+	if df == nil || len(types) == 0 {
+		return
+	}
+
+	var (
+		fmtPkg     = p.Import(fmtPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	p.P(`switch `, runtimePkg.Use(), `.DiscriminatorValue(v["`, df.GetName(), `"]) {`)
+	for _, rt := range types {
+		if len(rt.GetFields()) == 0 {
+			continue
+		}
+		p.P(`case "`, rt.GetType(), `":`)
+		for _, fn := range rt.GetFields() {
+			var fd *descriptor.FieldDescriptorProto
+			for _, f := range o.GetField() {
+				if f.GetName() == fn {
+					fd = f
+				}
+			}
+			absent := `!ok`
+			if p.implicitPresence(o, fd) {
+				absent += ` && !marshaled`
+			}
+			p.P(`if _, ok := v["`, fn, `"]; `, p.ruleGuard(o, fd, "required_for_type"), absent, ` {`)
+			p.renderObjectError(p.withCode("CodeRequired", p.joinPath()+`(path, "`+fn+`")`, fmtPkg.Use(), `.Errorf("field %q is required for type %q at %q", "`, fn, `", "`, rt.GetType(), `", path)`)...)
+			p.P(`}`)
+		}
+	}
+	p.P(`}`)
+}
+
+// renderRequiredIf function generates a check of a field with required_if option
+// within validate_required_Object_ function: the field must be present if a
+// referenced field is, only for methods of required option if it is set as well.
+func (p *Plugin) renderRequiredIf(fn, ref, presence string, methods []string) {
+
+	fmtPkg := p.Import(fmtPkgPath)
+
+	if len(methods) != 0 {
+		presence += ` && (method == "` + strings.Join(methods, `" || method == "`) + `")`
+	}
+
+	p.P(`if _, ok := v["`, ref, `"]; ok {`)
+	p.P(`if `, presence, ` {`)
+	p.renderObjectError(p.withCode("CodeRequired", p.joinPath()+`(path, "`+fn+`")`, fmtPkg.Use(), `.Errorf("field %q is required when %q is set", `, p.joinPath(), `(path, "`, fn, `"), `, p.joinPath(), `(path, "`, ref, `"))`)...)
+	p.P(`}`)
+	p.P(`}`)
+}
@@ -0,0 +1,225 @@
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// enumDescriptor structure represents an enum of the request together with
+// a flag that indicates whether the enum belongs to a package being generated,
+// a name the enum is registered with by gogo and golang/protobuf generated Go
+// code of its package, e.g. "external.ExternalUser_Role" for
+// external.ExternalUser.Role, and its full name protoregistry resolves.
+type enumDescriptor struct {
+	*descriptor.EnumDescriptorProto
+	local          bool
+	registeredName string
+	fullName       string
+}
+
+// indexEnums function adds enums of package pkg declared within prefix into p.enums.
+func (p *Plugin) indexEnums(pkg string, prefix string, enums []*descriptor.EnumDescriptorProto, local bool) {
+	for _, e := range enums {
+		name := prefix + "." + e.GetName()
+		registeredName := strings.Replace(strings.TrimPrefix(name, "."+pkg+"."), ".", "_", -1)
+		if pkg != "" {
+			registeredName = pkg + "." + registeredName
+		}
+		p.enums[name] = &enumDescriptor{EnumDescriptorProto: e, local: local, registeredName: registeredName, fullName: strings.TrimPrefix(name, ".")}
+	}
+}
+
+// externalEnum function returns an enum of another package of a field or nil if
+// the field is not an enum or the enum is local.
+func (p *Plugin) externalEnum(f *descriptor.FieldDescriptorProto) *enumDescriptor {
+	if !f.IsEnum() {
+		return nil
+	}
+
+	if e, ok := p.enums[f.GetTypeName()]; ok && !e.local {
+		return e
+	}
+
+	return nil
+}
+
+// enumCheck function returns an expression that validates a JSON value r at path
+// against an enum of a field: a generated runtime.Enum for local enums or the
+// registry of github.com/golang/protobuf/proto for enums of other packages,
+// protoregistry of google.golang.org/protobuf with runtime=protobuf-go parameter.
+func (p *Plugin) enumCheck(f *descriptor.FieldDescriptorProto, r string, path string) string {
+	runtimePkg := p.Import(runtimePkgPath)
+	if e := p.externalEnum(f); e != nil {
+		if p.protobufGo {
+			return p.Import(protobufGoPkgPath).Use() + `.ValidateRegisteredEnum(` + r + `, ` + path + `, "` + e.fullName + `")`
+		}
+		return runtimePkg.Use() + `.ValidateRegisteredEnum(` + r + `, ` + path + `, "` + e.registeredName + `")`
+	}
+
+	return runtimePkg.Use() + `.ValidateEnum(` + r + `, ` + path + `, ` + p.enumVarName(f) + `)`
+}
+
+// localEnum function returns a local enum of a field or nil if the field is not
+// an enum or the enum belongs to another package.
+func (p *Plugin) localEnum(f *descriptor.FieldDescriptorProto) *enumDescriptor {
+	if !f.IsEnum() {
+		return nil
+	}
+
+	if e, ok := p.enums[f.GetTypeName()]; ok && e.local {
+		return e
+	}
+
+	return nil
+}
+
+// allowPrefixVariants function reports whether an enum accepts variants of
+// declared names.
+func (p *Plugin) allowPrefixVariants(e *enumDescriptor) bool {
+	return p.enumOption(e.Options).GetAllowPrefixVariants()
+}
+
+// variantEnum function returns a local enum that accepts variants of declared
+// names for an enum field or a map field with enum values, nil otherwise.
+func (p *Plugin) variantEnum(f *descriptor.FieldDescriptorProto) *enumDescriptor {
+	if p.isMapField(f) {
+		_, f = p.mapEntryFields(f)
+	}
+
+	if e := p.localEnum(f); e != nil && p.allowPrefixVariants(e) {
+		return e
+	}
+
+	return nil
+}
+
+// enumPrefix function returns the common prefix of enum value names up to and
+// including the last "_" (e.g. "STATUS_" for STATUS_ACTIVE and STATUS_INACTIVE).
+// If the names have no common prefix the upper snake case enum name is used.
+func enumPrefix(e *descriptor.EnumDescriptorProto) string {
+	var prefix string
+	for i, v := range e.GetValue() {
+		if i == 0 {
+			prefix = v.GetName()
+			continue
+		}
+		for !strings.HasPrefix(v.GetName(), prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	if i := strings.LastIndex(prefix, "_"); i > 0 {
+		return prefix[:i+1]
+	}
+
+	var name []rune
+	for i, r := range e.GetName() {
+		if i > 0 && unicode.IsUpper(r) {
+			name = append(name, '_')
+		}
+		name = append(name, unicode.ToUpper(r))
+	}
+
+	return string(name) + "_"
+}
+
+// enumVarName function returns a name of runtime.Enum variable of a local enum.
+func (p *Plugin) enumVarName(f *descriptor.FieldDescriptorProto) string {
+	return `validate_Enum_` + p.TypeName(p.ObjectNamed(f.GetTypeName()))
+}
+
+// renderEnums function generates runtime.Enum variables for enums declared in
+// a file being generated.
+func (p *Plugin) renderEnums() {
+
+	runtimePkg := p.Import(runtimePkgPath)
+
+	var names []string
+	for n, e := range p.enums {
+		if e.local && p.isFileEnum(e.EnumDescriptorProto) {
+			names = append(names, n)
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, n := range names {
+		e := p.enums[n]
+		t := p.TypeName(p.ObjectNamed(n))
+
+		var values []string
+		for _, v := range e.GetValue() {
+			values = append(values, fmt.Sprintf("%q: %d", v.GetName(), v.GetNumber()))
+		}
+
+		p.P(`var validate_Enum_`, t, ` = &`, runtimePkg.Use(), `.Enum{`)
+		p.P(`Name: "`, e.GetName(), `",`)
+		p.P(`Names: map[string]int32{`, strings.Join(values, ", "), `},`)
+		p.P(`Prefix: "`, enumPrefix(e.EnumDescriptorProto), `",`)
+		p.P(`Variants: `, p.allowPrefixVariants(e), `,`)
+		p.P(`}`)
+		p.P()
+	}
+}
+
+// isFileEnum function reports whether an enum is declared in a file being generated.
+func (p *Plugin) isFileEnum(e *descriptor.EnumDescriptorProto) bool {
+	for _, fe := range p.file.GetEnumType() {
+		if fe == e {
+			return true
+		}
+	}
+
+	var walk func(msgs []*descriptor.DescriptorProto) bool
+	walk = func(msgs []*descriptor.DescriptorProto) bool {
+		for _, m := range msgs {
+			for _, me := range m.GetEnumType() {
+				if me == e {
+					return true
+				}
+			}
+			if walk(m.GetNestedType()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return walk(p.file.GetMessageType())
+}
+
+// renderEnumField function generates validation of an enum field within
+// validate_Object_ function.
+func (p *Plugin) renderEnumField(o *descriptor.DescriptorProto, f *descriptor.FieldDescriptorProto) {
+
+	var (
+		jsonPkg = p.Import(jsonPkgPath)
+		fmtPkg  = p.Import(fmtPkgPath)
+	)
+
+	if !f.IsRepeated() {
+		p.P(`if err = `, p.enumCheck(f, `v[k]`, p.joinPath()+`(path, k)`), `; err != nil {`)
+		p.renderFieldError(`err`)
+		p.P(`}`)
+		return
+	}
+
+	p.P(`if v[k] == nil || string(v[k]) == "null" {`)
+	p.P(`continue`)
+	p.P(`}`)
+	p.P(`var vArr []`, jsonPkg.Use(), `.RawMessage`)
+	p.P(`vArrPath := `, p.joinPath(), `(path, k)`)
+	p.P(`if err = `, jsonPkg.Use(), `.Unmarshal(v[k], &vArr); err != nil {`)
+	p.renderCodedFieldError("CodeTypeMismatch", `vArrPath`, fmtPkg.Use(), `.Errorf("invalid value for %q: expected array.", vArrPath)`)
+	p.P(`}`)
+	p.renderArrayChecks(o, f)
+	p.P(`for i, vv := range vArr {`)
+	p.P(`if err = `, p.enumCheck(f, `vv`, p.joinIndex()+`(vArrPath, i)`), `; err != nil {`)
+	p.renderFieldError(`err`)
+	p.P(`}`)
+	p.P(`}`)
+}
@@ -0,0 +1,58 @@
+package plugin
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// getMutuallyExclusive function returns fields of each group of mutually_exclusive
+// option of a message, a group must list at least two fields of the message.
+func (p *Plugin) getMutuallyExclusive(o *descriptor.DescriptorProto, t string) [][]*descriptor.FieldDescriptorProto {
+	var groups [][]*descriptor.FieldDescriptorProto
+	for _, g := range p.messageOption(o.Options).GetMutuallyExclusive() {
+		if len(g.GetFields()) < 2 {
+			p.Fail(`mutually_exclusive option of message "`, t, `" contains a group with less than two fields`)
+		}
+
+		var group []*descriptor.FieldDescriptorProto
+		for _, fn := range g.GetFields() {
+			var fd *descriptor.FieldDescriptorProto
+			for _, f := range o.GetField() {
+				if f.GetName() == fn {
+					fd = f
+				}
+			}
+			if fd == nil {
+				p.Fail(`mutually_exclusive option of message "`, t, `" refers to unknown field `, fn)
+			}
+			group = append(group, fd)
+		}
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// renderMutuallyExclusive function generates checks that at most one field of each
+// mutually_exclusive group of a message is set within validate_Object_ function,
+// an error lists paths of the fields, e.g. `fields [phone email] are mutually exclusive`.
+func (p *Plugin) renderMutuallyExclusive(o *descriptor.DescriptorProto, t string) {
+
+	var (
+		fmtPkg     = p.Import(fmtPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	for _, group := range p.getMutuallyExclusive(o, t) {
+		var names, paths []string
+		for _, f := range group {
+			names = append(names, `"`+f.GetName()+`"`)
+			paths = append(paths, p.joinPath()+`(path, "`+f.GetName()+`")`)
+		}
+
+		p.P(`if `, p.ruleGuard(o, group[0], "mutually_exclusive"), runtimePkg.Use(), `.CountSet(v, `, strings.Join(names, ", "), `) > 1 {`)
+		p.renderObjectError(fmtPkg.Use(), `.Errorf("fields %v are mutually exclusive", []string{`, strings.Join(paths, ", "), `})`)
+		p.P(`}`)
+	}
+}
@@ -0,0 +1,204 @@
+package plugin
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// getFormat function returns format option of a string field or empty string if
+// the option is not specified.
+func (p *Plugin) getFormat(f *descriptor.FieldDescriptorProto) string {
+	name := p.getFieldOption(f).GetFormat()
+	if name == "" {
+		return ""
+	}
+
+	if f.GetType() != descriptor.FieldDescriptorProto_TYPE_STRING {
+		p.Fail(`format option is allowed only for string fields, field `, f.GetName(), ` is `, f.GetType().String())
+	}
+
+	return name
+}
+
+// getInSet function returns in_set option of a string field or empty string if
+// the option is not specified.
+func (p *Plugin) getInSet(f *descriptor.FieldDescriptorProto) string {
+	name := p.getFieldOption(f).GetInSet()
+	if name == "" {
+		return ""
+	}
+
+	if f.GetType() != descriptor.FieldDescriptorProto_TYPE_STRING {
+		p.Fail(`in_set option is allowed only for string fields, field `, f.GetName(), ` is `, f.GetType().String())
+	}
+
+	return name
+}
+
+// getPattern function returns pattern option of a string field or empty string if
+// the option is not specified, the pattern must be a valid regular expression.
+func (p *Plugin) getPattern(f *descriptor.FieldDescriptorProto) string {
+	expr := p.getFieldOption(f).GetPattern()
+	if expr == "" {
+		return ""
+	}
+
+	if f.GetType() != descriptor.FieldDescriptorProto_TYPE_STRING {
+		p.Fail(`pattern option is allowed only for string fields, field `, f.GetName(), ` is `, f.GetType().String())
+	}
+	if _, err := regexp.Compile(expr); err != nil {
+		p.Fail(`invalid pattern option of field `, f.GetName(), `: `, err.Error())
+	}
+
+	return expr
+}
+
+// getKeyPattern function returns key_pattern option of a string-keyed map field
+// or empty string if the option is not specified, the pattern must be a valid
+// regular expression.
+func (p *Plugin) getKeyPattern(f *descriptor.FieldDescriptorProto) string {
+	expr := p.getFieldOption(f).GetKeyPattern()
+	if expr == "" {
+		return ""
+	}
+
+	if !p.IsMap(f) {
+		p.Fail(`key_pattern option is allowed only for map fields, field `, f.GetName(), ` is not`)
+	}
+	if kf, _ := p.mapEntryFields(f); kf.GetType() != descriptor.FieldDescriptorProto_TYPE_STRING {
+		p.Fail(`key_pattern option is allowed only for string-keyed map fields, keys of field `, f.GetName(), ` are `, kf.GetType().String())
+	}
+	if _, err := regexp.Compile(expr); err != nil {
+		p.Fail(`invalid key_pattern option of field `, f.GetName(), `: `, err.Error())
+	}
+
+	return expr
+}
+
+// patternVar function returns a name of a package-level variable that holds a
+// compiled pattern option of a field, e.g. regexp_examplepb_Profile_email.
+func (p *Plugin) patternVar(o *descriptor.DescriptorProto, f *descriptor.FieldDescriptorProto) string {
+	return "regexp_" + strings.Replace(p.messageNames[o], ".", "_", -1) + "_" + f.GetName()
+}
+
+// keyPatternVar function returns a name of a package-level variable that holds a
+// compiled key_pattern option of a map field, e.g. regexp_examplepb_Policy_Rule_labels_key.
+func (p *Plugin) keyPatternVar(o *descriptor.DescriptorProto, f *descriptor.FieldDescriptorProto) string {
+	return p.patternVar(o, f) + "_key"
+}
+
+// renderPatterns function generates package-level regular expressions of pattern
+// and key_pattern options of fields of a message, so that they are compiled once.
+func (p *Plugin) renderPatterns(o *descriptor.DescriptorProto) {
+
+	regexpPkg := p.Import(regexpPkgPath)
+
+	var rendered bool
+	for _, f := range o.GetField() {
+		if expr := p.getPattern(f); expr != "" {
+			p.P(`var `, p.patternVar(o, f), ` = `, regexpPkg.Use(), `.MustCompile(`, fmt.Sprintf("%q", expr), `)`)
+			rendered = true
+		}
+		if expr := p.getKeyPattern(f); expr != "" {
+			p.P(`var `, p.keyPatternVar(o, f), ` = `, regexpPkg.Use(), `.MustCompile(`, fmt.Sprintf("%q", expr), `)`)
+			rendered = true
+		}
+	}
+	if rendered {
+		p.P()
+	}
+}
+
+// getMaxLength function returns max_length option of a string or bytes field or
+// zero if the option is not specified.
+func (p *Plugin) getMaxLength(f *descriptor.FieldDescriptorProto) uint32 {
+	n := p.getFieldOption(f).GetMaxLength()
+	if n == 0 {
+		return 0
+	}
+
+	if f.GetType() != descriptor.FieldDescriptorProto_TYPE_STRING && f.GetType() != descriptor.FieldDescriptorProto_TYPE_BYTES {
+		p.Fail(`max_length option is allowed only for string and bytes fields, field `, f.GetName(), ` is `, f.GetType().String())
+	}
+
+	return n
+}
+
+// renderStringField function generates validation of a string or bytes field (or
+// each element of a repeated one) against its type and its format, in_set, pattern
+// and max_length options within validate_Object_ function, it returns false if the
+// field has none of the options.
+func (p *Plugin) renderStringField(o *descriptor.DescriptorProto, f *descriptor.FieldDescriptorProto) bool {
+
+	var (
+		jsonPkg    = p.Import(jsonPkgPath)
+		fmtPkg     = p.Import(fmtPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	var checks []func(value, path string)
+
+	if name := p.getFormat(f); name != "" {
+		checks = append(checks, func(value, path string) {
+			p.P(`if err = `, runtimePkg.Use(), `.ValidateFormat(`, value, `, `, path, `, "`, name, `"); `, p.ruleGuard(o, f, "format"), `err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		})
+	}
+
+	if name := p.getInSet(f); name != "" {
+		checks = append(checks, func(value, path string) {
+			p.P(`if err = `, runtimePkg.Use(), `.ValidateInSet(`, value, `, `, path, `, "`, name, `"); `, p.ruleGuard(o, f, "in_set"), `err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		})
+	}
+
+	if expr := p.getPattern(f); expr != "" {
+		checks = append(checks, func(value, path string) {
+			p.P(`if err = `, runtimePkg.Use(), `.ValidatePattern(`, value, `, `, path, `, `, p.patternVar(o, f), `); `, p.ruleGuard(o, f, "pattern"), `err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		})
+	}
+
+	if n := p.getMaxLength(f); n != 0 {
+		checks = append(checks, func(value, path string) {
+			p.P(`if err = `, runtimePkg.Use(), `.ValidateMaxLength(`, value, `, `, path, `, `, int(n), `, "`, p.scalarKind(f), `"); `, p.ruleGuard(o, f, "max_length"), `err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		})
+	}
+
+	if len(checks) == 0 {
+		return false
+	}
+	checks = append(p.typeChecks(f), checks...)
+
+	if !f.IsRepeated() {
+		for _, check := range checks {
+			check(`v[k]`, p.joinPath()+`(path, k)`)
+		}
+		return true
+	}
+
+	p.P(`if v[k] == nil || string(v[k]) == "null" {`)
+	p.P(`continue`)
+	p.P(`}`)
+	p.P(`var vArr []`, jsonPkg.Use(), `.RawMessage`)
+	p.P(`vArrPath := `, p.joinPath(), `(path, k)`)
+	p.P(`if err = `, jsonPkg.Use(), `.Unmarshal(v[k], &vArr); err != nil {`)
+	p.renderCodedFieldError("CodeTypeMismatch", `vArrPath`, fmtPkg.Use(), `.Errorf("invalid value for %q: expected array.", vArrPath)`)
+	p.P(`}`)
+	p.renderArrayChecks(o, f)
+	p.P(`for i, vv := range vArr {`)
+	for _, check := range checks {
+		check(`vv`, p.joinIndex()+`(vArrPath, i)`)
+	}
+	p.P(`}`)
+
+	return true
+}
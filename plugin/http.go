@@ -1,6 +1,8 @@
 package plugin
 
 import (
+	"sort"
+
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 
@@ -10,6 +12,8 @@ import (
 type httpOpt struct {
 	body   string
 	method string
+	// custom is set for a rule with custom HTTP method.
+	custom bool
 }
 
 func getHttpMethod(r *http_opts.HttpRule) string {
@@ -24,6 +28,8 @@ func getHttpMethod(r *http_opts.HttpRule) string {
 		return "DELETE"
 	case *http_opts.HttpRule_Patch:
 		return "PATCH"
+	case *http_opts.HttpRule_Custom:
+		return r.GetCustom().GetKind()
 	}
 
 	return ""
@@ -45,11 +51,13 @@ func extractHTTPOpts(m *descriptor.MethodDescriptorProto) []httpOpt {
 		r = append(r, httpOpt{
 			body:   httpRule.Body,
 			method: getHttpMethod(httpRule),
+			custom: httpRule.GetCustom() != nil,
 		})
 		for _, b := range httpRule.GetAdditionalBindings() {
 			r = append(r, httpOpt{
 				body:   b.Body,
 				method: getHttpMethod(b),
+				custom: b.GetCustom() != nil,
 			})
 		}
 	} else {
@@ -58,3 +66,23 @@ func extractHTTPOpts(m *descriptor.MethodDescriptorProto) []httpOpt {
 
 	return r
 }
+
+// indexCustomMethods function collects sorted HTTP methods (e.g. "SEARCH") of custom
+// google.api.http rules of methods of every file of a request.
+func (p *Plugin) indexCustomMethods() {
+	seen := make(map[string]bool)
+	for _, f := range p.Generator.Request.ProtoFile {
+		for _, svc := range f.GetService() {
+			for _, method := range svc.GetMethod() {
+				for _, opt := range extractHTTPOpts(method) {
+					if opt.custom && opt.method != "" && !seen[opt.method] {
+						seen[opt.method] = true
+						p.customMethods = append(p.customMethods, opt.method)
+					}
+				}
+			}
+		}
+	}
+
+	sort.Strings(p.customMethods)
+}
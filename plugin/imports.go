@@ -3,22 +3,35 @@ package plugin
 import (
 	"fmt"
 	"path"
+	"strings"
 
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
 )
 
 const (
-	bytesPkgPath  = "bytes"
-	ctxPkgPath    = "context"
-	fmtPkgPath    = "fmt"
-	httpPkgPath   = "net/http"
-	ioutilPkgPath = "io/ioutil"
-	jsonPkgPath   = "encoding/json"
+	bytesPkgPath   = "bytes"
+	ctxPkgPath     = "context"
+	fmtPkgPath     = "fmt"
+	httpPkgPath    = "net/http"
+	ioPkgPath      = "io"
+	ioutilPkgPath  = "io/ioutil"
+	jsonPkgPath    = "encoding/json"
+	regexpPkgPath  = "regexp"
+	strconvPkgPath = "strconv"
+	timePkgPath    = "time"
+	urlPkgPath     = "net/url"
 
 	metadataPkgPath  = "google.golang.org/grpc/metadata"
+	grpcPkgPath      = "google.golang.org/grpc"
+	codesPkgPath     = "google.golang.org/grpc/codes"
+	statusPkgPath    = "google.golang.org/grpc/status"
 	gwruntimePkgPath = "github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	runtimePkgPath = "github.com/infobloxopen/protoc-gen-atlas-validate/runtime"
+	celPkgPath     = "github.com/infobloxopen/protoc-gen-atlas-validate/runtime/cel"
+
+	protobufGoPkgPath = "github.com/infobloxopen/protoc-gen-atlas-validate/runtime/protobufgo"
 )
 
 var wkt = map[string]bool{
@@ -28,6 +41,9 @@ var wkt = map[string]bool{
 	".google.protobuf.Empty":     true,
 	".google.protobuf.Any":       true,
 	".google.protobuf.Struct":    true,
+	".google.protobuf.Value":     true,
+	".google.protobuf.ListValue": true,
+	".google.protobuf.FieldMask": true,
 
 	// nillable values
 	".google.protobuf.StringValue": true,
@@ -54,15 +70,25 @@ func (p *Plugin) initPluginImports(g *generator.Generator) {
 		ctxPkgPath,
 		fmtPkgPath,
 		httpPkgPath,
+		ioPkgPath,
 		ioutilPkgPath,
 		jsonPkgPath,
+		regexpPkgPath,
+		strconvPkgPath,
+		timePkgPath,
+		urlPkgPath,
 
 		// external packages
 		metadataPkgPath,
+		grpcPkgPath,
+		codesPkgPath,
+		statusPkgPath,
 		gwruntimePkgPath,
 
 		// local packages
 		runtimePkgPath,
+		celPkgPath,
+		protobufGoPkgPath,
 	} {
 		pi.AddImport(v)
 	}
@@ -188,3 +214,56 @@ func (p *Plugin) objectNamed(name string) generator.Object {
 
 	return obj
 }
+
+// messageDescriptor structure represents a message of the request together with
+// flags that indicate whether the message belongs to a package being generated
+// and whether it is declared in a proto3 file.
+type messageDescriptor struct {
+	*descriptor.DescriptorProto
+	local  bool
+	proto3 bool
+}
+
+// indexMessages function collects all messages (including nested ones) of the
+// request into p.messages.
+func (p *Plugin) indexMessages() {
+	p.messages = make(map[string]*messageDescriptor)
+	p.enums = make(map[string]*enumDescriptor)
+	p.messageNames = make(map[*descriptor.DescriptorProto]string)
+
+	pkgs := make(map[string]bool)
+	for _, f := range p.Request.ProtoFile {
+		for _, fg := range p.Request.FileToGenerate {
+			if f.GetName() == fg {
+				pkgs[f.GetPackage()] = true
+			}
+		}
+	}
+
+	var walk func(f *descriptor.FileDescriptorProto, prefix string, msgs []*descriptor.DescriptorProto, local bool)
+	walk = func(f *descriptor.FileDescriptorProto, prefix string, msgs []*descriptor.DescriptorProto, local bool) {
+		for _, m := range msgs {
+			name := prefix + "." + m.GetName()
+			p.messages[name] = &messageDescriptor{DescriptorProto: m, local: local, proto3: f.GetSyntax() == "proto3"}
+			p.messageNames[m] = strings.TrimPrefix(name, ".")
+			p.indexEnums(f.GetPackage(), name, m.GetEnumType(), local)
+			walk(f, name, m.GetNestedType(), local)
+		}
+	}
+
+	for _, f := range p.Request.ProtoFile {
+		prefix := ""
+		if f.GetPackage() != "" {
+			prefix = "." + f.GetPackage()
+		}
+		p.indexEnums(f.GetPackage(), prefix, f.GetEnumType(), pkgs[f.GetPackage()])
+		walk(f, prefix, f.GetMessageType(), pkgs[f.GetPackage()])
+	}
+}
+
+// isMapField function is an equivalent of generator.IsMap that does not depend on
+// a file being generated.
+func (p *Plugin) isMapField(f *descriptor.FieldDescriptorProto) bool {
+	m, ok := p.messages[f.GetTypeName()]
+	return f.IsRepeated() && ok && m.GetOptions().GetMapEntry()
+}
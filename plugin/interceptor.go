@@ -0,0 +1,77 @@
+package plugin
+
+import "sort"
+
+// renderInterceptor function generates AtlasValidateInterceptor function that
+// validates requests of gRPC methods with validators of their HTTP bindings, the
+// first binding of a method is used.
+func (p *Plugin) renderInterceptor() {
+
+	var (
+		jsonPkg    = p.Import(jsonPkgPath)
+		ctxPkg     = p.Import(ctxPkgPath)
+		grpcPkg    = p.Import(grpcPkgPath)
+		codesPkg   = p.Import(codesPkgPath)
+		statusPkg  = p.Import(statusPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	marshalPkg := runtimePkg
+	if p.protobufGo {
+		marshalPkg = p.Import(protobufGoPkgPath)
+	}
+
+	var files []string
+	for f := range p.methods {
+		files = append(files, f)
+	}
+
+	sort.StringSlice(files).Sort()
+
+	p.P(`var validate_Methods = map[string]struct{`)
+	p.P(`httpMethod string`)
+	p.P(`httpBody string`)
+	p.P(`bodyArray bool`)
+	p.P(`allowUnknown bool`)
+	p.P(`validator func(`, ctxPkg.Use(), `.Context, `, jsonPkg.Use(), `.RawMessage) error`)
+	p.P(`} {`)
+	seen := make(map[string]bool)
+	for _, f := range files {
+		for _, m := range p.methods[f] {
+			if seen[m.fullMethod] {
+				continue
+			}
+			seen[m.fullMethod] = true
+			p.P(`"`, m.fullMethod, `": {httpMethod: "`, m.httpMethod, `", httpBody: "`, m.httpBody, `", bodyArray: `, m.bodyArray, `, allowUnknown: `, m.allowUnknown, `, validator: validate_`, m.gwPattern, `},`)
+		}
+	}
+	p.P(`}`)
+	p.P()
+
+	p.P(`// AtlasValidateInterceptor returns a gRPC server interceptor that validates a request`)
+	p.P(`// of a method bound to HTTP the same way AtlasValidateAnnotator validates its JSON body,`)
+	p.P(`// the request is marshaled to JSON and checked as a body of the first binding.`)
+	p.P(`func AtlasValidateInterceptor() `, grpcPkg.Use(), `.UnaryServerInterceptor {`)
+	p.P(`return func(ctx `, ctxPkg.Use(), `.Context, req interface{}, info *`, grpcPkg.Use(), `.UnaryServerInfo, handler `, grpcPkg.Use(), `.UnaryHandler) (interface{}, error) {`)
+	p.P(`v, ok := validate_Methods[info.FullMethod]`)
+	p.P(`if !ok {`)
+	p.P(`return handler(ctx, req)`)
+	p.P(`}`)
+	p.P(`b, err := `, marshalPkg.Use(), `.MarshalRequestBody(req, v.httpBody)`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, `, statusPkg.Use(), `.Error(`, codesPkg.Use(), `.InvalidArgument, err.Error())`)
+	p.P(`}`)
+	p.P(`if v.bodyArray && string(b) == "{}" {`)
+	p.P(`// an empty repeated field is marshaled as absent`)
+	p.P(`b = `, jsonPkg.Use(), `.RawMessage("[]")`)
+	p.P(`}`)
+	p.P(`vctx := `, ctxPkg.Use(), `.WithValue(`, ctxPkg.Use(), `.WithValue(ctx, `, runtimePkg.Use(), `.HTTPMethodContextKey, v.httpMethod), `, runtimePkg.Use(), `.AllowUnknownContextKey, v.allowUnknown)`)
+	p.P(`vctx = `, ctxPkg.Use(), `.WithValue(vctx, `, runtimePkg.Use(), `.MarshaledContextKey, true)`)
+	p.P(`if err = v.validator(vctx, b); err != nil {`)
+	p.P(`return nil, `, statusPkg.Use(), `.Error(`, codesPkg.Use(), `.InvalidArgument, `, p.errorMessage(), `)`)
+	p.P(`}`)
+	p.P(`return handler(ctx, req)`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P()
+}
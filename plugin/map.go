@@ -0,0 +1,126 @@
+package plugin
+
+import (
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// mapEntryFields function returns key and value fields of a map entry message
+// of a map field.
+func (p *Plugin) mapEntryFields(f *descriptor.FieldDescriptorProto) (key, value *descriptor.FieldDescriptorProto) {
+	for _, ef := range p.messages[f.GetTypeName()].GetField() {
+		switch ef.GetNumber() {
+		case 1:
+			key = ef
+		case 2:
+			value = ef
+		}
+	}
+
+	return key, value
+}
+
+// mapValueField function returns a value field of a map entry of a map field f.
+func (p *Plugin) mapValueField(f *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	_, value := p.mapEntryFields(f)
+	return value
+}
+
+// renderMapField function generates validation of a map field within validate_Object_
+// function: the value must be a JSON object, its keys must conform to the map key type
+// and key_pattern option and its values are validated as scalars, enums or objects with a path like "labels.env".
+func (p *Plugin) renderMapField(o *descriptor.DescriptorProto, f *descriptor.FieldDescriptorProto) {
+
+	var (
+		jsonPkg    = p.Import(jsonPkgPath)
+		fmtPkg     = p.Import(fmtPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	kf, vf := p.mapEntryFields(f)
+
+	keyKind := p.scalarKind(kf)
+	if keyKind == "string" {
+		keyKind = ""
+	}
+
+	keyPattern := p.getKeyPattern(f)
+
+	valueKind := p.scalarKind(vf)
+	valueObject := vf.IsMessage() && !p.isWKT(vf.GetTypeName())
+	valueEnum := p.localEnum(vf) != nil || p.externalEnum(vf) != nil
+
+	p.P(`if v[k] == nil || string(v[k]) == "null" {`)
+	p.P(`continue`)
+	p.P(`}`)
+	p.P(`var vMap map[string]`, jsonPkg.Use(), `.RawMessage`)
+	p.P(`vMapPath := `, p.joinPath(), `(path, k)`)
+	p.P(`if err = `, jsonPkg.Use(), `.Unmarshal(v[k], &vMap); err != nil {`)
+	p.renderCodedFieldError("CodeTypeMismatch", `vMapPath`, fmtPkg.Use(), `.Errorf("invalid value for %q: expected map.", vMapPath)`)
+	p.P(`}`)
+	if p.rejectDuplicateKeys {
+		p.P(`if err = `, runtimePkg.Use(), `.ValidateUniqueKeys(v[k], vMapPath, `, p.joinPath(), `); err != nil {`)
+		p.renderFieldError(`err`)
+		p.P(`}`)
+	}
+
+	if keyKind == "" && keyPattern == "" && valueKind == "" && !valueObject && !valueEnum {
+		return
+	}
+
+	var (
+		ft    string
+		local bool
+	)
+
+	if valueObject {
+		p.renderFieldAllowUnknown(f)
+		fo := p.objectNamed(vf.GetTypeName())
+		ft, local = p.TypeName(fo), p.isLocal(fo)
+		if !local {
+			p.P(`validator, ok := `, p.generateAtlasValidateJSONInterfaceSignature(ft))
+		}
+	}
+
+	p.renderRange(`kk`, `vv`, `vMap`)
+	p.P(`vvPath := `, p.joinPath(), `(vMapPath, kk)`)
+	if keyKind != "" {
+		p.P(`if err = `, runtimePkg.Use(), `.ValidateMapKey(kk, vvPath, "`, keyKind, `"); err != nil {`)
+		p.renderFieldError(`err`)
+		p.P(`}`)
+	}
+	if keyPattern != "" {
+		p.P(`if err = `, runtimePkg.Use(), `.ValidateMapKeyPattern(kk, vMapPath, `, p.keyPatternVar(o, f), `); `, p.ruleGuard(o, f, "key_pattern"), `err != nil {`)
+		p.renderFieldError(`err`)
+		p.P(`}`)
+	}
+
+	switch {
+	case valueKind != "":
+		p.P(`if err = `, runtimePkg.Use(), `.ValidateScalar(vv, vvPath, "`, valueKind, `"); err != nil {`)
+		p.renderCodedFieldError("CodeTypeMismatch", `vvPath`, `err`)
+		p.P(`}`)
+		if valueKind == "bytes" {
+			p.P(`if err = `, runtimePkg.Use(), `.ValidateBase64(vv, vvPath); err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		}
+	case valueEnum:
+		p.P(`if err = `, p.enumCheck(vf, `vv`, `vvPath`), `; err != nil {`)
+		p.renderFieldError(`err`)
+		p.P(`}`)
+	case valueObject && local:
+		p.P(`if err = validate_Object_`, ft, `(ctx, vv, vvPath); err != nil {`)
+		p.renderFieldError(`err`)
+		p.P(`}`)
+	case valueObject:
+		p.P(`if !ok {`)
+		p.P(`continue`)
+		p.P(`}`)
+		p.P(`if err = validator.AtlasValidateJSON(ctx, vv, vvPath); err != nil {`)
+		p.renderFieldError(`err`)
+		p.P(`}`)
+	default:
+		p.P(`_ = vv`)
+	}
+	p.P(`}`)
+}
@@ -0,0 +1,80 @@
+package plugin
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// proto3OptionalField is a number of proto3_optional field of FieldDescriptorProto,
+// the descriptor package predates it and keeps the field unrecognized.
+const proto3OptionalField = 17
+
+// isProto3Optional function reports whether a field is declared with proto3
+// optional keyword and thus is the only member of a synthetic oneof.
+func isProto3Optional(f *descriptor.FieldDescriptorProto) bool {
+	buf := proto.NewBuffer(f.XXX_unrecognized)
+	for {
+		key, err := buf.DecodeVarint()
+		if err != nil {
+			return false
+		}
+
+		var v uint64
+		switch key & 7 {
+		case proto.WireVarint:
+			v, err = buf.DecodeVarint()
+		case proto.WireFixed64:
+			_, err = buf.DecodeFixed64()
+		case proto.WireBytes:
+			_, err = buf.DecodeRawBytes(false)
+		case proto.WireFixed32:
+			_, err = buf.DecodeFixed32()
+		default:
+			return false
+		}
+		if err != nil {
+			return false
+		}
+
+		if key == proto3OptionalField<<3|proto.WireVarint {
+			return v != 0
+		}
+	}
+}
+
+// renderOneofs function generates checks that at most one member of each oneof
+// of a message is set within validate_Object_ function, an error refers to the
+// oneof by its name, e.g. `only one of "method" may be set`. Synthetic oneofs of
+// proto3 optional fields are skipped.
+func (p *Plugin) renderOneofs(o *descriptor.DescriptorProto) {
+
+	var (
+		fmtPkg     = p.Import(fmtPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	for i, od := range o.GetOneofDecl() {
+		var (
+			members   []string
+			synthetic bool
+		)
+		for _, f := range o.GetField() {
+			if f.OneofIndex != nil && int(f.GetOneofIndex()) == i {
+				members = append(members, `"`+f.GetName()+`"`)
+				synthetic = isProto3Optional(f)
+			}
+		}
+
+		// synthetic oneofs of proto3 optional fields only track presence
+		if synthetic || len(members) < 2 {
+			continue
+		}
+
+		p.P(`if `, runtimePkg.Use(), `.CountSet(v, `, strings.Join(members, ", "), `) > 1 {`)
+		p.renderObjectError(fmtPkg.Use(), `.Errorf("only one of %q may be set", `, p.joinPath(), `(path, "`, od.GetName(), `"))`)
+		p.P(`}`)
+		p.P()
+	}
+}
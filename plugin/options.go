@@ -0,0 +1,283 @@
+package plugin
+
+import (
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/descriptorpb"
+
+	av_opts "github.com/infobloxopen/protoc-gen-atlas-validate/options"
+)
+
+// optionReader is an adapter the plugin reads atlas_validate options of descriptors
+// with: gogoOptions by default and protobufGoOptions if the plugin is run with
+// runtime=protobuf-go parameter. Descriptors of a request are parsed by gogo in
+// either case, readers differ in how extensions of their options are decoded.
+type optionReader interface {
+	// extension returns a decoded value of ext set in opts, nil if it is not set.
+	extension(opts proto.Message, ext *proto.ExtensionDesc) interface{}
+
+	// encodedExtension returns encoded records (a key and a payload) of ext set
+	// in opts as they were received, nil if it is not set.
+	encodedExtension(opts proto.Message, ext *proto.ExtensionDesc) []byte
+}
+
+// gogoOptions structure reads extensions with github.com/gogo/protobuf/proto.
+type gogoOptions struct{}
+
+func (gogoOptions) extension(opts proto.Message, ext *proto.ExtensionDesc) interface{} {
+	if v, err := proto.GetExtension(opts, ext); err == nil {
+		return v
+	}
+
+	return nil
+}
+
+func (gogoOptions) encodedExtension(opts proto.Message, ext *proto.ExtensionDesc) []byte {
+	if reflect.ValueOf(opts).IsNil() {
+		return nil
+	}
+
+	exts := proto.GetUnsafeExtensionsMap(opts)
+	if _, ok := exts[ext.Field]; !ok {
+		return nil
+	}
+
+	enc, err := proto.GetRawExtension(exts, ext.Field)
+	if err != nil {
+		return nil
+	}
+
+	return enc
+}
+
+// protobufGoOptions structure reads extensions with google.golang.org/protobuf:
+// options are decoded into descriptorpb messages resolved with protoregistry by
+// name and extensions into types of the options package.
+type protobufGoOptions struct {
+	types   *protoregistry.Types
+	decoded map[proto.Message]protoreflect.Message
+}
+
+// newProtobufGoOptions function returns protobufGoOptions that resolve extensions
+// of the options package.
+func newProtobufGoOptions() (*protobufGoOptions, error) {
+	o := &protobufGoOptions{
+		types:   new(protoregistry.Types),
+		decoded: make(map[proto.Message]protoreflect.Message),
+	}
+
+	for _, ext := range []*proto.ExtensionDesc{av_opts.E_File, av_opts.E_Service, av_opts.E_Method, av_opts.E_Message, av_opts.E_Field, av_opts.E_Enum} {
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(proto.MessageName(ext.ExtendedType)))
+		if err != nil {
+			return nil, err
+		}
+
+		err = o.types.RegisterExtension(&protoimpl.ExtensionInfo{
+			ExtendedType:  mt.Zero().Interface().(protoiface.MessageV1),
+			ExtensionType: ext.ExtensionType,
+			Field:         ext.Field,
+			Name:          ext.Name,
+			Tag:           ext.Tag,
+			Filename:      ext.Filename,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+// decode function returns opts decoded into a descriptorpb message, nil if opts
+// is nil or can not be decoded.
+func (o *protobufGoOptions) decode(opts proto.Message) protoreflect.Message {
+	if m, ok := o.decoded[opts]; ok {
+		return m
+	}
+
+	var m protoreflect.Message
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(proto.MessageName(opts))); err == nil && !reflect.ValueOf(opts).IsNil() {
+		if b, err := proto.Marshal(opts); err == nil {
+			m = mt.New()
+			if err = (protov2.UnmarshalOptions{Resolver: o.types}).Unmarshal(b, m.Interface()); err != nil {
+				m = nil
+			}
+		}
+	}
+	o.decoded[opts] = m
+
+	return m
+}
+
+func (o *protobufGoOptions) extension(opts proto.Message, ext *proto.ExtensionDesc) interface{} {
+	m := o.decode(opts)
+	xt, err := o.types.FindExtensionByName(protoreflect.FullName(ext.Name))
+	if m == nil || err != nil || !protov2.HasExtension(m.Interface(), xt) {
+		return nil
+	}
+
+	return protov2.GetExtension(m.Interface(), xt)
+}
+
+func (o *protobufGoOptions) encodedExtension(opts proto.Message, ext *proto.ExtensionDesc) []byte {
+	if reflect.ValueOf(opts).IsNil() {
+		return nil
+	}
+
+	b, err := proto.Marshal(opts)
+	if err != nil {
+		return nil
+	}
+
+	var enc []byte
+	for len(b) > 0 {
+		num, _, n := protowire.ConsumeField(b)
+		if n < 0 {
+			return nil
+		}
+		if int32(num) == ext.Field {
+			enc = append(enc, b[:n]...)
+		}
+		b = b[n:]
+	}
+
+	return enc
+}
+
+// Functions below return nil if a descriptor has no option, getters of options
+// are nil-safe.
+
+// fileOption function returns atlas_validate.file option of file options.
+func (p *Plugin) fileOption(opts proto.Message) *av_opts.AtlasValidateFileOption {
+	v, _ := p.options.extension(opts, av_opts.E_File).(*av_opts.AtlasValidateFileOption)
+	return v
+}
+
+// serviceOption function returns atlas_validate.service option of service options.
+func (p *Plugin) serviceOption(opts proto.Message) *av_opts.AtlasValidateServiceOption {
+	v, _ := p.options.extension(opts, av_opts.E_Service).(*av_opts.AtlasValidateServiceOption)
+	return v
+}
+
+// methodOption function returns atlas_validate.method option of method options.
+func (p *Plugin) methodOption(opts proto.Message) *av_opts.AtlasValidateMethodOption {
+	v, _ := p.options.extension(opts, av_opts.E_Method).(*av_opts.AtlasValidateMethodOption)
+	return v
+}
+
+// messageOption function returns atlas_validate.message option of message options.
+func (p *Plugin) messageOption(opts proto.Message) *av_opts.AtlasValidateMessageOption {
+	v, _ := p.options.extension(opts, av_opts.E_Message).(*av_opts.AtlasValidateMessageOption)
+	return v
+}
+
+// fieldOption function returns atlas_validate.field option of field options.
+func (p *Plugin) fieldOption(opts proto.Message) *av_opts.AtlasValidateFieldOption {
+	v, _ := p.options.extension(opts, av_opts.E_Field).(*av_opts.AtlasValidateFieldOption)
+	return v
+}
+
+// enumOption function returns atlas_validate.enum option of enum options.
+func (p *Plugin) enumOption(opts proto.Message) *av_opts.AtlasValidateEnumOption {
+	v, _ := p.options.extension(opts, av_opts.E_Enum).(*av_opts.AtlasValidateEnumOption)
+	return v
+}
+
+// indexAllowUnknown function records options of files, services and methods that
+// set allow_unknown_fields. Options are proto3 and a decoded false is the same as
+// an unset field, so presence is read from encoded options, it must be called
+// before any of them is decoded. Compilers may drop false of an option message
+// they encode, e.g. protoc does for aggregate values, it is also looked up in
+// source locations of options set with a dotted name.
+func (p *Plugin) indexAllowUnknown() {
+	p.allowUnknownSet = make(map[proto.Message]bool)
+	index := func(opts proto.Message, ext *proto.ExtensionDesc) {
+		rawFields(p.options.encodedExtension(opts, ext), func(num uint64, payload []byte) {
+			if num != uint64(ext.Field) {
+				return
+			}
+			rawFields(payload, func(num uint64, _ []byte) {
+				if num == 1 {
+					p.allowUnknownSet[opts] = true
+				}
+			})
+		})
+	}
+
+	for _, f := range p.Generator.Request.ProtoFile {
+		index(f.Options, av_opts.E_File)
+		for _, svc := range f.GetService() {
+			index(svc.Options, av_opts.E_Service)
+			for _, method := range svc.GetMethod() {
+				index(method.Options, av_opts.E_Method)
+			}
+		}
+
+		// paths of file (8), service (6, i, 3) and method (6, i, 2, j, 4) options
+		// followed by the extension and allow_unknown_fields (1)
+		for _, loc := range f.GetSourceCodeInfo().GetLocation() {
+			path := loc.GetPath()
+			if len(path) < 3 || path[len(path)-2] != av_opts.E_File.Field || path[len(path)-1] != 1 {
+				continue
+			}
+			switch path = path[:len(path)-2]; {
+			case len(path) == 1 && path[0] == 8 && f.Options != nil:
+				p.allowUnknownSet[f.Options] = true
+			case len(path) == 3 && path[0] == 6 && path[2] == 3 && int(path[1]) < len(f.Service):
+				if opts := f.Service[path[1]].Options; opts != nil {
+					p.allowUnknownSet[opts] = true
+				}
+			case len(path) == 5 && path[0] == 6 && path[2] == 2 && path[4] == 4 && int(path[1]) < len(f.Service):
+				if methods := f.Service[path[1]].Method; int(path[3]) < len(methods) && methods[path[3]].Options != nil {
+					p.allowUnknownSet[methods[path[3]].Options] = true
+				}
+			}
+		}
+	}
+}
+
+// rawFields function calls fn with the number and the payload of each field
+// encoded in b, a payload of a length-delimited field excludes its length.
+// Malformed input stops the iteration.
+func rawFields(b []byte, fn func(num uint64, payload []byte)) {
+	for len(b) > 0 {
+		key, n := proto.DecodeVarint(b)
+		if n == 0 {
+			return
+		}
+		b = b[n:]
+
+		start, size := 0, 0
+		switch key & 7 {
+		case proto.WireVarint:
+			if _, size = proto.DecodeVarint(b); size == 0 {
+				return
+			}
+		case proto.WireFixed64:
+			size = 8
+		case proto.WireFixed32:
+			size = 4
+		case proto.WireBytes:
+			l, n := proto.DecodeVarint(b)
+			if n == 0 {
+				return
+			}
+			start, size = n, n+int(l)
+		default:
+			return
+		}
+		if size < start || size > len(b) {
+			return
+		}
+
+		fn(key>>3, b[start:size])
+		b = b[size:]
+	}
+}
@@ -2,9 +2,12 @@ package plugin
 
 import (
 	"fmt"
+	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
@@ -16,6 +19,105 @@ import (
 const (
 	// PluginName is name of the plugin specified for protoc
 	PluginName = "atlas-validate"
+
+	// ruleGuardsParam is a plugin parameter that enables runtime.RuleEnabled
+	// guards around generated constraints.
+	ruleGuardsParam = "rule_guards"
+
+	// celParam is a plugin parameter that enables generation of CEL expressions
+	// of atlas_validate.message option.
+	celParam = "cel"
+
+	// verboseErrorsParam is a plugin parameter that adds details computed at
+	// generation time to errors, e.g. operations allowed for a denied field.
+	verboseErrorsParam = "verbose_errors"
+
+	// formParam is a plugin parameter that enables validation of form-urlencoded
+	// bodies against fields of body messages.
+	formParam = "form"
+
+	// relaxedJSONParam is a plugin parameter that makes the annotator accept
+	// comments and trailing commas in JSON bodies, see runtime.RelaxJSON.
+	relaxedJSONParam = "relaxed_json"
+
+	// reportAllowUnknownParam is a plugin parameter that reports effective
+	// allow_unknown_fields of each method and suspicious overrides to stderr.
+	reportAllowUnknownParam = "report_allow_unknown"
+
+	// errorModeParam is a plugin parameter that selects how validators report
+	// errors, error_mode=collect makes them collect all errors of a request
+	// into runtime.Errors instead of returning the first one.
+	errorModeParam = "error_mode"
+
+	// jsonNamesParam is a plugin parameter that enables match_json_names
+	// option for every generated file.
+	jsonNamesParam = "json_names"
+
+	// strictWKTParam is a plugin parameter that enables validation of JSON
+	// representation of well-known types, e.g. RFC 3339 strings of Timestamp.
+	strictWKTParam = "strict_wkt"
+
+	// rejectDuplicateKeysParam is a plugin parameter that makes validators reject
+	// objects with duplicate keys instead of using the last value.
+	rejectDuplicateKeysParam = "reject_duplicate_keys"
+
+	// errorHeaderParam is a plugin parameter that sets a name of metadata the
+	// annotator reports validation errors with, Atlas-Validation-Error by default.
+	errorHeaderParam = "error_header"
+
+	// optInHeaderParam is a plugin parameter that names a header of requests the
+	// annotator validates only if it is set to true, e.g. X-Atlas-Validate.
+	optInHeaderParam = "opt_in_header"
+
+	// genTestsParam is a plugin parameter that enables generation of
+	// *.pb.atlas.validate_test.go files with table-driven tests of validators.
+	genTestsParam = "gen_tests"
+
+	// genBenchmarksParam is a plugin parameter that enables generation of benchmarks
+	// of validators in *.pb.atlas.validate_test.go files.
+	genBenchmarksParam = "gen_benchmarks"
+
+	// constraintsParam is a plugin parameter that enables generation of
+	// AtlasValidateConstraints variable describing validation rules of fields.
+	constraintsParam = "constraints"
+
+	// caseInsensitiveParam is a plugin parameter that makes validators match
+	// fields of JSON objects case-insensitively.
+	caseInsensitiveParam = "case_insensitive"
+
+	// validateResponsesParam is a plugin parameter that enables generation of
+	// validators of response bodies, see AtlasValidateResponse.
+	validateResponsesParam = "validate_responses"
+
+	// pathStyleParam is a plugin parameter that selects a style of error paths of
+	// files without json_pointer_paths option, "jsonpath" renders them as JSONPath
+	// (e.g. $.groups[0].name) instead of the default dotted form.
+	pathStyleParam = "path_style"
+
+	// separatePackageParam is a plugin parameter that is rejected: validators
+	// declare AtlasValidateJSON methods of messages and refer to unexported
+	// pattern_* variables of *.pb.gw.go, so they must be in the message package.
+	separatePackageParam = "separate_package"
+
+	// runtimeParam is a plugin parameter that selects a protobuf runtime of
+	// messages validators are generated for, "gogo" (default) or "protobuf-go"
+	// for messages of google.golang.org/protobuf.
+	runtimeParam = "runtime"
+
+	// allowEmptyObjectBodyParam is a plugin parameter that makes validators of
+	// methods without body accept an empty JSON object some clients send as one.
+	allowEmptyObjectBodyParam = "allow_empty_object_body"
+
+	// errorCodesParam is a plugin parameter that makes validators return errors
+	// of fields as runtime.ValidationError with codes, e.g. REQUIRED.
+	errorCodesParam = "error_codes"
+
+	// singleFileParam is a plugin parameter that names a file validators of all
+	// files of a request are combined into, see SingleFile.
+	singleFileParam = "single_file"
+
+	// defaultErrorHeader is a default name of validation error metadata.
+	defaultErrorHeader = "Atlas-Validation-Error"
 )
 
 type Plugin struct {
@@ -27,6 +129,101 @@ type Plugin struct {
 	imports map[string]*importPkg
 	fcount  int
 
+	// messages indexes all messages of the request by fully-qualified type name,
+	// it is used where generator.ObjectNamed is not usable (e.g. in Init).
+	messages map[string]*messageDescriptor
+	// enums indexes all enums of the request by fully-qualified type name.
+	enums map[string]*enumDescriptor
+	// messageNames maps message descriptors to their fully-qualified names
+	// without leading dot.
+	messageNames map[*descriptor.DescriptorProto]string
+
+	// ruleGuards is set by rule_guards=true parameter, it wraps generated
+	// constraints into runtime.RuleEnabled checks.
+	ruleGuards bool
+
+	// cel is set by cel=true parameter.
+	cel bool
+
+	// verboseErrors is set by verbose_errors=true parameter.
+	verboseErrors bool
+
+	// form is set by form=true parameter.
+	form bool
+
+	// relaxedJSON is set by relaxed_json=true parameter.
+	relaxedJSON bool
+
+	// reportAllowUnknown is set by report_allow_unknown=true parameter.
+	reportAllowUnknown bool
+
+	// allowUnknownSet holds options of files, services and methods that set
+	// allow_unknown_fields, see indexAllowUnknown.
+	allowUnknownSet map[proto.Message]bool
+
+	// collectErrors is set by error_mode=collect parameter.
+	collectErrors bool
+
+	// jsonNames is set by json_names=true parameter.
+	jsonNames bool
+
+	// errorHeader is set by error_header parameter.
+	errorHeader string
+
+	// optInHeader is set by opt_in_header parameter.
+	optInHeader string
+
+	// rejectDuplicateKeys is set by reject_duplicate_keys=true parameter.
+	rejectDuplicateKeys bool
+
+	// strictWKT is set by strict_wkt=true parameter.
+	strictWKT bool
+
+	// genTests is set by gen_tests=true parameter.
+	genTests bool
+
+	// genBenchmarks is set by gen_benchmarks=true parameter.
+	genBenchmarks bool
+
+	// constraints is set by constraints=true parameter.
+	constraints bool
+	// tests holds test cases of generated files, see renderTestCases.
+	tests []testCases
+
+	// caseInsensitive is set by case_insensitive=true parameter.
+	caseInsensitive bool
+
+	// validateResponses is set by validate_responses=true parameter.
+	validateResponses bool
+
+	// jsonPath is set by path_style=jsonpath parameter.
+	jsonPath bool
+
+	// protobufGo is set by runtime=protobuf-go parameter.
+	protobufGo bool
+
+	// options reads atlas_validate options of descriptors, see optionReader.
+	options optionReader
+
+	// allowEmptyObjectBody is set by allow_empty_object_body=true parameter.
+	allowEmptyObjectBody bool
+
+	// errorCodes is set by error_codes=true parameter.
+	errorCodes bool
+
+	// singleFile is set by single_file parameter.
+	singleFile string
+
+	// queryTypes is a set of messages that need validate_Query_Object_ function.
+	queryTypes map[string]bool
+
+	// customMethods lists HTTP methods of custom google.api.http rules of a
+	// request that custom operation of deny and required options refers to.
+	customMethods []string
+
+	// annotatorOnce guards rendering of declarations that cover methods of every
+	// file of a request (validate_Patterns, the annotator, etc.) into one of them,
+	// see Generate.
 	annotatorOnce sync.Once
 }
 
@@ -37,6 +234,70 @@ func (p *Plugin) Name() string {
 func (p *Plugin) Init(g *generator.Generator) {
 	p.Generator = g
 
+	p.ruleGuards = p.Param[ruleGuardsParam] == "true"
+	p.cel = p.Param[celParam] == "true"
+	if p.cel && !celSupported {
+		p.Fail(`cel=true parameter requires the plugin built with "cel" build tag (go install -tags cel)`)
+	}
+	p.verboseErrors = p.Param[verboseErrorsParam] == "true"
+	p.form = p.Param[formParam] == "true"
+	p.relaxedJSON = p.Param[relaxedJSONParam] == "true"
+	p.reportAllowUnknown = p.Param[reportAllowUnknownParam] == "true"
+	p.collectErrors = p.Param[errorModeParam] == "collect"
+	p.jsonNames = p.Param[jsonNamesParam] == "true"
+	p.rejectDuplicateKeys = p.Param[rejectDuplicateKeysParam] == "true"
+	p.strictWKT = p.Param[strictWKTParam] == "true"
+	p.genTests = p.Param[genTestsParam] == "true"
+	p.genBenchmarks = p.Param[genBenchmarksParam] == "true"
+	p.constraints = p.Param[constraintsParam] == "true"
+	p.caseInsensitive = p.Param[caseInsensitiveParam] == "true"
+	p.validateResponses = p.Param[validateResponsesParam] == "true"
+	p.allowEmptyObjectBody = p.Param[allowEmptyObjectBodyParam] == "true"
+	p.errorCodes = p.Param[errorCodesParam] == "true"
+	switch style := p.Param[pathStyleParam]; style {
+	case "":
+	case "jsonpath":
+		p.jsonPath = true
+	default:
+		p.Fail(`path_style parameter must be "jsonpath", got `, style)
+	}
+	switch rt := p.Param[runtimeParam]; rt {
+	case "", "gogo":
+		p.options = gogoOptions{}
+	case "protobuf-go":
+		p.protobufGo = true
+		options, err := newProtobufGoOptions()
+		if err != nil {
+			p.Fail(`failed to resolve atlas_validate options with google.golang.org/protobuf: `, err.Error())
+		}
+		p.options = options
+	default:
+		p.Fail(`runtime parameter must be "gogo" or "protobuf-go", got `, rt)
+	}
+	p.singleFile = p.Param[singleFileParam]
+	if p.singleFile != "" && (strings.ContainsRune(p.singleFile, '/') || !strings.HasSuffix(p.singleFile, ".go") || strings.HasSuffix(p.singleFile, "_test.go")) {
+		p.Fail(`single_file parameter must be a name of a non-test .go file, got `, p.singleFile)
+	}
+	p.errorHeader = p.Param[errorHeaderParam]
+	if p.errorHeader == "" {
+		p.errorHeader = defaultErrorHeader
+	}
+	if strings.Trim(strings.ToLower(p.errorHeader), "abcdefghijklmnopqrstuvwxyz0123456789-_.") != "" {
+		p.Fail(`error_header parameter must be a valid metadata key, got `, p.errorHeader)
+	}
+	p.optInHeader = p.Param[optInHeaderParam]
+	if strings.Trim(strings.ToLower(p.optInHeader), "abcdefghijklmnopqrstuvwxyz0123456789-_.") != "" {
+		p.Fail(`opt_in_header parameter must be a valid header name, got `, p.optInHeader)
+	}
+
+	if _, ok := p.Param[separatePackageParam]; ok {
+		p.Fail(`separate_package parameter is not supported, validators are generated into the package of messages`)
+	}
+
+	p.indexAllowUnknown()
+	p.indexMessages()
+	p.indexCustomMethods()
+
 	p.methods = make(map[string][]*methodDescriptor)
 	for _, f := range p.Generator.Request.ProtoFile {
 		for _, fg := range p.Generator.Request.FileToGenerate {
@@ -47,9 +308,22 @@ func (p *Plugin) Init(g *generator.Generator) {
 		}
 	}
 
+	p.queryTypes = make(map[string]bool)
+	for _, methods := range p.methods {
+		for _, m := range methods {
+			if m.hasQuery {
+				p.markQueryTypes(m.inputType)
+			}
+			if m.hasForm {
+				p.markQueryTypes(p.bodyTypeName(m))
+			}
+		}
+	}
+
 }
 
 func (p *Plugin) GenerateImports(file *generator.FileDescriptor) {
+	p.useGolangProtoImport(file)
 	if p.pluginImports != nil {
 		p.pluginImports.GenerateImports(file)
 	}
@@ -66,42 +340,205 @@ func (p *Plugin) Generate(file *generator.FileDescriptor) {
 
 	p.initPluginImports(p.Generator)
 
+	p.renderEnums()
 	p.renderValidatorMethods()
 	p.renderValidatorObjectMethods()
+	p.renderTestCases()
 
-	if p.fcount == 0 || strings.HasSuffix(file.GetName(), file.GetPackage()+".proto") {
+	// declarations of the request go to a file named after the package or the last
+	// file, the latter with single_file parameter so that they follow validators
+	// of every file in the combined one
+	if p.fcount == 0 || (p.singleFile == "" && strings.HasSuffix(file.GetName(), file.GetPackage()+".proto")) {
 		p.annotatorOnce.Do(func() {
 			p.renderMethodDescriptors()
 			p.renderAnnotator()
+			p.renderReaderValidator()
+			p.renderRequestValidators()
+			if p.validateResponses {
+				p.renderResponseValidator()
+			}
+			p.renderInterceptor()
+			p.renderSelfTest()
+			p.renderPatternsAccessor()
+			p.renderRegistration()
+			if p.constraints {
+				p.renderConstraints()
+			}
 		})
 	}
 }
 
 // getAllowUnknown function picks up correct allowUnknown option from file/service/method
-// hierarchy.
+// hierarchy, a level that does not set allow_unknown_fields inherits the value of the
+// enclosing one.
 func (p *Plugin) getAllowUnknown(file proto.Message, svc proto.Message, method proto.Message) bool {
-	var gavOpt *av_opts.AtlasValidateFileOption
-	if aExt, err := proto.GetExtension(file, av_opts.E_File); err == nil && aExt != nil {
-		gavOpt = aExt.(*av_opts.AtlasValidateFileOption)
+	switch {
+	case p.allowUnknownSet[method]:
+		return p.methodOption(method).GetAllowUnknownFields()
+	case p.allowUnknownSet[svc]:
+		return p.serviceOption(svc).GetAllowUnknownFields()
+	}
+
+	return p.fileOption(file).GetAllowUnknownFields()
+}
+
+// allowUnknownReport function describes how allow_unknown_fields of a method is
+// resolved from file/service/method hierarchy: the effective value and its origin
+// followed by notes about overrides of inherited values and warnings about options
+// that repeat inherited values.
+func (p *Plugin) allowUnknownReport(f *descriptor.FileDescriptorProto, svc *descriptor.ServiceDescriptorProto, method *descriptor.MethodDescriptorProto) []string {
+	name := f.GetName() + ": " + svc.GetName() + "." + method.GetName()
+
+	value, origin := p.fileOption(f.Options).GetAllowUnknownFields(), "file"
+	if !p.allowUnknownSet[f.Options] {
+		origin = "default"
+	}
+
+	var lines []string
+	override := func(level string, v bool) {
+		if v == value {
+			lines = append(lines, fmt.Sprintf("%s: warning: %s option repeats allow_unknown_fields = %t of %s", name, level, v, origin))
+		} else {
+			lines = append(lines, fmt.Sprintf("%s: note: %s option overrides allow_unknown_fields = %t of %s", name, level, value, origin))
+		}
+		value, origin = v, level
+	}
+
+	if p.allowUnknownSet[svc.Options] {
+		override("service", p.serviceOption(svc.Options).GetAllowUnknownFields())
+	}
+	if p.allowUnknownSet[method.Options] {
+		override("method", p.methodOption(method.Options).GetAllowUnknownFields())
+	}
+
+	return append([]string{fmt.Sprintf("%s: allow_unknown_fields = %t (%s)", name, value, origin)}, lines...)
+}
+
+// errorMessage function returns an expression of a message of validation error
+// err reported by the annotator and the interceptor: a JSON array of errors with
+// error_codes=true parameter, see runtime.ErrorsJSON, or runtime.ErrorMessage of
+// collected errors limited by FirstError option of runtime.Options.
+func (p *Plugin) errorMessage() string {
+	err := `err`
+	if p.collectErrors {
+		err = p.Import(runtimePkgPath).Use() + `.ReportedErrors(ctx, err)`
+	}
+
+	switch {
+	case p.errorCodes:
+		return p.Import(runtimePkgPath).Use() + `.ErrorsJSON(` + err + `)`
+	case p.collectErrors:
+		return p.Import(runtimePkgPath).Use() + `.ErrorMessage(` + err + `)`
+	}
+
+	return `err.Error()`
+}
+
+// jsonPointerPaths function reports whether a file being generated renders error
+// paths as RFC 6901 JSON Pointers.
+func (p *Plugin) jsonPointerPaths() bool {
+	return p.fileOption(p.file.Options).GetJsonPointerPaths()
+}
+
+// matchJSONNames function reports whether match_json_names option is set for
+// a file being generated or enabled by json_names=true parameter.
+func (p *Plugin) matchJSONNames() bool {
+	if p.jsonNames {
+		return true
+	}
+	return p.fileOption(p.file.Options).GetMatchJsonNames()
+}
+
+// renderJSONNamesNormalization function generates renaming of fields sent under
+// their json_name to proto names within validate_Object_ function, so the rest of
+// the validator matches and reports fields by proto names.
+func (p *Plugin) renderJSONNamesNormalization(o *descriptor.DescriptorProto) {
+
+	fmtPkg := p.Import(fmtPkgPath)
+
+	renamed := false
+	for _, f := range o.GetField() {
+		if f.GetJsonName() == "" || f.GetJsonName() == f.GetName() {
+			continue
+		}
+		renamed = true
+
+		p.P(`if vv, ok := v["`, f.GetJsonName(), `"]; ok {`)
+		p.P(`if _, ok := v["`, f.GetName(), `"]; ok {`)
+		p.P(`return `, fmtPkg.Use(), `.Errorf("field %q is set twice.", `, p.joinPath(), `(path, "`, f.GetName(), `"))`)
+		p.P(`}`)
+		p.P(`delete(v, "`, f.GetJsonName(), `")`)
+		p.P(`v["`, f.GetName(), `"] = vv`)
+		p.P(`}`)
 	}
 
-	var savOpt *av_opts.AtlasValidateServiceOption
-	if aExt, err := proto.GetExtension(svc, av_opts.E_Service); err == nil && aExt != nil {
-		savOpt = aExt.(*av_opts.AtlasValidateServiceOption)
+	if renamed {
+		p.P()
 	}
+}
+
+// foldNames function returns quoted names keys of a JSON object are matched to
+// with case_insensitive=true parameter: proto names and json names with
+// match_json_names option.
+func (p *Plugin) foldNames(o *descriptor.DescriptorProto) []string {
+	var names []string
+	for _, f := range o.GetField() {
+		names = append(names, p.defaultKeys(f)...)
+	}
+
+	return names
+}
+
+// renderFoldKeys function generates renaming of fields that differ from proto
+// names (or json names with match_json_names option) only by case within
+// validate_Object_ function, so required, deny and field checks see declared names.
+// Keys are renamed unless a request is validated by an annotator of another package,
+// whose normalization does not rename them in the body (see runtime.FoldsKeys).
+func (p *Plugin) renderFoldKeys(o *descriptor.DescriptorProto) {
+
+	names := p.foldNames(o)
+	if len(names) == 0 {
+		return
+	}
+
+	runtimePkg := p.Import(runtimePkgPath)
+
+	p.P(`if `, runtimePkg.Use(), `.FoldsKeys(ctx, "`, p.file.GetPackage(), `") {`)
+	p.P(`if err = `, runtimePkg.Use(), `.FoldKeys(v, path, []string{`, strings.Join(names, ", "), `}, `, p.joinPath(), `); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P()
+}
 
-	var mavOpt *av_opts.AtlasValidateMethodOption
-	if aExt, err := proto.GetExtension(method, av_opts.E_Method); err == nil && aExt != nil {
-		mavOpt = aExt.(*av_opts.AtlasValidateMethodOption)
+// requiredRejectsEmptyString function reports whether required_rejects_empty_string
+// option is set for a file being generated.
+func (p *Plugin) requiredRejectsEmptyString() bool {
+	return p.fileOption(p.file.Options).GetRequiredRejectsEmptyString()
+}
+
+// joinPath function returns a runtime function that appends a field name to a path.
+func (p *Plugin) joinPath() string {
+	if p.jsonPointerPaths() {
+		return p.Import(runtimePkgPath).Use() + ".JoinPointer"
 	}
+	if p.jsonPath {
+		return p.Import(runtimePkgPath).Use() + ".JoinJSONPath"
+	}
+
+	return p.Import(runtimePkgPath).Use() + ".JoinPath"
+}
 
-	if mavOpt != nil {
-		return mavOpt.GetAllowUnknownFields()
-	} else if savOpt != nil {
-		return savOpt.GetAllowUnknownFields()
+// joinIndex function returns a runtime function that appends an array index to a path.
+func (p *Plugin) joinIndex() string {
+	if p.jsonPointerPaths() {
+		return p.Import(runtimePkgPath).Use() + ".JoinPointerIndex"
+	}
+	if p.jsonPath {
+		return p.Import(runtimePkgPath).Use() + ".JoinJSONPathIndex"
 	}
 
-	return gavOpt.GetAllowUnknownFields()
+	return p.Import(runtimePkgPath).Use() + ".JoinIndex"
 }
 
 type methodDescriptor struct {
@@ -112,6 +549,25 @@ type methodDescriptor struct {
 	gwPattern            string
 	allowUnknown         bool
 	inputType            string
+	outputType           string
+	hasDefaults          bool
+	// fullMethod is a gRPC name of the method, e.g. "/examplepb.Users/Update".
+	fullMethod string
+	// candidates are message types the body is matched against instead of
+	// inputType, candidatesOneOf tells whether exactly one of them must match.
+	candidates      []string
+	candidatesOneOf bool
+	// hasQuery tells whether query parameters of a method without body are validated.
+	hasQuery bool
+	// hasForm tells whether a form-urlencoded body of a method is validated.
+	hasForm bool
+	// bodyArray tells whether the body is bound to a repeated message field, i.e.
+	// it is a JSON array of objects.
+	bodyArray bool
+	// maxTotalElements limits array elements decoded across a request body.
+	maxTotalElements uint32
+	// maxTotalTextBytes limits aggregate size of string values of a request body.
+	maxTotalTextBytes uint32
 }
 
 // gatherMethods function walks through services and methods and extracts
@@ -120,19 +576,44 @@ func (p *Plugin) gatherMethods(f *descriptor.FileDescriptorProto) []*methodDescr
 
 	var methods []*methodDescriptor
 
+	pkgPrefix := ""
+	if f.GetPackage() != "" {
+		pkgPrefix = f.GetPackage() + "."
+	}
+
 	for _, svc := range f.GetService() {
 		for _, method := range svc.GetMethod() {
 			for i, opt := range extractHTTPOpts(method) {
-				methods = append(methods, &methodDescriptor{
+				m := &methodDescriptor{
 					svc:          svc.GetName(),
 					method:       method.GetName(),
 					idx:          i,
 					httpBody:     opt.body,
 					httpMethod:   opt.method,
 					gwPattern:    fmt.Sprintf("%s_%s_%d", svc.GetName(), method.GetName(), i),
+					fullMethod:   fmt.Sprintf("/%s%s/%s", pkgPrefix, svc.GetName(), method.GetName()),
 					inputType:    method.GetInputType(),
+					outputType:   method.GetOutputType(),
 					allowUnknown: p.getAllowUnknown(f.Options, svc.Options, method.Options),
-				})
+				}
+				if p.reportAllowUnknown && i == 0 {
+					for _, line := range p.allowUnknownReport(f, svc, method) {
+						fmt.Fprintln(os.Stderr, PluginName+":", line)
+					}
+				}
+				if m.httpBody != "" && m.httpBody != "*" && p.bodyTypeName(m) == "" && !p.hasField(m.inputType, m.httpBody) {
+					p.Fail(`body "`, m.httpBody, `" of method `, m.svc, `.`, m.method, ` refers to unknown field of `, strings.TrimPrefix(m.inputType, "."))
+				}
+				p.setCandidates(m, method)
+				m.bodyArray = len(m.candidates) == 0 && p.isArrayBody(m)
+				m.maxTotalElements = p.getMaxTotalElements(f.Options, method.Options)
+				m.maxTotalTextBytes = p.getMaxTotalTextBytes(f.Options)
+				if m.httpBody != "" && len(m.candidates) == 0 {
+					m.hasDefaults = p.hasDefaults(p.bodyTypeName(m), make(map[string]bool))
+				}
+				m.hasQuery = m.httpBody == "" && p.isQueryMessage(m.inputType)
+				m.hasForm = p.form && m.httpBody != "" && len(m.candidates) == 0 && !m.bodyArray && p.isQueryMessage(p.bodyTypeName(m))
+				methods = append(methods, m)
 			}
 		}
 	}
@@ -140,6 +621,73 @@ func (p *Plugin) gatherMethods(f *descriptor.FileDescriptorProto) []*methodDescr
 	return methods
 }
 
+// setCandidates function reads one_of/any_of method options into method descriptor.
+func (p *Plugin) setCandidates(m *methodDescriptor, method *descriptor.MethodDescriptorProto) {
+	mavOpt := p.methodOption(method.Options)
+
+	oneOf, anyOf := mavOpt.GetOneOf(), mavOpt.GetAnyOf()
+	if len(oneOf) != 0 && len(anyOf) != 0 {
+		p.Fail(`method `, m.svc, `.`, m.method, ` cannot specify both one_of and any_of options`)
+	}
+
+	m.candidatesOneOf = len(oneOf) != 0
+	for _, t := range append(oneOf, anyOf...) {
+		if !strings.HasPrefix(t, ".") {
+			t = "." + t
+		}
+		if _, ok := p.messages[t]; !ok {
+			p.Fail(`method `, m.svc, `.`, m.method, ` refers to unknown message type `, t)
+		}
+		m.candidates = append(m.candidates, t)
+	}
+}
+
+// bodyTypeName function returns a proto type name of an object that is bound to
+// HTTP request body of a given method.
+func (p *Plugin) bodyTypeName(m *methodDescriptor) string {
+	if m.httpBody == "*" {
+		return m.inputType
+	}
+
+	if o, ok := p.messages[m.inputType]; ok {
+		for _, f := range o.GetField() {
+			if f.GetName() == m.httpBody {
+				return f.GetTypeName()
+			}
+		}
+	}
+
+	return ""
+}
+
+// isArrayBody function tells whether a body of a method is bound to a repeated
+// message field, such a body is a JSON array of objects of the field type.
+func (p *Plugin) isArrayBody(m *methodDescriptor) bool {
+	if o, ok := p.messages[m.inputType]; ok && m.httpBody != "*" {
+		for _, f := range o.GetField() {
+			if f.GetName() == m.httpBody && f.IsRepeated() && f.IsMessage() {
+				ft, ok := p.messages[f.GetTypeName()]
+				return !ok || !ft.GetOptions().GetMapEntry()
+			}
+		}
+	}
+
+	return false
+}
+
+// hasField function tells whether a message has a field with a given name.
+func (p *Plugin) hasField(typeName, name string) bool {
+	if o, ok := p.messages[typeName]; ok {
+		for _, f := range o.GetField() {
+			if f.GetName() == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // renderMethodDescriptors renders array of structs that are used to trigger validation
 // function on correct HTTP request according to HTTP method and grpc-gateway/runtime.Pattern.
 func (p *Plugin) renderMethodDescriptors() {
@@ -147,17 +695,32 @@ func (p *Plugin) renderMethodDescriptors() {
 	var (
 		jsonPkg      = p.Import(jsonPkgPath)
 		ctxPkg       = p.Import(ctxPkgPath)
+		urlPkg       = p.Import(urlPkgPath)
 		gwruntimePkg = p.Import(gwruntimePkgPath)
 	)
 
 	p.P(`var validate_Patterns = []struct{`)
 	p.P(`pattern `, gwruntimePkg.Use(), `.Pattern`)
 	p.P(`httpMethod string`)
+	p.P(`// method is a gRPC name of the method the pattern is bound to.`)
+	p.P(`method string`)
 	p.P(`validator func(`, ctxPkg.Use(), `.Context, `, jsonPkg.Use(), `.RawMessage) error`)
 	p.P(`// Included for introspection purpose.`)
 	p.P(`allowUnknown bool`)
+	p.P(`// defaulter injects default values into a valid body, nil if there is nothing to inject.`)
+	p.P(`defaulter func(`, ctxPkg.Use(), `.Context, `, jsonPkg.Use(), `.RawMessage) (`, jsonPkg.Use(), `.RawMessage, error)`)
+	p.P(`// queryValidator validates query parameters of a request without body, nil if they are not validated.`)
+	p.P(`queryValidator func(`, ctxPkg.Use(), `.Context, `, urlPkg.Use(), `.Values) error`)
+	p.P(`// formValidator validates a form-urlencoded body, nil if such bodies are not validated.`)
+	p.P(`formValidator func(`, ctxPkg.Use(), `.Context, `, urlPkg.Use(), `.Values) error`)
+	if p.validateResponses {
+		p.P(`// responseValidator validates a response body.`)
+		p.P(`responseValidator func(`, ctxPkg.Use(), `.Context, `, jsonPkg.Use(), `.RawMessage) error`)
+	}
 	p.P(`} {`)
 
+	// Patterns are listed by file name and then in order of services, methods and
+	// bindings of a file, so that generated code is reproducible.
 	var files []string
 	for f := range p.methods {
 		files = append(files, f)
@@ -165,22 +728,53 @@ func (p *Plugin) renderMethodDescriptors() {
 
 	sort.StringSlice(files).Sort()
 
+	// indexes of patterns by HTTP method, in order of validate_Patterns
+	var httpMethods []string
+	byMethod := make(map[string][]string)
+	n := 0
 	for _, f := range files {
 		methods := p.methods[f]
 		p.P(`// patterns for file `, f)
 		for _, m := range methods {
+			if _, ok := byMethod[m.httpMethod]; !ok {
+				httpMethods = append(httpMethods, m.httpMethod)
+			}
+			byMethod[m.httpMethod] = append(byMethod[m.httpMethod], strconv.Itoa(n))
+			n++
 			p.P(`{`)
 			// NOTE: pattern reiles on code generated by protoc-gen-grpc-gateway.
 			p.P(`pattern: `, "pattern_"+m.gwPattern, `,`)
 			p.P(`httpMethod: "`, m.httpMethod, `",`)
+			p.P(`method: "`, m.fullMethod, `",`)
 			p.P(`validator: `, "validate_"+m.gwPattern, `,`)
 			p.P(`allowUnknown: `, m.allowUnknown, `,`)
+			if m.hasDefaults {
+				p.P(`defaulter: `, "default_"+m.gwPattern, `,`)
+			}
+			if m.hasQuery {
+				p.P(`queryValidator: `, "validate_query_"+m.gwPattern, `,`)
+			}
+			if m.hasForm {
+				p.P(`formValidator: `, "validate_form_"+m.gwPattern, `,`)
+			}
+			if p.validateResponses {
+				p.P(`responseValidator: `, "validate_response_"+m.gwPattern, `,`)
+			}
 			p.P(`},`)
 		}
 		p.P()
 	}
 	p.P(`}`)
 	p.P()
+
+	p.P(`// validate_PatternsByMethod holds indexes of validate_Patterns by HTTP method, so`)
+	p.P(`// that a request is matched only against patterns of its method.`)
+	p.P(`var validate_PatternsByMethod = map[string][]int{`)
+	for _, m := range httpMethods {
+		p.P(`"`, m, `": {`, strings.Join(byMethod[m], `, `), `},`)
+	}
+	p.P(`}`)
+	p.P()
 }
 
 // renderValidatorMethods function generates entrypoints for validator one per each
@@ -199,28 +793,31 @@ func (p *Plugin) renderValidatorMethods() {
 		p.P(`func validate_`, m.gwPattern, `(ctx `, ctxPkg.Use(), `.Context, r `, jsonPkg.Use(), `.RawMessage) (err error) {`)
 
 		if m.httpBody == "" {
-			p.P(`if len(r) != 0 {`)
+			if p.allowEmptyObjectBody {
+				p.P(`if len(r) != 0 && !`, p.Import(runtimePkgPath).Use(), `.IsEmptyObject(r) {`)
+			} else {
+				p.P(`if len(r) != 0 {`)
+			}
 			p.P(`return `, fmtPkg.Use(), `.Errorf("body is not allowed")`)
 			p.P(`}`)
 			p.P(`return nil`)
-		} else if p.isWKT(m.inputType) {
+		} else if len(m.candidates) != 0 {
+			p.renderRequestLimits(m)
+			p.renderCandidatesMatch(m)
+		} else if _, ok := p.messages[p.bodyTypeName(m)]; !ok || p.isWKT(m.inputType) {
+			// a body bound to a scalar or enum field is checked by grpc-gateway
 			p.P(`return nil`)
 		} else {
 
-			var (
-				o generator.Object
-				t string
-			)
-
-			o = p.objectNamed(m.inputType)
-			t = p.TypeName(o)
-
-			if m.httpBody != "*" {
-				o = p.objectNamed(o.File().GetMessage(t).GetFieldDescriptor(m.httpBody).GetTypeName())
-				t = p.TypeName(o)
-			}
+			// each binding validates an object named by its own body, e.g. the
+			// whole input of "*" binding and a field of additional "payload" one
+			o := p.objectNamed(p.bodyTypeName(m))
+			t := p.TypeName(o)
 
-			if p.isLocal(o) {
+			p.renderRequestLimits(m)
+			if m.bodyArray {
+				p.renderArrayBody(o, t)
+			} else if p.isLocal(o) {
 				p.P(`return validate_Object_`, t, `(ctx, r, "")`)
 			} else {
 				p.P(`if validator, ok := `, p.generateAtlasValidateJSONInterfaceSignature(t), `; ok {`)
@@ -231,31 +828,196 @@ func (p *Plugin) renderValidatorMethods() {
 		}
 		p.P(`}`)
 		p.P()
+
+		if p.validateResponses {
+			p.renderResponseValidatorMethod(m)
+		}
+
+		if m.hasDefaults {
+			t := p.TypeName(p.objectNamed(p.bodyTypeName(m)))
+			p.P(`// default_`, m.gwPattern, ` injects default values into a body of "`, m.httpMethod, `" HTTP request`)
+			p.P(`// that match *.pb.gw.go/pattern_`, m.gwPattern, `.`)
+			p.P(`func default_`, m.gwPattern, `(ctx `, ctxPkg.Use(), `.Context, r `, jsonPkg.Use(), `.RawMessage) (`, jsonPkg.Use(), `.RawMessage, error) {`)
+			if m.bodyArray {
+				p.renderArrayBodyDefaults(t)
+			} else {
+				p.P(`return default_Object_`, t, `(ctx, r, "")`)
+			}
+			p.P(`}`)
+			p.P()
+		}
+
+		if m.hasQuery {
+			t := p.TypeName(p.objectNamed(m.inputType))
+			p.P(`// validate_query_`, m.gwPattern, ` is an entrypoint for validating query parameters of "`, m.httpMethod, `" HTTP request`)
+			p.P(`// that match *.pb.gw.go/pattern_`, m.gwPattern, `.`)
+			p.P(`func validate_query_`, m.gwPattern, `(ctx `, ctxPkg.Use(), `.Context, q `, p.Import(urlPkgPath).Use(), `.Values) error {`)
+			p.P(`return `, p.Import(runtimePkgPath).Use(), `.ValidateQuery(ctx, q, validate_Query_Object_`, t, `)`)
+			p.P(`}`)
+			p.P()
+		}
+
+		if m.hasForm {
+			t := p.TypeName(p.objectNamed(p.bodyTypeName(m)))
+			p.P(`// validate_form_`, m.gwPattern, ` is an entrypoint for validating a form-urlencoded body of "`, m.httpMethod, `" HTTP request`)
+			p.P(`// that match *.pb.gw.go/pattern_`, m.gwPattern, `.`)
+			p.P(`func validate_form_`, m.gwPattern, `(ctx `, ctxPkg.Use(), `.Context, form `, p.Import(urlPkgPath).Use(), `.Values) error {`)
+			p.P(`if err := `, p.Import(runtimePkgPath).Use(), `.ValidateQuery(ctx, form, validate_Query_Object_`, t, `); err != nil {`)
+			p.P(`return err`)
+			p.P(`}`)
+			p.P(`return validate_required_Object_`, t, `(ctx, `, p.Import(runtimePkgPath).Use(), `.FormObject(form), "")`)
+			p.P(`}`)
+			p.P()
+		}
 	}
 }
 
-func (p *Plugin) renderValidatorObjectMethods() {
+// renderRequestLimits function generates setting of max_total_elements and
+// max_total_text_bytes limits of a method into a context of validate_ function.
+func (p *Plugin) renderRequestLimits(m *methodDescriptor) {
+	if m.maxTotalElements != 0 {
+		p.P(`ctx = `, p.Import(runtimePkgPath).Use(), `.WithMaxTotalElements(ctx, `, int(m.maxTotalElements), `)`)
+	}
+	if m.maxTotalTextBytes != 0 {
+		p.P(`ctx = `, p.Import(runtimePkgPath).Use(), `.WithMaxTotalTextBytes(ctx, `, int(m.maxTotalTextBytes), `)`)
+	}
+}
 
-	for _, o := range p.file.GetMessageType() {
+// renderArrayBody function generates a body of validator entrypoint of a body
+// bound to a repeated field: each element of a JSON array is validated as an
+// object of type t with its index as a path, e.g. "[0].name".
+func (p *Plugin) renderArrayBody(o generator.Object, t string) {
 
-		ptype := "." + p.file.GetPackage() + "." + o.GetName()
-		otype := p.TypeName(p.objectNamed(ptype))
+	var (
+		jsonPkg    = p.Import(jsonPkgPath)
+		fmtPkg     = p.Import(fmtPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
 
-		p.renderValidatorObjectMethod(o, otype)
-		p.generateValidateRequired(o, otype)
+	validate := `validate_Object_` + t
+	if !p.isLocal(o) {
+		p.P(`validator, ok := `, p.generateAtlasValidateJSONInterfaceSignature(t))
+		p.P(`if !ok {`)
+		p.P(`return nil`)
+		p.P(`}`)
+		validate = `validator.AtlasValidateJSON`
+	}
+
+	p.P(`var vArr []`, jsonPkg.Use(), `.RawMessage`)
+	p.P(`if err = `, jsonPkg.Use(), `.Unmarshal(r, &vArr); err != nil {`)
+	p.P(`return `, fmtPkg.Use(), `.Errorf("invalid value for %q: expected array.", "")`)
+	p.P(`}`)
+	if p.collectErrors {
+		p.P(`var errs []error`)
+	}
+	p.P(`for i, vv := range vArr {`)
+	p.P(`if err = `, validate, `(ctx, vv, `, p.joinIndex(), `("", i)); err != nil {`)
+	if p.collectErrors {
+		p.P(`errs = `, runtimePkg.Use(), `.AppendError(errs, err)`)
+	} else {
+		p.P(`return err`)
+	}
+	p.P(`}`)
+	p.P(`}`)
+	if p.collectErrors {
+		p.P(`return `, runtimePkg.Use(), `.JoinErrors(errs)`)
+	} else {
+		p.P(`return nil`)
+	}
+}
+
+// renderArrayBodyDefaults function generates a body of defaulter entrypoint of a
+// body bound to a repeated field, defaults are injected into each element.
+func (p *Plugin) renderArrayBodyDefaults(t string) {
+
+	var (
+		jsonPkg  = p.Import(jsonPkgPath)
+		bytesPkg = p.Import(bytesPkgPath)
+	)
+
+	p.P(`var vArr []`, jsonPkg.Use(), `.RawMessage`)
+	p.P(`if err := `, jsonPkg.Use(), `.Unmarshal(r, &vArr); err != nil {`)
+	p.P(`return r, nil`)
+	p.P(`}`)
+	p.P(`changed := false`)
+	p.P(`for i, vv := range vArr {`)
+	p.P(`b, err := default_Object_`, t, `(ctx, vv, `, p.joinIndex(), `("", i))`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`if !`, bytesPkg.Use(), `.Equal(b, vv) {`)
+	p.P(`vArr[i], changed = b, true`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`if !changed {`)
+	p.P(`return r, nil`)
+	p.P(`}`)
+	p.P(`return `, jsonPkg.Use(), `.Marshal(vArr)`)
+}
+
+// renderCandidatesMatch function generates a body of validator entrypoint that tries
+// each of one_of/any_of candidate types and aggregates results with runtime.MatchSchemas.
+func (p *Plugin) renderCandidatesMatch(m *methodDescriptor) {
+
+	runtimePkg := p.Import(runtimePkgPath)
+
+	var names []string
+	for _, t := range m.candidates {
+		names = append(names, `"`+strings.TrimPrefix(t, ".")+`"`)
+	}
 
-		for _, no := range o.GetNestedType() {
+	p.P(`return `, runtimePkg.Use(), `.MatchSchemas(`, m.candidatesOneOf, `, []string{`, strings.Join(names, ", "), `}, []error{`)
+	for _, t := range m.candidates {
+		o := p.objectNamed(t)
+		ot := p.TypeName(o)
+		if p.isLocal(o) {
+			p.P(`validate_Object_`, ot, `(ctx, r, ""),`)
+		} else {
+			p.P(`func() error {`)
+			p.P(`if validator, ok := `, p.generateAtlasValidateJSONInterfaceSignature(ot), `; ok {`)
+			p.P(`return validator.AtlasValidateJSON(ctx, r, "")`)
+			p.P(`}`)
+			p.P(`return nil`)
+			p.P(`}(),`)
+		}
+	}
+	p.P(`})`)
+}
 
-			if no.GetOptions().GetMapEntry() {
+// walkFileMessages function calls fn with every message of the file being generated
+// and its full name, e.g. ".examplepb.Policy.Rule", messages nested at any depth
+// follow their parent and map entries are skipped.
+func (p *Plugin) walkFileMessages(fn func(o *descriptor.DescriptorProto, ptype string)) {
+	var walk func(prefix string, msgs []*descriptor.DescriptorProto)
+	walk = func(prefix string, msgs []*descriptor.DescriptorProto) {
+		for _, o := range msgs {
+			if o.GetOptions().GetMapEntry() {
 				continue
 			}
+			ptype := prefix + "." + o.GetName()
+			fn(o, ptype)
+			walk(ptype, o.GetNestedType())
+		}
+	}
+
+	walk("."+p.file.GetPackage(), p.file.GetMessageType())
+}
+
+func (p *Plugin) renderValidatorObjectMethods() {
+
+	p.walkFileMessages(func(o *descriptor.DescriptorProto, ptype string) {
 
-			notype := p.TypeName(p.objectNamed(ptype + "." + no.GetName()))
+		otype := p.TypeName(p.objectNamed(ptype))
 
-			p.renderValidatorObjectMethod(no, notype)
-			p.generateValidateRequired(no, notype)
+		p.renderValidatorObjectMethod(o, otype)
+		p.generateValidateRequired(o, otype)
+		if p.hasDefaults(ptype, make(map[string]bool)) {
+			p.renderDefaultObjectMethod(o, otype)
 		}
-	}
+		if p.queryTypes[ptype] {
+			p.renderQueryObjectMethod(o, otype)
+		}
+	})
 }
 
 func (p *Plugin) renderValidatorObjectMethod(o *descriptor.DescriptorProto, t string) {
@@ -267,62 +1029,168 @@ func (p *Plugin) renderValidatorObjectMethod(o *descriptor.DescriptorProto, t st
 		runtimePkg = p.Import(runtimePkgPath)
 	)
 
+	if len(p.getCELExpressions(o)) != 0 {
+		p.renderCELPrograms(o, t)
+	}
+	p.renderPatterns(o)
+
 	p.P(`// validate_Object_`, t, ` function validates a JSON for a given object.`)
 	p.P(`func validate_Object_`, t, `(ctx `, ctxPkg.Use(), `.Context, r `, jsonPkg.Use(), `.RawMessage, path string) (err error) {`)
+	p.P(`if ctx, err = `, runtimePkg.Use(), `.EnterObject(ctx, path); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
 	p.P(`if hook, ok := `, p.generateAtlasJSONValidateInterfaceSignature(t), `; ok {`)
+	p.P(runtimePkg.Use(), `.MarkHookCalled(ctx)`)
 	p.P(`if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {`)
+	p.P(`if err == `, runtimePkg.Use(), `.ErrSkipValidation {`)
+	p.P(`return nil`)
+	p.P(`}`)
 	p.P(`return err`)
 	p.P(`}`)
 	p.P(`}`)
 	p.P()
 	p.P(`var v map[string]`, jsonPkg.Use(), `.RawMessage`)
 	p.P(`if err = `, jsonPkg.Use(), `.Unmarshal(r, &v); err != nil {`)
-	p.P(`return `, fmtPkg.Use(), `.Errorf("invalid value for %q: expected object.", path)`)
+	p.P(append([]interface{}{`return `}, p.withCode("CodeTypeMismatch", `path`, fmtPkg.Use(), `.Errorf("invalid value for %q: expected object.", path)`)...)...)
 	p.P(`}`)
+	if p.rejectDuplicateKeys {
+		p.P(`if err = `, runtimePkg.Use(), `.ValidateUniqueKeys(r, path, `, p.joinPath(), `); err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+	}
 	p.P()
+	if p.caseInsensitive {
+		p.renderFoldKeys(o)
+	}
+	if p.matchJSONNames() {
+		p.renderJSONNamesNormalization(o)
+	}
+	if n := p.getMaxFields(o); n != 0 {
+		p.P(`if len(v) > `, int(n), ` {`)
+		p.P(`return `, fmtPkg.Use(), `.Errorf("object %q has too many fields", path)`)
+		p.P(`}`)
+		p.P()
+	}
+	if p.collectErrors {
+		p.P(`var errs []error`)
+	}
 	p.P(`if err = validate_required_Object_`, t, `(ctx, v, path); err != nil {`)
-	p.P(`return err`)
+	p.renderObjectError(`err`)
 	p.P(`}`)
 	p.P()
+	p.renderOneofs(o)
+	p.renderMutuallyExclusive(o, t)
 	p.P(`allowUnknown := `, runtimePkg.Use(), `.AllowUnknownFromContext(ctx)`)
 	p.P()
-	p.P(`for k, _ := range v {`)
+	p.renderRange(`k`, ``, `v`)
 
 	p.P(`switch k {`)
 	for _, f := range o.GetField() {
+		if p.getFieldOption(f).GetAllowUnknownFields() && !f.IsMessage() {
+			p.Fail(`allow_unknown_fields option is allowed only for message and map fields, field `, f.GetName(), ` is `, f.GetType().String())
+		}
+		if fo := p.getFieldOption(f); fo.GetStrict() && (!f.IsMessage() || fo.GetAllowUnknownFields()) {
+			p.Fail(`strict option is allowed only for message and map fields without allow_unknown_fields option, field `, f.GetName(), ` is not`)
+		}
+		if fo := p.getFieldOption(f); (fo.GetMinItems() != 0 || fo.GetMaxItems() != 0) && (!f.IsRepeated() || p.IsMap(f)) {
+			p.Fail(`min_items and max_items options are allowed only for repeated fields, field `, f.GetName(), ` is not`)
+		} else if fo.GetMaxItems() != 0 && fo.GetMinItems() > fo.GetMaxItems() {
+			p.Fail(`min_items option of field `, f.GetName(), ` exceeds max_items`)
+		}
+		if fo := p.getFieldOption(f); fo.GetReadOnly() && (len(fo.GetRequired()) != 0 || fo.GetRequiredIf() != "" || fo.GetDefault() != "") {
+			p.Fail(`read_only field `, f.GetName(), ` cannot be required or have a default value`)
+		}
+
+		if p.getFieldOption(f).GetNonNullable() && !f.IsMessage() {
+			p.Fail(`non_nullable option is allowed only for message fields, field `, f.GetName(), ` is `, f.GetType().String())
+		}
+
 		p.P(`case "`, f.GetName(), `":`)
 
-		if p.IsMap(f) {
-			continue
+		if p.hasFieldRules(f) {
+			p.P(runtimePkg.Use(), `.MarkCovered(ctx, `, p.joinPath(), `(path, k))`)
 		}
 
-		if fExt, err := proto.GetExtension(f.Options, av_opts.E_Field); err == nil && fExt != nil {
-			favOpt := fExt.(*av_opts.AtlasValidateFieldOption)
+		if n := p.getFieldOption(f).GetMaxFieldBytes(); n != 0 {
+			p.P(`if `, p.ruleGuard(o, f, "max_field_bytes"), `len(v[k]) > `, int(n), ` {`)
+			p.renderFieldError(fmtPkg.Use(), `.Errorf("field %q is too large", `, p.joinPath(), `(path, k))`)
+			p.P(`}`)
+		}
+
+		if p.getFieldOption(f).GetNonNullable() {
+			p.P(`if `, p.ruleGuard(o, f, "non_nullable"), `string(v[k]) == "null" {`)
+			p.renderFieldError(fmtPkg.Use(), `.Errorf("field %q may not be null", `, p.joinPath(), `(path, k))`)
+			p.P(`}`)
+		}
+
+		if p.isTextField(f) {
+			p.P(`if err = `, runtimePkg.Use(), `.CountText(ctx, v[k]); err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		}
+
+		if favOpt := p.fieldOption(f.Options); favOpt != nil {
 			methods := p.GetDeniedMethods(favOpt.GetDeny())
+			kind, errArgs := "deny", []interface{}{fmtPkg.Use(), `.Errorf("field %q is unsupported for %q operation`, p.allowedMethodsSuffix(methods), `.", k, method)`}
+			if favOpt.GetReadOnly() {
+				// read_only denies every write operation, deny option adds nothing to it
+				methods = p.writeMethods()
+				kind, errArgs = "read_only", []interface{}{fmtPkg.Use(), `.Errorf("field %q is read-only", `, p.joinPath(), `(path, k))`}
+			}
 			if len(methods) != 0 {
 				cond := strings.Join(methods, `" || method == "`)
 				p.P(`method := `, runtimePkg.Use(), `.HTTPMethodFromContext(ctx)`)
-				p.P(`if method == "`, cond, `" {`)
-				p.P(`return `, fmtPkg.Use(), `.Errorf("field %q is unsupported for %q operation.", k, method)`)
+				p.P(`if `, p.ruleGuard(o, f, kind), `(method == "`, cond, `") {`)
+				p.renderCodedFieldError("CodeDenied", p.joinPath()+`(path, k)`, errArgs...)
 				p.P("}")
 			}
 		}
 
+		if name := p.getFieldOption(f).GetPathVariable(); name != "" {
+			if f.IsMessage() || f.IsRepeated() {
+				p.Fail(`path_variable option is allowed only for singular scalar fields, field `, f.GetName(), ` is not`)
+			}
+			p.P(`if err = `, runtimePkg.Use(), `.ValidatePathVariable(ctx, v[k], `, p.joinPath(), `(path, k), "`, name, `"); `, p.ruleGuard(o, f, "path_variable"), `err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		}
+
+		if p.renderStringField(o, f) {
+			continue
+		}
+
+		if p.IsMap(f) {
+			p.renderMapField(o, f)
+			continue
+		}
+
+		if p.localEnum(f) != nil || p.externalEnum(f) != nil {
+			p.renderEnumField(o, f)
+			continue
+		}
+
+		if p.renderScalarField(o, f) {
+			continue
+		}
+
 		if f.IsMessage() && f.IsRepeated() {
 
 			p.P(`if v[k] == nil {`)
 			p.P(`continue`)
 			p.P(`}`)
 			p.P(`var vArr []`, jsonPkg.Use(), `.RawMessage`)
-			p.P(`vArrPath := `, runtimePkg.Use(), `.JoinPath(path, k)`)
+			p.P(`vArrPath := `, p.joinPath(), `(path, k)`)
 			p.P(`if err = `, jsonPkg.Use(), `.Unmarshal(v[k], &vArr); err != nil {`)
-			p.P(`return `, fmtPkg.Use(), `.Errorf("invalid value for %q: expected array.", vArrPath)`)
+			p.renderCodedFieldError("CodeTypeMismatch", `vArrPath`, fmtPkg.Use(), `.Errorf("invalid value for %q: expected array.", vArrPath)`)
 			p.P(`}`)
+			p.renderArrayChecks(o, f)
 
 			if p.isWKT(f.GetTypeName()) {
+				p.renderWKTField(o, f)
 				continue
 			}
 
+			p.renderFieldAllowUnknown(f)
 			fo := p.objectNamed(f.GetTypeName())
 			ft := p.TypeName(fo)
 
@@ -333,14 +1201,14 @@ func (p *Plugin) renderValidatorObjectMethod(o *descriptor.DescriptorProto, t st
 				p.P(`}`)
 			}
 			p.P(`for i, vv := range vArr {`)
-			p.P(`vvPath := `, fmtPkg.Use(), `.Sprintf("%s.[%d]", vArrPath, i)`)
+			p.P(`vvPath := `, p.joinIndex(), `(vArrPath, i)`)
 			if p.isLocal(fo) {
 				p.P(`if err = validate_Object_`, ft, `(ctx, vv, vvPath); err != nil {`)
-				p.P(`return err`)
+				p.renderFieldError(`err`)
 				p.P(`}`)
 			} else {
 				p.P(`if err = validator.AtlasValidateJSON(ctx, vv, vvPath); err != nil {`)
-				p.P(`return err`)
+				p.renderFieldError(`err`)
 				p.P(`}`)
 			}
 			p.P(`}`)
@@ -348,20 +1216,24 @@ func (p *Plugin) renderValidatorObjectMethod(o *descriptor.DescriptorProto, t st
 		} else if f.IsMessage() {
 
 			if p.isWKT(f.GetTypeName()) {
+				p.renderWKTField(o, f)
 				continue
 			}
 
 			fo := p.objectNamed(f.GetTypeName())
 			ft := p.TypeName(fo)
 
-			p.P(`if v[k] == nil {`)
+			// null message is the same as absent one and its required
+			// fields must not be enforced.
+			p.P(`if v[k] == nil || string(v[k]) == "null" {`)
 			p.P(`continue`)
 			p.P(`}`)
+			p.renderFieldAllowUnknown(f)
 			p.P(`vv := v[k]`)
-			p.P(`vvPath := `, runtimePkg.Use(), `.JoinPath(path, k)`)
+			p.P(`vvPath := `, p.joinPath(), `(path, k)`)
 			if p.isLocal(fo) {
 				p.P(`if err = validate_Object_`, ft, `(ctx, vv, vvPath); err != nil {`)
-				p.P(`return err`)
+				p.renderFieldError(`err`)
 				p.P(`}`)
 			} else {
 				p.P(`validator, ok := `, p.generateAtlasValidateJSONInterfaceSignature(ft))
@@ -369,18 +1241,33 @@ func (p *Plugin) renderValidatorObjectMethod(o *descriptor.DescriptorProto, t st
 				p.P(`continue`)
 				p.P(`}`)
 				p.P(`if err = validator.AtlasValidateJSON(ctx, vv, vvPath); err != nil {`)
-				p.P(`return err`)
+				p.renderFieldError(`err`)
 				p.P(`}`)
 			}
 		}
 	}
 
+	if extra := p.getIgnoreExtraFields(o, t); len(extra) != 0 {
+		p.P(`case "`, strings.Join(extra, `", "`), `":`)
+	}
 	p.P(`default:`)
+	p.P(`if handler := `, runtimePkg.Use(), `.GetUnknownFieldHandler(); handler != nil {`)
+	p.P(`if err = handler(ctx, path, k, v[k]); err != nil {`)
+	p.renderFieldError(`err`)
+	p.P(`}`)
+	p.P(`continue`)
+	p.P(`}`)
 	p.P(`if !allowUnknown {`)
-	p.P(`return `, fmtPkg.Use(), `.Errorf("unknown field %q.", `, runtimePkg.Use(), `.JoinPath(path, k))`)
+	p.renderCodedFieldError("CodeUnknownField", p.joinPath()+`(path, k)`, fmtPkg.Use(), `.Errorf("unknown field %q.", `, p.joinPath(), `(path, k))`)
 	p.P(`}`)
 	p.P(`}`)
 	p.P(`}`)
+	p.renderCELChecks(o, t)
+	if p.collectErrors {
+		p.P(`if len(errs) != 0 {`)
+		p.P(`return `, runtimePkg.Use(), `.JoinErrors(errs)`)
+		p.P(`}`)
+	}
 	p.P(`return nil`)
 	p.P(`}`)
 	p.P()
@@ -388,7 +1275,11 @@ func (p *Plugin) renderValidatorObjectMethod(o *descriptor.DescriptorProto, t st
 	p.P(`// AtlasValidateJSON function validates a JSON for object `, t, `.`)
 	p.P(`func (_ *`, t, `) AtlasValidateJSON(ctx `, ctxPkg.Use(), `.Context, r `, jsonPkg.Use(), `.RawMessage, path string) (err error) {`)
 	p.P(`if hook, ok := `, p.generateAtlasJSONValidateInterfaceSignature(t), `; ok {`)
+	p.P(runtimePkg.Use(), `.MarkHookCalled(ctx)`)
 	p.P(`if r, err = hook.AtlasJSONValidate(ctx, r, path); err != nil {`)
+	p.P(`if err == `, runtimePkg.Use(), `.ErrSkipValidation {`)
+	p.P(`return nil`)
+	p.P(`}`)
 	p.P(`return err`)
 	p.P(`}`)
 	p.P(`}`)
@@ -397,6 +1288,140 @@ func (p *Plugin) renderValidatorObjectMethod(o *descriptor.DescriptorProto, t st
 	p.P()
 }
 
+// getIgnoreExtraFields function returns ignore_extra_fields option of a message,
+// duplicate names and names that clash with declared fields are not allowed.
+func (p *Plugin) getIgnoreExtraFields(o *descriptor.DescriptorProto, t string) []string {
+	extra := p.messageOption(o.Options).GetIgnoreExtraFields()
+	for i, name := range extra {
+		for _, other := range extra[:i] {
+			if other == name {
+				p.Fail(`ignore_extra_fields option of message "`, t, `" contains duplicate field `, name)
+			}
+		}
+		for _, f := range o.GetField() {
+			if f.GetName() == name {
+				p.Fail(`ignore_extra_fields option of message "`, t, `" contains declared field `, name)
+			}
+		}
+	}
+
+	return extra
+}
+
+// getMaxFields function returns max_fields option of a message or, if it is not
+// set, max_fields option of a file being generated.
+func (p *Plugin) getMaxFields(o *descriptor.DescriptorProto) uint32 {
+	if n := p.messageOption(o.Options).GetMaxFields(); n != 0 {
+		return n
+	}
+
+	return p.fileOption(p.file.Options).GetMaxFields()
+}
+
+// getMaxTotalElements function returns max_total_elements option of a method or,
+// if it is not set, max_total_elements option of a file.
+func (p *Plugin) getMaxTotalElements(file proto.Message, method proto.Message) uint32 {
+	if n := p.methodOption(method).GetMaxTotalElements(); n != 0 {
+		return n
+	}
+
+	return p.fileOption(file).GetMaxTotalElements()
+}
+
+// getMaxTotalTextBytes function returns max_total_text_bytes option of a file.
+func (p *Plugin) getMaxTotalTextBytes(file proto.Message) uint32 {
+	return p.fileOption(file).GetMaxTotalTextBytes()
+}
+
+// isTextField function reports whether values of a field are counted against
+// max_total_text_bytes limit: string fields, repeated ones and maps with string values.
+func (p *Plugin) isTextField(f *descriptor.FieldDescriptorProto) bool {
+	if p.IsMap(f) {
+		_, vf := p.mapEntryFields(f)
+		return vf.GetType() == descriptor.FieldDescriptorProto_TYPE_STRING
+	}
+
+	return f.GetType() == descriptor.FieldDescriptorProto_TYPE_STRING
+}
+
+// renderArrayChecks function generates counting of elements of a decoded vArr
+// array against max_total_elements limit of a request and checks of min_items
+// and max_items options of a field.
+func (p *Plugin) renderArrayChecks(o *descriptor.DescriptorProto, f *descriptor.FieldDescriptorProto) {
+
+	var (
+		fmtPkg     = p.Import(fmtPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	p.P(`if err = `, runtimePkg.Use(), `.CountElements(ctx, len(vArr)); err != nil {`)
+	p.renderFieldError(`err`)
+	p.P(`}`)
+
+	if n := p.getFieldOption(f).GetMinItems(); n != 0 {
+		p.P(`if `, p.ruleGuard(o, f, "min_items"), `vArr != nil && len(vArr) < `, int(n), ` {`)
+		p.renderFieldError(fmtPkg.Use(), `.Errorf("field %q must have at least %d items", vArrPath, `, int(n), `)`)
+		p.P(`}`)
+	}
+	if n := p.getFieldOption(f).GetMaxItems(); n != 0 {
+		p.P(`if `, p.ruleGuard(o, f, "max_items"), `len(vArr) > `, int(n), ` {`)
+		p.renderFieldError(fmtPkg.Use(), `.Errorf("field %q must have at most %d items", vArrPath, `, int(n), `)`)
+		p.P(`}`)
+	}
+}
+
+// getMaxFutureSkew function returns max_future_skew option of a Timestamp field
+// rendered as time.Duration expression.
+func (p *Plugin) getMaxFutureSkew(f *descriptor.FieldDescriptorProto) (string, bool) {
+	skew := p.getFieldOption(f).GetMaxFutureSkew()
+	if skew == "" {
+		return "", false
+	}
+
+	if f.GetTypeName() != ".google.protobuf.Timestamp" {
+		p.Fail(`max_future_skew option is allowed only for google.protobuf.Timestamp fields, field `, f.GetName(), ` is `, f.GetTypeName())
+	}
+
+	d, err := time.ParseDuration(skew)
+	if err != nil {
+		p.Fail(`invalid max_future_skew option of field `, f.GetName(), `: `, err.Error())
+	}
+
+	return fmt.Sprintf("%s.Duration(%d)", p.Import(timePkgPath).Use(), int64(d)), true
+}
+
+// ruleGuard function returns a condition prefix that consults runtime.RuleEnabled
+// for a rule of kind applied to field f of message o. Rule ID has a form of
+// <package>.<Message>.<field>.<kind>, e.g. "examplepb.User.name.required".
+// The prefix is empty unless rule_guards parameter is set.
+func (p *Plugin) ruleGuard(o *descriptor.DescriptorProto, f *descriptor.FieldDescriptorProto, kind string) string {
+	if !p.ruleGuards {
+		return ""
+	}
+
+	id := p.messageNames[o] + "." + f.GetName() + "." + kind
+	return p.Import(runtimePkgPath).Use() + `.RuleEnabled(ctx, "` + id + `") && `
+}
+
+// hasFieldRules function reports whether generated validator applies any rule to
+// a field value, such fields are reported to runtime coverage collector.
+func (p *Plugin) hasFieldRules(f *descriptor.FieldDescriptorProto) bool {
+	favOpt := p.getFieldOption(f)
+	return (f.IsMessage() && (f.IsRepeated() || !p.isWKT(f.GetTypeName()) || wrapperKinds[f.GetTypeName()] != "" || (p.strictWKT && wktKinds[f.GetTypeName()] != ""))) || p.localEnum(f) != nil || p.externalEnum(f) != nil || len(favOpt.GetDeny()) != 0 || len(favOpt.GetRequired()) != 0 || favOpt.GetMaxFutureSkew() != "" || favOpt.GetFormat() != "" || favOpt.GetPattern() != "" || favOpt.GetInSet() != "" || favOpt.GetPathVariable() != "" || favOpt.GetMaxFieldBytes() != 0 || favOpt.GetMaxLength() != 0 || favOpt.GetMinBound() != nil || favOpt.GetMaxBound() != nil || favOpt.GetMinItems() != 0 || favOpt.GetMaxItems() != 0 || favOpt.GetReadOnly() || favOpt.GetNonNullable()
+}
+
+// renderFieldAllowUnknown function generates a context that allows unknown fields
+// in objects nested in a field with allow_unknown_fields option or rejects them in
+// objects nested in a field with strict option.
+func (p *Plugin) renderFieldAllowUnknown(f *descriptor.FieldDescriptorProto) {
+	fo := p.getFieldOption(f)
+	if !fo.GetAllowUnknownFields() && !fo.GetStrict() {
+		return
+	}
+
+	p.P(`ctx := `, p.Import(ctxPkgPath).Use(), `.WithValue(ctx, `, p.Import(runtimePkgPath).Use(), `.AllowUnknownContextKey, `, fo.GetAllowUnknownFields(), `)`)
+}
+
 func (p *Plugin) generateAtlasValidateJSONInterfaceSignature(t string) string {
 
 	var (
@@ -431,21 +1456,79 @@ func (p *Plugin) renderAnnotator() {
 
 	p.P(`// AtlasValidateAnnotator parses JSON input and validates unknown fields`)
 	p.P(`// based on 'allow_unknown_fields' options specified in proto file.`)
+	p.P(`// Validators and hooks get a context derived from ctx, so its values, deadline`)
+	p.P(`// and cancellation are preserved, runtime.Options of ctx tune validation.`)
+	if p.optInHeader != "" {
+		p.P(`// A request is validated only if its `, p.optInHeader, ` header is true.`)
+	}
 	p.P(`func AtlasValidateAnnotator(ctx `, ctxPkg.Use(), `.Context, r *`, httpPkg.Use(), `.Request) `, metadataPkg.Use(), `.MD {`)
 	p.P(`md := make(`, metadataPkg.Use(), `.MD)`)
+	if p.optInHeader != "" {
+		p.P(`if optIn, _ := `, p.Import(strconvPkgPath).Use(), `.ParseBool(r.Header.Get("`, p.optInHeader, `")); !optIn {`)
+		p.P(`return md`)
+		p.P(`}`)
+	}
+	p.P(`errorHeader := `, runtimePkg.Use(), `.ErrorHeader(ctx, "`, p.errorHeader, `")`)
 
-	p.P(`for _, v := range validate_Patterns {`)
-	p.P(`if r.Method == v.httpMethod && `, runtimePkg.Use(), `.PatternMatch(v.pattern, r.URL.Path) {`)
+	p.P(`for _, i := range validate_PatternsByMethod[r.Method] {`)
+	p.P(`v := validate_Patterns[i]`)
+	p.P(`if pathVars, ok := `, runtimePkg.Use(), `.PatternVariables(v.pattern, r.URL.Path); ok {`)
+	p.P(`md.Set("Atlas-Validation-Method", v.method)`)
+	p.P(`md.Set("Atlas-Validation-Allow-Unknown", `, p.Import(strconvPkgPath).Use(), `.FormatBool(v.allowUnknown))`)
 	p.P(`var b []byte`)
 	p.P(`var err error`)
 	p.P(`if b, err = `, ioutilPkg.Use(), `.ReadAll(r.Body); err != nil {`)
-	p.P(`md.Set("Atlas-Validation-Error", "invalid value: unable to parse body")`)
+	p.P(`md.Set(errorHeader, "invalid value: unable to parse body")`)
 	p.P(`return md`)
 	p.P(`}`)
 	p.P(`r.Body = `, ioutilPkg.Use(), `.NopCloser(`, bytesPkg.Use(), `.NewReader(b))`)
-	p.P(`ctx := `, ctxPkg.Use(), `.WithValue(`, ctxPkg.Use(), `.WithValue(`, ctxPkg.Use(), `.Background(), `, runtimePkg.Use(), `.HTTPMethodContextKey, r.Method), `, runtimePkg.Use(), `.AllowUnknownContextKey, v.allowUnknown)`)
-	p.P(`if err = v.validator(ctx, b); err != nil {`)
-	p.P(`md.Set("Atlas-Validation-Error", err.Error())`)
+	p.P(`ctx := `, ctxPkg.Use(), `.WithValue(`, ctxPkg.Use(), `.WithValue(ctx, `, runtimePkg.Use(), `.HTTPMethodContextKey, r.Method), `, runtimePkg.Use(), `.AllowUnknownContextKey, v.allowUnknown)`)
+	p.P(`ctx = `, ctxPkg.Use(), `.WithValue(`, ctxPkg.Use(), `.WithValue(ctx, `, runtimePkg.Use(), `.PathVariablesContextKey, pathVars), `, runtimePkg.Use(), `.HTTPPathContextKey, r.URL.Path)`)
+	p.P(`ctx = `, runtimePkg.Use(), `.WithFoldPackage(ctx, "`, p.file.GetPackage(), `")`)
+	p.P(`form := v.formValidator != nil && `, runtimePkg.Use(), `.IsFormContentType(r.Header.Get("Content-Type"))`)
+	if p.relaxedJSON {
+		p.P(`if !form {`)
+		p.P(`if b, err = `, runtimePkg.Use(), `.RelaxJSON(b); err != nil {`)
+		p.P(`md.Set(errorHeader, "invalid value: unable to parse body")`)
+		p.P(`return md`)
+		p.P(`}`)
+		p.P(`r.Body = `, ioutilPkg.Use(), `.NopCloser(`, bytesPkg.Use(), `.NewReader(b))`)
+		p.P(`r.ContentLength = int64(len(b))`)
+		p.P(`}`)
+	}
+	p.P(`var cacheKey string`)
+	p.P(`if !form {`)
+	p.P(`cacheKey = `, runtimePkg.Use(), `.ValidationCacheKey(ctx, "`, p.file.GetPackage(), `:"+v.pattern.String()+" "+r.URL.Path, r.Method, v.allowUnknown, r.URL.RawQuery, b)`)
+	p.P(`}`)
+	p.P(`if cacheKey != "" {`)
+	p.P(`ctx = `, runtimePkg.Use(), `.TrackHooks(ctx)`)
+	p.P(`}`)
+	p.P(`if !`, runtimePkg.Use(), `.ValidationCached(cacheKey) {`)
+	p.P(`if form {`)
+	p.P(`// form bodies are not normalized, their keys are matched case-sensitively`)
+	p.P(`err = `, runtimePkg.Use(), `.ValidateForm(`, runtimePkg.Use(), `.WithFoldPackage(ctx, ""), b, v.formValidator)`)
+	p.P(`} else if err = v.validator(ctx, b); err == nil && v.queryValidator != nil {`)
+	p.P(`err = v.queryValidator(ctx, r.URL.Query())`)
+	p.P(`}`)
+	p.P(`if err != nil {`)
+	p.P(`md.Set(errorHeader, `, p.errorMessage(), `)`)
+	p.P(`return md`)
+	p.P(`}`)
+	p.P(`if !`, runtimePkg.Use(), `.HooksCalled(ctx) {`)
+	p.P(runtimePkg.Use(), `.CacheValidation(cacheKey)`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`if !form {`)
+	p.P(`var normalized []string`)
+	p.P(`if b, normalized, err = `, runtimePkg.Use(), `.Normalize(ctx, b, v.defaulter); err != nil {`)
+	p.P(`md.Set(errorHeader, err.Error())`)
+	p.P(`return md`)
+	p.P(`}`)
+	p.P(`if len(normalized) != 0 {`)
+	p.P(`md.Set("Atlas-Validation-Normalized", normalized...)`)
+	p.P(`r.Body = `, ioutilPkg.Use(), `.NopCloser(`, bytesPkg.Use(), `.NewReader(b))`)
+	p.P(`r.ContentLength = int64(len(b))`)
+	p.P(`}`)
 	p.P(`}`)
 	p.P(`break`)
 	p.P(`}`)
@@ -455,6 +1538,192 @@ func (p *Plugin) renderAnnotator() {
 	p.P()
 }
 
+// renderReaderValidator function generates AtlasValidateReader function that
+// validates a body read from io.Reader with validators of the first pattern
+// matching an HTTP method and a path, the way AtlasValidateAnnotator does.
+func (p *Plugin) renderReaderValidator() {
+
+	var (
+		ctxPkg     = p.Import(ctxPkgPath)
+		ioPkg      = p.Import(ioPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	p.P(`// AtlasValidateReader validates a JSON body read from r of a request with HTTP method`)
+	p.P(`// and URL path the same way AtlasValidateAnnotator does, without an *http.Request.`)
+	p.P(`// Reading stops at the end of the body value, a request that matches no pattern`)
+	p.P(`// is not validated and r is not read. It is not a streaming validator: the body`)
+	p.P(`// value is buffered as a whole before validation, as by AtlasValidateAnnotator.`)
+	p.P(`func AtlasValidateReader(ctx `, ctxPkg.Use(), `.Context, method, path string, r `, ioPkg.Use(), `.Reader) error {`)
+	p.P(`for _, i := range validate_PatternsByMethod[method] {`)
+	p.P(`v := validate_Patterns[i]`)
+	p.P(`pathVars, ok := `, runtimePkg.Use(), `.PatternVariables(v.pattern, path)`)
+	p.P(`if !ok {`)
+	p.P(`continue`)
+	p.P(`}`)
+	if p.relaxedJSON {
+		// relaxed JSON is not parsed by json.Decoder and is read as a whole
+		p.P(`b, err := `, p.Import(ioutilPkgPath).Use(), `.ReadAll(r)`)
+		p.P(`if err == nil {`)
+		p.P(`b, err = `, runtimePkg.Use(), `.RelaxJSON(b)`)
+		p.P(`}`)
+		p.P(`if err != nil {`)
+		p.P(`return `, p.Import(fmtPkgPath).Use(), `.Errorf("invalid value: unable to parse body")`)
+		p.P(`}`)
+	} else {
+		p.P(`b, err := `, runtimePkg.Use(), `.ReadJSON(r)`)
+		p.P(`if err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+	}
+	p.P(`ctx = `, ctxPkg.Use(), `.WithValue(`, ctxPkg.Use(), `.WithValue(ctx, `, runtimePkg.Use(), `.HTTPMethodContextKey, method), `, runtimePkg.Use(), `.AllowUnknownContextKey, v.allowUnknown)`)
+	p.P(`ctx = `, ctxPkg.Use(), `.WithValue(`, ctxPkg.Use(), `.WithValue(ctx, `, runtimePkg.Use(), `.PathVariablesContextKey, pathVars), `, runtimePkg.Use(), `.HTTPPathContextKey, path)`)
+	p.P(`return v.validator(ctx, b)`)
+	p.P(`}`)
+	p.P(`return nil`)
+	p.P(`}`)
+	p.P()
+}
+
+// renderRequestValidators function generates Validate<Type> function per local
+// input message of methods, it validates a request body for an HTTP method
+// the same way the annotator does for a matched pattern. If several methods take
+// the message with the same HTTP method, the first one declared is used.
+func (p *Plugin) renderRequestValidators() {
+
+	var (
+		ctxPkg     = p.Import(ctxPkgPath)
+		fmtPkg     = p.Import(fmtPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	var files []string
+	for f := range p.methods {
+		files = append(files, f)
+	}
+
+	sort.StringSlice(files).Sort()
+
+	var types []string
+	byType := make(map[string][]*methodDescriptor)
+	for _, f := range files {
+		for _, m := range p.methods[f] {
+			if p.isWKT(m.inputType) || !p.isLocal(p.ObjectNamed(m.inputType)) {
+				continue
+			}
+			if _, ok := byType[m.inputType]; !ok {
+				types = append(types, m.inputType)
+			}
+			byType[m.inputType] = append(byType[m.inputType], m)
+		}
+	}
+
+	for _, it := range types {
+		t := p.TypeName(p.ObjectNamed(it))
+
+		p.P(`// Validate`, t, ` validates body of a request with `, t, ` input for HTTP method,`)
+		p.P(`// e.g. "POST", the same way AtlasValidateAnnotator does it.`)
+		p.P(`func Validate`, t, `(ctx `, ctxPkg.Use(), `.Context, body []byte, method string) error {`)
+		p.P(`switch method {`)
+		seen := make(map[string]bool)
+		for _, m := range byType[it] {
+			if seen[m.httpMethod] {
+				continue
+			}
+			seen[m.httpMethod] = true
+			p.P(`case "`, m.httpMethod, `":`)
+			p.P(`ctx = `, ctxPkg.Use(), `.WithValue(`, ctxPkg.Use(), `.WithValue(ctx, `, runtimePkg.Use(), `.HTTPMethodContextKey, method), `, runtimePkg.Use(), `.AllowUnknownContextKey, `, m.allowUnknown, `)`)
+			p.P(`return validate_`, m.gwPattern, `(ctx, body)`)
+		}
+		p.P(`}`)
+		p.P(`return `, fmtPkg.Use(), `.Errorf("%q operation is not supported for `, t, `", method)`)
+		p.P(`}`)
+		p.P()
+	}
+}
+
+// renderSelfTest function generates AtlasValidateSelfTest function that verifies
+// entries of validate_Patterns with runtime.SelfTest.
+func (p *Plugin) renderSelfTest() {
+
+	runtimePkg := p.Import(runtimePkgPath)
+
+	p.P(`// AtlasValidateSelfTest verifies that validators of all patterns are set up`)
+	p.P(`// and do not panic on an empty request, it is intended to be called at startup.`)
+	p.P(`func AtlasValidateSelfTest() error {`)
+	p.P(`for _, v := range validate_Patterns {`)
+	p.P(`if err := `, runtimePkg.Use(), `.SelfTest(v.pattern, v.httpMethod, v.allowUnknown, v.validator, v.defaulter, v.queryValidator, v.formValidator); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`return nil`)
+	p.P(`}`)
+	p.P()
+}
+
+// renderPatternsAccessor function generates AtlasValidatePatterns function that
+// exposes entries of validate_Patterns along with their allow_unknown_fields.
+func (p *Plugin) renderPatternsAccessor() {
+
+	gwruntimePkg := p.Import(gwruntimePkgPath)
+
+	p.P(`// AtlasValidatePattern describes an HTTP binding validated by AtlasValidateAnnotator.`)
+	p.P(`type AtlasValidatePattern struct {`)
+	p.P(`HTTPMethod string`)
+	p.P(`// AllowUnknown reports whether unknown fields of a body are accepted.`)
+	p.P(`AllowUnknown bool`)
+	p.P(`Pattern `, gwruntimePkg.Use(), `.Pattern`)
+	p.P(`}`)
+	p.P()
+	p.P(`// AtlasValidatePatterns returns HTTP bindings validated by AtlasValidateAnnotator in`)
+	p.P(`// order they are matched.`)
+	p.P(`func AtlasValidatePatterns() []AtlasValidatePattern {`)
+	p.P(`patterns := make([]AtlasValidatePattern, 0, len(validate_Patterns))`)
+	p.P(`for _, v := range validate_Patterns {`)
+	p.P(`patterns = append(patterns, AtlasValidatePattern{HTTPMethod: v.httpMethod, AllowUnknown: v.allowUnknown, Pattern: v.pattern})`)
+	p.P(`}`)
+	p.P(`return patterns`)
+	p.P(`}`)
+	p.P()
+}
+
+// renderRegistration function generates RegisterAtlasValidators function that
+// registers validators of entries of validate_Patterns with runtime.Registrar.
+func (p *Plugin) renderRegistration() {
+
+	var (
+		ctxPkg     = p.Import(ctxPkgPath)
+		jsonPkg    = p.Import(jsonPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	p.P(`// RegisterAtlasValidators registers validators of HTTP bindings validated by`)
+	p.P(`// AtlasValidateAnnotator with reg in order they are matched, a registered validator`)
+	p.P(`// validates a body the same way AtlasValidateReader does for a matching path, a body`)
+	p.P(`// of a path that does not match the pattern of the validator is not validated.`)
+	p.P(`func RegisterAtlasValidators(reg `, runtimePkg.Use(), `.Registrar) {`)
+	p.P(`for _, v := range validate_Patterns {`)
+	p.P(`v := v`)
+	p.P(`reg.RegisterValidator(v.httpMethod, v.pattern, func(ctx `, ctxPkg.Use(), `.Context, path string, body `, jsonPkg.Use(), `.RawMessage) error {`)
+	p.P(`pathVars, ok := `, runtimePkg.Use(), `.PatternVariables(v.pattern, path)`)
+	p.P(`if !ok {`)
+	p.P(`return nil`)
+	p.P(`}`)
+	if p.relaxedJSON {
+		p.P(`body, err := `, runtimePkg.Use(), `.RelaxJSON(body)`)
+		p.P(`if err != nil {`)
+		p.P(`return `, p.Import(fmtPkgPath).Use(), `.Errorf("invalid value: unable to parse body")`)
+		p.P(`}`)
+	}
+	p.P(`ctx = `, ctxPkg.Use(), `.WithValue(`, ctxPkg.Use(), `.WithValue(ctx, `, runtimePkg.Use(), `.HTTPMethodContextKey, v.httpMethod), `, runtimePkg.Use(), `.AllowUnknownContextKey, v.allowUnknown)`)
+	p.P(`ctx = `, ctxPkg.Use(), `.WithValue(`, ctxPkg.Use(), `.WithValue(ctx, `, runtimePkg.Use(), `.PathVariablesContextKey, pathVars), `, runtimePkg.Use(), `.HTTPPathContextKey, path)`)
+	p.P(`return v.validator(ctx, body)`)
+	p.P(`})`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P()
+}
+
 //Return methods to which field marked as denied
 func (p *Plugin) GetDeniedMethods(options []av_opts.AtlasValidateFieldOption_Operation) []string {
 	httpMethods := make(map[string]struct{}, 0)
@@ -466,6 +1735,10 @@ func (p *Plugin) GetDeniedMethods(options []av_opts.AtlasValidateFieldOption_Ope
 			httpMethods["PATCH"] = struct{}{}
 		case av_opts.AtlasValidateFieldOption_replace:
 			httpMethods["PUT"] = struct{}{}
+		case av_opts.AtlasValidateFieldOption_custom:
+			for _, m := range p.customMethods {
+				httpMethods[m] = struct{}{}
+			}
 		}
 	}
 
@@ -478,6 +1751,30 @@ func (p *Plugin) GetDeniedMethods(options []av_opts.AtlasValidateFieldOption_Ope
 	return uniqueMethods
 }
 
+// writeMethods function returns sorted HTTP methods of every operation of deny
+// and required options: create, update, replace and custom ones.
+func (p *Plugin) writeMethods() []string {
+	return p.GetDeniedMethods([]av_opts.AtlasValidateFieldOption_Operation{av_opts.AtlasValidateFieldOption_create, av_opts.AtlasValidateFieldOption_update, av_opts.AtlasValidateFieldOption_replace, av_opts.AtlasValidateFieldOption_custom})
+}
+
+// allowedMethodsSuffix function returns a suffix of deny error that lists HTTP
+// methods a field is not denied for, e.g. "; allowed: [PATCH, PUT]". The suffix
+// is empty unless verbose_errors parameter is set.
+func (p *Plugin) allowedMethodsSuffix(denied []string) string {
+	if !p.verboseErrors {
+		return ""
+	}
+
+	allowed := make([]string, 0)
+	for _, m := range p.writeMethods() {
+		if i := sort.SearchStrings(denied, m); i == len(denied) || denied[i] != m {
+			allowed = append(allowed, m)
+		}
+	}
+
+	return "; allowed: [" + strings.Join(allowed, ", ") + "]"
+}
+
 //Return methods to which field marked as required
 func (p *Plugin) GetRequiredMethods(options []av_opts.AtlasValidateFieldOption_Operation) []string {
 	requiredMethods := make(map[string]struct{}, 0)
@@ -489,6 +1786,10 @@ func (p *Plugin) GetRequiredMethods(options []av_opts.AtlasValidateFieldOption_O
 			requiredMethods["PATCH"] = struct{}{}
 		case av_opts.AtlasValidateFieldOption_replace:
 			requiredMethods["PUT"] = struct{}{}
+		case av_opts.AtlasValidateFieldOption_custom:
+			for _, m := range p.customMethods {
+				requiredMethods[m] = struct{}{}
+			}
 		}
 	}
 
@@ -501,6 +1802,27 @@ func (p *Plugin) GetRequiredMethods(options []av_opts.AtlasValidateFieldOption_O
 	return uniqueMethods
 }
 
+// implicitPresence function reports whether a field of a message has no presence:
+// a scalar, enum, repeated or map field of a proto3 message that is not a member of
+// a oneof. A body marshaled from a proto message omits such a field that holds a zero
+// value, so absence of the field from the body does not tell it was not set.
+func (p *Plugin) implicitPresence(md *descriptor.DescriptorProto, fd *descriptor.FieldDescriptorProto) bool {
+	m, ok := p.messages["."+p.messageNames[md]]
+	return ok && m.proto3 && (!fd.IsMessage() || fd.IsRepeated()) && fd.OneofIndex == nil
+}
+
+// fieldRequiredMethods function returns HTTP methods a field is required for:
+// methods of its required option or, for a proto2 required field without the
+// option, all write methods.
+func (p *Plugin) fieldRequiredMethods(fd *descriptor.FieldDescriptorProto) []string {
+	methods := p.GetRequiredMethods(p.fieldOption(fd.Options).GetRequired())
+	if len(methods) == 0 && fd.IsRequired() {
+		methods = p.GetRequiredMethods([]av_opts.AtlasValidateFieldOption_Operation{av_opts.AtlasValidateFieldOption_create, av_opts.AtlasValidateFieldOption_update, av_opts.AtlasValidateFieldOption_replace})
+	}
+
+	return methods
+}
+
 func (p *Plugin) generateValidateRequired(md *descriptor.DescriptorProto, t string) {
 
 	var (
@@ -511,20 +1833,32 @@ func (p *Plugin) generateValidateRequired(md *descriptor.DescriptorProto, t stri
 	)
 
 	requiredFields := make(map[string][]string)
+	requiredIf := make(map[string]string)
+	fieldDescriptors := make(map[string]*descriptor.FieldDescriptorProto)
 	for _, fd := range md.GetField() {
-		if fExt, err := proto.GetExtension(fd.Options, av_opts.E_Field); err == nil && fExt != nil {
-			favOpt := fExt.(*av_opts.AtlasValidateFieldOption)
-			methods := p.GetRequiredMethods(favOpt.GetRequired())
-			if len(methods) == 0 {
-				continue
+		fieldDescriptors[fd.GetName()] = fd
+	}
+	for _, fd := range md.GetField() {
+		methods := p.fieldRequiredMethods(fd)
+		if ref := p.fieldOption(fd.Options).GetRequiredIf(); ref != "" {
+			if _, ok := fieldDescriptors[ref]; !ok || ref == fd.GetName() {
+				p.Fail(`required_if option of field "`, t, `.`, fd.GetName(), `" must refer to another field of the message, got `, ref)
 			}
-			requiredFields[fd.GetName()] = methods
+			requiredIf[fd.GetName()] = ref
+		} else if len(methods) == 0 {
+			continue
 		}
+		requiredFields[fd.GetName()] = methods
 	}
 
 	p.P(`func validate_required_Object_`, t, `(ctx `, ctxPkg.Use(), `.Context, v map[string]`, jsonPkg.Use(), `.RawMessage, path string) error {`)
 	p.P(`method := `, runtimePkg.Use(), `.HTTPMethodFromContext(ctx)`)
 	p.P(`_ = method`)
+	p.P(`marshaled := `, runtimePkg.Use(), `.MarshaledFromContext(ctx)`)
+	p.P(`_ = marshaled`)
+	if p.collectErrors {
+		p.P(`var errs []error`)
+	}
 
 	var fields []string
 	for v := range requiredFields {
@@ -533,21 +1867,44 @@ func (p *Plugin) generateValidateRequired(md *descriptor.DescriptorProto, t stri
 
 	sort.StringSlice(fields).Sort()
 
+	masked := p.renderUpdateMask(md, t, requiredFields)
+
 	for _, fn := range fields {
 		methods := requiredFields[fn]
-		if len(methods) == 3 {
-			p.P(`if _, ok := v["`, fn, `"]; !ok {`)
-			p.P(`path = `, runtimePkg.Use(), `.JoinPath(path, "`, fn, `")`)
-			p.P(`return `, fmtPkg.Use(), `.Errorf("field %q is required for %q operation.", path, method)`)
-			p.P(`}`)
+		fd := fieldDescriptors[fn]
+		kind := "required"
+		if _, ok := requiredIf[fn]; ok {
+			kind = "required_if"
+		}
+		guard := p.ruleGuard(md, fd, kind)
+		presence := `_, ok := v["` + fn + `"]; ` + guard + `!ok`
+		if p.requiredRejectsEmptyString() && fd.GetType() == descriptor.FieldDescriptorProto_TYPE_STRING && !fd.IsRepeated() {
+			presence = `vv, ok := v["` + fn + `"]; ` + guard + `(!ok || string(vv) == ` + "`" + `""` + "`" + `)`
+		}
+		if p.implicitPresence(md, fd) {
+			presence += ` && !marshaled`
+		}
+		for _, m := range methods {
+			if masked && m == "PATCH" {
+				// a field out of the mask of a PATCH request is not updated
+				presence += ` && !` + updateMaskExcludes(fd)
+			}
+		}
+		if ref, ok := requiredIf[fn]; ok {
+			p.renderRequiredIf(fn, ref, presence, methods)
 		} else {
 			cond := strings.Join(methods, `" || method == "`)
-			p.P(`if _, ok := v["`, fn, `"]; !ok && (method == "`, cond, `") {`)
-			p.P(`path = `, runtimePkg.Use(), `.JoinPath(path, "`, fn, `")`)
-			p.P(`return `, fmtPkg.Use(), `.Errorf("field %q is required for %q operation.", path, method)`)
+			p.P(`if `, presence, ` && (method == "`, cond, `") {`)
+			p.renderObjectError(p.withCode("CodeRequired", p.joinPath()+`(path, "`+fn+`")`, fmtPkg.Use(), `.Errorf("field %q is required for %q operation.", `, p.joinPath(), `(path, "`, fn, `"), method)`)...)
 			p.P(`}`)
 		}
 	}
-	p.P(`return nil`)
+	p.renderRequiredForType(md, t)
+	p.renderAtLeastOneOf(md, t)
+	if p.collectErrors {
+		p.P(`return `, runtimePkg.Use(), `.JoinErrors(errs)`)
+	} else {
+		p.P(`return nil`)
+	}
 	p.P(`}`)
 }
@@ -0,0 +1,27 @@
+package plugin
+
+import (
+	"github.com/gogo/protobuf/gogoproto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+)
+
+// useGolangProtoImport function makes the generator import github.com/golang/protobuf/proto
+// into a generated file instead of gogo's package if the plugin is run with
+// runtime=protobuf-go parameter, the package is implemented on google.golang.org/protobuf
+// since v1.4. The generator picks the package by gogoproto.gogoproto_import option of
+// the file, it is true unless set. Generated validators use the package only in the
+// generator's reference to proto.Marshal.
+func (p *Plugin) useGolangProtoImport(file *generator.FileDescriptor) {
+	if !p.protobufGo {
+		return
+	}
+
+	if file.Options == nil {
+		file.Options = &descriptor.FileOptions{}
+	}
+	if err := proto.SetExtension(file.Options, gogoproto.E_GogoprotoImport, proto.Bool(false)); err != nil {
+		p.Fail(`failed to disable gogo proto import of "`, file.GetName(), `": `, err.Error())
+	}
+}
@@ -0,0 +1,205 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// wktQueryKinds maps well-known types that grpc-gateway accepts in query parameters
+// to kinds of runtime.ValidateQueryValue.
+var wktQueryKinds = map[string]string{
+	".google.protobuf.Timestamp":   "timestamp",
+	".google.protobuf.StringValue": "string",
+	".google.protobuf.BytesValue":  "bytes",
+	".google.protobuf.Int32Value":  "int64",
+	".google.protobuf.Int64Value":  "int64",
+	".google.protobuf.UInt32Value": "uint64",
+	".google.protobuf.UInt64Value": "uint64",
+	".google.protobuf.FloatValue":  "double",
+	".google.protobuf.DoubleValue": "double",
+	".google.protobuf.BoolValue":   "bool",
+}
+
+// queryKind function returns a kind of runtime.ValidateQueryValue for a field or
+// empty string if values of the field are not validated.
+func (p *Plugin) queryKind(f *descriptor.FieldDescriptorProto) string {
+	if f.IsMessage() && !f.IsRepeated() {
+		return wktQueryKinds[f.GetTypeName()]
+	}
+
+	return p.scalarKind(f)
+}
+
+// isQueryMessage function reports whether query parameters mapped onto a message t
+// are validated, that is t is a local message with fields.
+func (p *Plugin) isQueryMessage(t string) bool {
+	obj, ok := p.messages[t]
+	return ok && obj.local && !p.isWKT(t) && len(obj.GetField()) != 0
+}
+
+// markQueryTypes function marks a message t and local messages reachable through
+// its singular fields as ones that need validate_Query_Object_ function.
+func (p *Plugin) markQueryTypes(t string) {
+	if p.queryTypes[t] || !p.isQueryMessage(t) {
+		return
+	}
+	p.queryTypes[t] = true
+
+	for _, f := range p.messages[t].GetField() {
+		if f.IsMessage() && !f.IsRepeated() {
+			p.markQueryTypes(f.GetTypeName())
+		}
+	}
+}
+
+// queryConstraints function returns generators of checks of min, max, format,
+// in_set, pattern and max_length options of a field that are applied to values of
+// a query parameter mapped onto the field, within a function of a JSON value r at
+// path that ValidateQueryConstraints calls.
+func (p *Plugin) queryConstraints(o *descriptor.DescriptorProto, f *descriptor.FieldDescriptorProto) []func() {
+
+	runtimePkg := p.Import(runtimePkgPath)
+
+	var checks []func()
+	check := func(rule, call string) {
+		checks = append(checks, func() {
+			p.P(`if err = `, runtimePkg.Use(), call, `; `, p.ruleGuard(o, f, rule), `err != nil {`)
+			p.P(`return err`)
+			p.P(`}`)
+		})
+	}
+
+	kind := p.valueKind(f)
+	if isNumericKind(kind) {
+		min, max := p.getBounds(f)
+		if min != nil {
+			check("min", fmt.Sprint(`.ValidateMin(r, path, "`, kind, `", `, *min, `)`))
+		}
+		if max != nil {
+			check("max", fmt.Sprint(`.ValidateMax(r, path, "`, kind, `", `, *max, `)`))
+		}
+	}
+
+	if f.GetType() != descriptor.FieldDescriptorProto_TYPE_STRING && f.GetType() != descriptor.FieldDescriptorProto_TYPE_BYTES {
+		return checks
+	}
+
+	if name := p.getFormat(f); name != "" {
+		check("format", `.ValidateFormat(r, path, "`+name+`")`)
+	}
+	if name := p.getInSet(f); name != "" {
+		check("in_set", `.ValidateInSet(r, path, "`+name+`")`)
+	}
+	if expr := p.getPattern(f); expr != "" {
+		check("pattern", `.ValidatePattern(r, path, `+p.patternVar(o, f)+`)`)
+	}
+	if n := p.getMaxLength(f); n != 0 {
+		check("max_length", fmt.Sprint(`.ValidateMaxLength(r, path, `, int(n), `, "`, p.scalarKind(f), `")`))
+	}
+
+	return checks
+}
+
+// renderQueryObjectMethod function generates validate_Query_Object_ function that
+// validates a query parameter (or a field of a form body) mapped onto a field of a
+// given object: values of scalar and well-known wrapper fields must conform to their
+// types and options of the fields (see queryConstraints), enum values must be declared
+// and map fields take "name[key]" syntax.
+// Parameters that do not match any field are handled by
+// runtime.ValidateUnknownQueryParameter.
+func (p *Plugin) renderQueryObjectMethod(o *descriptor.DescriptorProto, t string) {
+
+	var (
+		ctxPkg     = p.Import(ctxPkgPath)
+		jsonPkg    = p.Import(jsonPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	p.P(`// validate_Query_Object_`, t, ` function validates a query parameter for a given object.`)
+	p.P(`func validate_Query_Object_`, t, `(ctx `, ctxPkg.Use(), `.Context, fieldPath []string, values []string, key string) error {`)
+	p.P(`switch fieldPath[0] {`)
+
+	for _, f := range o.GetField() {
+
+		kind := p.queryKind(f)
+		nested := f.IsMessage() && !f.IsRepeated() && p.isQueryMessage(f.GetTypeName())
+
+		if f.GetJsonName() != "" && f.GetJsonName() != f.GetName() {
+			p.P(`case "`, f.GetName(), `", "`, f.GetJsonName(), `":`)
+		} else {
+			p.P(`case "`, f.GetName(), `":`)
+		}
+
+		if p.IsMap(f) {
+			kf, vf := p.mapEntryFields(f)
+			keyKind, valueKind := p.scalarKind(kf), p.scalarKind(vf)
+			if keyKind == "string" {
+				keyKind = ""
+			}
+			if valueKind == "string" {
+				valueKind = ""
+			}
+			if p.localEnum(vf) == nil && p.externalEnum(vf) == nil {
+				p.P(`return `, runtimePkg.Use(), `.ValidateQueryMapEntry(ctx, key, fieldPath, values, "`, keyKind, `", "`, valueKind, `")`)
+				continue
+			}
+			p.P(`if err := `, runtimePkg.Use(), `.ValidateQueryMapEntry(ctx, key, fieldPath, values, "`, keyKind, `", ""); err != nil {`)
+			p.P(`return err`)
+			p.P(`}`)
+			p.P(`return `, runtimePkg.Use(), `.ValidateQueryEnum(ctx, key, fieldPath, values[1:], false, func(r `, jsonPkg.Use(), `.RawMessage) error {`)
+			p.P(`return `, p.enumCheck(vf, `r`, `key`))
+			p.P(`})`)
+			continue
+		}
+
+		if p.localEnum(f) != nil || p.externalEnum(f) != nil {
+			p.P(`return `, runtimePkg.Use(), `.ValidateQueryEnum(ctx, key, fieldPath, values, `, f.IsRepeated(), `, func(r `, jsonPkg.Use(), `.RawMessage) error {`)
+			p.P(`return `, p.enumCheck(f, `r`, `key`))
+			p.P(`})`)
+			continue
+		}
+
+		if kind == "" && !nested {
+			// other fields (e.g. repeated messages) are left to grpc-gateway
+			p.P(`return nil`)
+			continue
+		}
+
+		if nested {
+			p.P(`if len(fieldPath) == 1 {`)
+			p.P(`return `, runtimePkg.Use(), `.QueryParameterError(ctx, key, ": expected a nested field.")`)
+			p.P(`}`)
+			p.P(`return validate_Query_Object_`, p.TypeName(p.objectNamed(f.GetTypeName())), `(ctx, fieldPath[1:], values, key)`)
+			continue
+		}
+
+		if checks := p.queryConstraints(o, f); len(checks) != 0 {
+			p.P(`if err := `, runtimePkg.Use(), `.ValidateQueryValue(ctx, key, fieldPath, values, "`, kind, `", `, f.IsRepeated(), `); err != nil {`)
+			p.P(`return err`)
+			p.P(`}`)
+			p.P(`return `, runtimePkg.Use(), `.ValidateQueryConstraints(ctx, key, values, func(r `, jsonPkg.Use(), `.RawMessage, path string) (err error) {`)
+			for _, check := range checks {
+				check()
+			}
+			p.P(`return nil`)
+			p.P(`})`)
+			continue
+		}
+
+		if skew, ok := p.getMaxFutureSkew(f); ok {
+			p.P(`if err := `, runtimePkg.Use(), `.ValidateQueryValue(ctx, key, fieldPath, values, "`, kind, `", `, f.IsRepeated(), `); err != nil {`)
+			p.P(`return err`)
+			p.P(`}`)
+			p.P(`return `, runtimePkg.Use(), `.ValidateQueryMaxFutureSkew(ctx, key, values, `, skew, `)`)
+			continue
+		}
+
+		p.P(`return `, runtimePkg.Use(), `.ValidateQueryValue(ctx, key, fieldPath, values, "`, kind, `", `, f.IsRepeated(), `)`)
+	}
+
+	p.P(`}`)
+	p.P(`return `, runtimePkg.Use(), `.ValidateUnknownQueryParameter(ctx, key)`)
+	p.P(`}`)
+	p.P()
+}
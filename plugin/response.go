@@ -0,0 +1,65 @@
+package plugin
+
+// renderResponseValidatorMethod function generates an entrypoint that validates a
+// response body of a method binding against the output message of the method.
+func (p *Plugin) renderResponseValidatorMethod(m *methodDescriptor) {
+
+	var (
+		jsonPkg = p.Import(jsonPkgPath)
+		ctxPkg  = p.Import(ctxPkgPath)
+	)
+
+	p.P(`// validate_response_`, m.gwPattern, ` is an entrypoint for validating a response body of "`, m.httpMethod, `" HTTP request`)
+	p.P(`// that match *.pb.gw.go/pattern_`, m.gwPattern, `.`)
+	p.P(`func validate_response_`, m.gwPattern, `(ctx `, ctxPkg.Use(), `.Context, r `, jsonPkg.Use(), `.RawMessage) (err error) {`)
+	if p.isWKT(m.outputType) {
+		p.P(`return nil`)
+	} else {
+		o := p.objectNamed(m.outputType)
+		t := p.TypeName(o)
+		if p.isLocal(o) {
+			p.P(`return validate_Object_`, t, `(ctx, r, "")`)
+		} else {
+			p.P(`if validator, ok := `, p.generateAtlasValidateJSONInterfaceSignature(t), `; ok {`)
+			p.P(`return validator.AtlasValidateJSON(ctx, r, "")`)
+			p.P(`}`)
+			p.P(`return nil`)
+		}
+	}
+	p.P(`}`)
+	p.P()
+}
+
+// renderResponseValidator function generates AtlasValidateResponse function that
+// validates a response body of a request matched to validate_Patterns.
+func (p *Plugin) renderResponseValidator() {
+
+	var (
+		httpPkg    = p.Import(httpPkgPath)
+		ctxPkg     = p.Import(ctxPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	p.P(`// AtlasValidateResponse validates a JSON body of a response to r against the output`)
+	p.P(`// message of a method whose pattern r matches, e.g. in tests or a response interceptor.`)
+	p.P(`// Rules scoped to operations (deny, read_only, required) do not apply to responses,`)
+	p.P(`// a response to a request that matches no pattern or whose Accept header does not`)
+	p.P(`// allow JSON is not validated.`)
+	p.P(`func AtlasValidateResponse(ctx `, ctxPkg.Use(), `.Context, r *`, httpPkg.Use(), `.Request, body []byte) error {`)
+	p.P(`if !`, runtimePkg.Use(), `.AcceptsJSON(r.Header.Get("Accept")) {`)
+	p.P(`return nil`)
+	p.P(`}`)
+	p.P(`for _, i := range validate_PatternsByMethod[r.Method] {`)
+	p.P(`v := validate_Patterns[i]`)
+	p.P(`if pathVars, ok := `, runtimePkg.Use(), `.PatternVariables(v.pattern, r.URL.Path); ok {`)
+	p.P(`// operation scoped rules are matched against a method in context, a response has none`)
+	p.P(`ctx := `, ctxPkg.Use(), `.WithValue(`, ctxPkg.Use(), `.WithValue(ctx, `, runtimePkg.Use(), `.HTTPMethodContextKey, ""), `, runtimePkg.Use(), `.AllowUnknownContextKey, v.allowUnknown)`)
+	p.P(`ctx = `, ctxPkg.Use(), `.WithValue(ctx, `, runtimePkg.Use(), `.PathVariablesContextKey, pathVars)`)
+	p.P(`ctx = `, ctxPkg.Use(), `.WithValue(ctx, `, runtimePkg.Use(), `.HTTPPathContextKey, r.URL.Path)`)
+	p.P(`return v.responseValidator(ctx, body)`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`return nil`)
+	p.P(`}`)
+	p.P()
+}
@@ -0,0 +1,196 @@
+package plugin
+
+import (
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// scalarKind function returns a kind of runtime.ValidateScalar for a scalar field
+// or empty string if the field is not a scalar one (messages, enums and groups).
+func (p *Plugin) scalarKind(f *descriptor.FieldDescriptorProto) string {
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return "bool"
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32, descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return "int32"
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SINT64, descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return "int64"
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return "uint32"
+	case descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return "uint64"
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return "float"
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return "double"
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return "string"
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return "bytes"
+	}
+
+	return ""
+}
+
+// wrapperKinds maps well-known wrapper types, that proto3 JSON mapping represents
+// as their wrapped scalar values, to kinds of runtime.ValidateScalar.
+var wrapperKinds = map[string]string{
+	".google.protobuf.StringValue": "string",
+	".google.protobuf.BytesValue":  "bytes",
+	".google.protobuf.Int32Value":  "int32",
+	".google.protobuf.Int64Value":  "int64",
+	".google.protobuf.UInt32Value": "uint32",
+	".google.protobuf.UInt64Value": "uint64",
+	".google.protobuf.FloatValue":  "float",
+	".google.protobuf.DoubleValue": "double",
+	".google.protobuf.BoolValue":   "bool",
+}
+
+// valueKind function returns a kind of runtime.ValidateScalar for a scalar field
+// or a field of a wrapper type, empty string otherwise.
+func (p *Plugin) valueKind(f *descriptor.FieldDescriptorProto) string {
+	if f.IsMessage() {
+		return wrapperKinds[f.GetTypeName()]
+	}
+
+	return p.scalarKind(f)
+}
+
+// isIntegerKind function reports whether a kind of runtime.ValidateScalar is
+// an integer one.
+func isIntegerKind(kind string) bool {
+	switch kind {
+	case "int32", "int64", "uint32", "uint64":
+		return true
+	}
+
+	return false
+}
+
+// isNumericKind function reports whether a kind of runtime.ValidateScalar is
+// a numeric one.
+func isNumericKind(kind string) bool {
+	return isIntegerKind(kind) || kind == "float" || kind == "double"
+}
+
+// getBounds function returns min and max options of a numeric field, nil bound
+// means the option is not specified.
+func (p *Plugin) getBounds(f *descriptor.FieldDescriptorProto) (min, max *float64) {
+	favOpt := p.getFieldOption(f)
+	if favOpt.GetMinBound() != nil {
+		v := favOpt.GetMin()
+		min = &v
+	}
+	if favOpt.GetMaxBound() != nil {
+		v := favOpt.GetMax()
+		max = &v
+	}
+
+	if (min != nil || max != nil) && !isNumericKind(p.valueKind(f)) {
+		p.Fail(`min and max options are allowed only for numeric fields, field `, f.GetName(), ` is `, f.GetType().String())
+	}
+	if min != nil && max != nil && *min > *max {
+		p.Fail(`min option of field `, f.GetName(), ` is greater than max option`)
+	}
+
+	return min, max
+}
+
+// typeChecks function returns generators of checks that a value of a scalar field
+// or a field of a wrapper type conforms to its type: integers must not be JSON
+// booleans, values must be valid proto3 JSON of the (wrapped) scalar or null and
+// strings of bytes must be valid base64.
+func (p *Plugin) typeChecks(f *descriptor.FieldDescriptorProto) []func(value, path string) {
+
+	runtimePkg := p.Import(runtimePkgPath)
+
+	kind := p.valueKind(f)
+	if kind == "" {
+		return nil
+	}
+
+	var checks []func(value, path string)
+
+	if isIntegerKind(kind) && !f.IsMessage() {
+		checks = append(checks, func(value, path string) {
+			p.P(`if err = `, runtimePkg.Use(), `.ValidateNotBoolean(`, value, `, `, path, `); err != nil {`)
+			p.renderCodedFieldError("CodeTypeMismatch", path, `err`)
+			p.P(`}`)
+		})
+	}
+
+	checks = append(checks, func(value, path string) {
+		p.P(`if err = `, runtimePkg.Use(), `.ValidateScalar(`, value, `, `, path, `, "`, kind, `"); err != nil {`)
+		p.renderCodedFieldError("CodeTypeMismatch", path, `err`)
+		p.P(`}`)
+	})
+
+	if kind == "bytes" {
+		checks = append(checks, func(value, path string) {
+			p.P(`if err = `, runtimePkg.Use(), `.ValidateBase64(`, value, `, `, path, `); err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		})
+	}
+
+	return checks
+}
+
+// renderScalarField function generates validation of a scalar field or a field
+// of a wrapper type (or each element of a repeated one) within validate_Object_
+// function: values must conform to the type (see typeChecks) and numbers must be
+// within min and max options. It returns false if nothing is validated for the field.
+func (p *Plugin) renderScalarField(o *descriptor.DescriptorProto, f *descriptor.FieldDescriptorProto) bool {
+
+	var (
+		jsonPkg    = p.Import(jsonPkgPath)
+		fmtPkg     = p.Import(fmtPkgPath)
+		runtimePkg = p.Import(runtimePkgPath)
+	)
+
+	kind := p.valueKind(f)
+	checks := p.typeChecks(f)
+
+	min, max := p.getBounds(f)
+	if min != nil {
+		checks = append(checks, func(value, path string) {
+			p.P(`if err = `, runtimePkg.Use(), `.ValidateMin(`, value, `, `, path, `, "`, kind, `", `, *min, `); `, p.ruleGuard(o, f, "min"), `err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		})
+	}
+	if max != nil {
+		checks = append(checks, func(value, path string) {
+			p.P(`if err = `, runtimePkg.Use(), `.ValidateMax(`, value, `, `, path, `, "`, kind, `", `, *max, `); `, p.ruleGuard(o, f, "max"), `err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		})
+	}
+
+	if len(checks) == 0 {
+		return false
+	}
+
+	if !f.IsRepeated() {
+		for _, check := range checks {
+			check(`v[k]`, p.joinPath()+`(path, k)`)
+		}
+		return true
+	}
+
+	p.P(`if v[k] == nil || string(v[k]) == "null" {`)
+	p.P(`continue`)
+	p.P(`}`)
+	p.P(`var vArr []`, jsonPkg.Use(), `.RawMessage`)
+	p.P(`vArrPath := `, p.joinPath(), `(path, k)`)
+	p.P(`if err = `, jsonPkg.Use(), `.Unmarshal(v[k], &vArr); err != nil {`)
+	p.renderCodedFieldError("CodeTypeMismatch", `vArrPath`, fmtPkg.Use(), `.Errorf("invalid value for %q: expected array.", vArrPath)`)
+	p.P(`}`)
+	p.renderArrayChecks(o, f)
+	p.P(`for i, vv := range vArr {`)
+	for _, check := range checks {
+		check(`vv`, p.joinIndex()+`(vArrPath, i)`)
+	}
+	p.P(`}`)
+
+	return true
+}
@@ -0,0 +1,115 @@
+package plugin
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	gogoplugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+)
+
+// SingleFile function combines generated files into a single file named by
+// single_file parameter in their directory if the plugin is run with it, files
+// are returned as is otherwise. Imports of the files are merged and declarations
+// follow in order of the files, so all of them must be of one Go package.
+func (p *Plugin) SingleFile(generated []*gogoplugin.CodeGeneratorResponse_File) []*gogoplugin.CodeGeneratorResponse_File {
+	if p.singleFile == "" || len(generated) == 0 {
+		return generated
+	}
+
+	var (
+		header  string
+		dir     string
+		pkg     string
+		sources []string
+		imports []string
+		decls   bytes.Buffer
+	)
+
+	seen := make(map[string]bool)
+	names := make(map[string]string)
+	for _, g := range generated {
+		content := g.GetContent()
+
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, g.GetName(), content, parser.ParseComments)
+		if err != nil {
+			p.Fail(`failed to parse generated file `, g.GetName(), `: `, err.Error())
+		}
+
+		if pkg == "" {
+			dir, pkg = path.Dir(g.GetName()), f.Name.Name
+			// package clause with its import comment
+			start := fset.Position(f.Package).Offset
+			header = content[start : start+strings.IndexByte(content[start:], '\n')]
+		} else if path.Dir(g.GetName()) != dir || f.Name.Name != pkg {
+			p.Fail(`single_file parameter requires files of one package, `, g.GetName(), ` is generated into another one`)
+		}
+
+		for _, line := range strings.Split(content[:fset.Position(f.Package).Offset], "\n") {
+			if strings.HasPrefix(line, "// source: ") {
+				sources = append(sources, line)
+			}
+		}
+
+		for _, spec := range f.Imports {
+			ipath, _ := strconv.Unquote(spec.Path.Value)
+			name := path.Base(ipath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			if prev, ok := names[name]; ok && prev != ipath && name != "_" {
+				p.Fail(`single_file parameter cannot combine imports of `, prev, ` and `, ipath, ` named `, name)
+			}
+			names[name] = ipath
+
+			if spec := name + " " + spec.Path.Value; !seen[spec] {
+				seen[spec] = true
+				imports = append(imports, spec)
+			}
+		}
+
+		for _, d := range f.Decls {
+			if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+				continue
+			}
+			// declarations are copied from the first one that is not an import
+			// along with its doc comment
+			pos := d.Pos()
+			if gd, ok := d.(*ast.GenDecl); ok && gd.Doc != nil {
+				pos = gd.Doc.Pos()
+			} else if fd, ok := d.(*ast.FuncDecl); ok && fd.Doc != nil {
+				pos = fd.Doc.Pos()
+			}
+			decls.WriteString(content[fset.Position(pos).Offset:])
+			decls.WriteString("\n")
+			break
+		}
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by protoc-gen-gogo. DO NOT EDIT.\n")
+	b.WriteString(strings.Join(sources, "\n"))
+	b.WriteString("\n\n" + header + "\n\n")
+	for _, spec := range imports {
+		b.WriteString("import " + spec + "\n")
+	}
+	b.WriteString("\n")
+	b.Write(decls.Bytes())
+
+	content, err := format.Source(b.Bytes())
+	if err != nil {
+		p.Fail(`failed to format `, p.singleFile, `: `, err.Error())
+	}
+
+	return []*gogoplugin.CodeGeneratorResponse_File{{
+		Name:    proto.String(path.Join(dir, p.singleFile)),
+		Content: proto.String(string(content)),
+	}}
+}
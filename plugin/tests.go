@@ -0,0 +1,177 @@
+package plugin
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	gogoplugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+)
+
+// testCases holds table-driven test cases and benchmarks of a generated file.
+type testCases struct {
+	name       string
+	cases      string
+	benchmarks string
+}
+
+// testMethods lists HTTP methods table-driven tests are generated for.
+var testMethods = []string{"POST", "PUT", "PATCH"}
+
+// renderTestCases function collects cases of the table-driven test generated
+// with gen_tests=true parameter and benchmarks generated with gen_benchmarks=true
+// parameter for objects of a file being generated. Cases of every file are kept
+// in order of Generate calls, so that they match generated files of the response
+// in TestFiles.
+func (p *Plugin) renderTestCases() {
+	if !p.genTests && !p.genBenchmarks {
+		return
+	}
+
+	var b, bb bytes.Buffer
+	render := func(o *descriptor.DescriptorProto, t string) {
+		if p.genTests {
+			p.renderObjectTestCases(&b, o, t)
+		}
+		if p.genBenchmarks {
+			p.renderObjectBenchmark(&bb, o, t)
+		}
+	}
+	p.walkFileMessages(func(o *descriptor.DescriptorProto, ptype string) {
+		render(o, p.TypeName(p.objectNamed(ptype)))
+	})
+
+	name := strings.Map(func(r rune) rune {
+		if r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, strings.TrimSuffix(path.Base(p.file.GetName()), ".proto"))
+
+	p.tests = append(p.tests, testCases{name: name, cases: b.String(), benchmarks: bb.String()})
+}
+
+// renderObjectTestCases function writes test cases of validate_Object_ function
+// of a message for each of testMethods: an empty object is expected to fail on
+// fields and at_least_one_of groups required for the method or to pass along with
+// an unknown field failure if the method requires none.
+func (p *Plugin) renderObjectTestCases(b *bytes.Buffer, o *descriptor.DescriptorProto, t string) {
+
+	join := strings.TrimPrefix(p.joinPath(), p.Import(runtimePkgPath).Use()+".")
+
+	for _, method := range testMethods {
+		var required []string
+		for _, fd := range o.GetField() {
+			if p.fieldOption(fd.Options).GetRequiredIf() != "" {
+				continue
+			}
+			for _, m := range p.fieldRequiredMethods(fd) {
+				if m == method {
+					required = append(required, fd.GetName())
+				}
+			}
+		}
+		sort.Strings(required)
+
+		var expected []string
+		for _, fn := range required {
+			expected = append(expected, fmt.Sprintf(`fmt.Sprintf("field %%q is required for %%q operation.", runtime.%s("", %q), %q)`, join, fn, method))
+		}
+		for _, g := range p.getAtLeastOneOf(o, t) {
+			if g.requiredFor(method) {
+				expected = append(expected, fmt.Sprintf(`fmt.Sprintf("at least one of %%v is required", %s)`, g.names()))
+			}
+		}
+
+		if len(expected) != 0 {
+			if !p.collectErrors {
+				expected = expected[:1]
+			}
+			fmt.Fprintf(b, "{%q, validate_Object_%s, %q, `{}`, %s},\n", t+"/"+method+"/required", t, method, strings.Join(expected, ` + "\n" + `))
+			continue
+		}
+
+		// CEL expressions may reject an empty object on their own
+		if len(p.getCELExpressions(o)) == 0 {
+			fmt.Fprintf(b, "{%q, validate_Object_%s, %q, `{}`, \"\"},\n", t+"/"+method+"/empty", t, method)
+		}
+		fmt.Fprintf(b, "{%q, validate_Object_%s, %q, `{\"atlas_validate_unknown_field\": null}`, fmt.Sprintf(\"unknown field %%q.\", runtime.%s(\"\", \"atlas_validate_unknown_field\"))},\n", t+"/"+method+"/unknown", t, method, join)
+	}
+}
+
+// TestFiles function returns *.pb.atlas.validate_test.go files generated with
+// gen_tests=true or gen_benchmarks=true parameter, one per generated file of the
+// response.
+func (p *Plugin) TestFiles(generated []*gogoplugin.CodeGeneratorResponse_File) []*gogoplugin.CodeGeneratorResponse_File {
+	var files []*gogoplugin.CodeGeneratorResponse_File
+
+	for i, tc := range p.tests {
+		if (tc.cases == "" && tc.benchmarks == "") || i >= len(generated) {
+			continue
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), "", generated[i].GetContent(), parser.PackageClauseOnly)
+		if err != nil {
+			p.Fail(`failed to parse generated file `, generated[i].GetName(), `: `, err.Error())
+		}
+
+		var b bytes.Buffer
+		fmt.Fprintln(&b, `// Code generated by protoc-gen-atlas-validate. DO NOT EDIT.`)
+		fmt.Fprintln(&b)
+		fmt.Fprintf(&b, "package %s\n\n", f.Name.Name)
+		fmtImport := ""
+		if tc.cases != "" {
+			fmtImport = "\"fmt\"\n"
+		}
+		fmt.Fprintf(&b, "import (\n\"context\"\n\"encoding/json\"\n%s\"testing\"\n\n%q\n)\n\n", fmtImport, runtimePkgPath)
+		if tc.cases != "" {
+			fmt.Fprintf(&b, "func TestAtlasValidateObjects_%s(t *testing.T) {\n", tc.name)
+			b.WriteString(`tests := []struct {
+name      string
+validator func(context.Context, json.RawMessage, string) error
+method    string
+body      string
+expected  string
+}{
+`)
+			b.WriteString(tc.cases)
+			b.WriteString(`}
+
+for _, test := range tests {
+ctx := context.WithValue(context.Background(), runtime.HTTPMethodContextKey, test.method)
+ctx = context.WithValue(ctx, runtime.AllowUnknownContextKey, false)
+
+var msg string
+if err := test.validator(ctx, json.RawMessage(test.body), ""); err != nil {
+msg = err.Error()
+}
+if msg != test.expected {
+t.Errorf("%s: expected error %q, got %q", test.name, test.expected, msg)
+}
+}
+}
+
+`)
+		}
+		b.WriteString(tc.benchmarks)
+
+		content, err := format.Source(b.Bytes())
+		if err != nil {
+			p.Fail(`failed to format tests of `, generated[i].GetName(), `: `, err.Error())
+		}
+
+		files = append(files, &gogoplugin.CodeGeneratorResponse_File{
+			Name:    proto.String(strings.TrimSuffix(generated[i].GetName(), ".go") + "_test.go"),
+			Content: proto.String(string(content)),
+		})
+	}
+
+	return files
+}
@@ -0,0 +1,67 @@
+package plugin
+
+import (
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// getUpdateMaskField function returns a field named by update_mask_field option of
+// a message or nil if the option is not set, the field must be a FieldMask.
+func (p *Plugin) getUpdateMaskField(o *descriptor.DescriptorProto, t string) *descriptor.FieldDescriptorProto {
+	fn := p.messageOption(o.Options).GetUpdateMaskField()
+	if fn == "" {
+		return nil
+	}
+
+	for _, f := range o.GetField() {
+		if f.GetName() != fn {
+			continue
+		}
+		if f.GetTypeName() != ".google.protobuf.FieldMask" || f.IsRepeated() {
+			p.Fail(`update_mask_field option of message "`, t, `" must refer to a google.protobuf.FieldMask field, got `, fn)
+		}
+		return f
+	}
+
+	p.Fail(`update_mask_field option of message "`, t, `" refers to unknown field `, fn)
+	return nil
+}
+
+// renderUpdateMask function generates parsing of an update mask of a PATCH request
+// within validate_required_Object_ function if a message has update_mask_field
+// option and some of required fields is required for update operation, it returns
+// whether mask variable is declared.
+func (p *Plugin) renderUpdateMask(o *descriptor.DescriptorProto, t string, requiredFields map[string][]string) bool {
+	f := p.getUpdateMaskField(o, t)
+	if f == nil {
+		return false
+	}
+
+	update := false
+	for _, methods := range requiredFields {
+		for _, m := range methods {
+			update = update || m == "PATCH"
+		}
+	}
+	if !update {
+		return false
+	}
+
+	runtimePkg := p.Import(runtimePkgPath)
+
+	p.P(`var mask `, runtimePkg.Use(), `.UpdateMask`)
+	p.P(`if method == "PATCH" {`)
+	p.P(`mask = `, runtimePkg.Use(), `.ParseUpdateMask(v["`, f.GetName(), `"])`)
+	p.P(`}`)
+	return true
+}
+
+// updateMaskExcludes function returns a condition that a field is not in paths of
+// an update mask declared by renderUpdateMask, mask paths use JSON names.
+func updateMaskExcludes(f *descriptor.FieldDescriptorProto) string {
+	names := `"` + f.GetName() + `"`
+	if f.GetJsonName() != "" && f.GetJsonName() != f.GetName() {
+		names += `, "` + f.GetJsonName() + `"`
+	}
+
+	return `mask.Excludes(` + names + `)`
+}
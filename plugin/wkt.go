@@ -0,0 +1,62 @@
+package plugin
+
+import (
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// wktKinds maps well-known types that proto3 JSON mapping represents specially
+// to kinds of runtime.ValidateWKT.
+var wktKinds = map[string]string{
+	".google.protobuf.Timestamp": "timestamp",
+	".google.protobuf.Duration":  "duration",
+	".google.protobuf.FieldMask": "field_mask",
+	".google.protobuf.Struct":    "struct",
+	".google.protobuf.ListValue": "list_value",
+	".google.protobuf.Any":       "any",
+	".google.protobuf.Empty":     "empty",
+}
+
+// renderWKTField function generates validation of a value of a well-known type
+// field (or each element of a repeated one) within validate_Object_ function:
+// max_future_skew option of a Timestamp and, with strict_wkt=true parameter, JSON
+// representation of the type. Values of well-known types are never validated as
+// objects. The caller has already unmarshaled a repeated field into vArr.
+func (p *Plugin) renderWKTField(o *descriptor.DescriptorProto, f *descriptor.FieldDescriptorProto) {
+
+	runtimePkg := p.Import(runtimePkgPath)
+
+	var checks []func(value, path string)
+
+	if kind := wktKinds[f.GetTypeName()]; p.strictWKT && kind != "" {
+		checks = append(checks, func(value, path string) {
+			p.P(`if err = `, runtimePkg.Use(), `.ValidateWKT(`, value, `, `, path, `, "`, kind, `"); err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		})
+	}
+
+	if skew, ok := p.getMaxFutureSkew(f); ok {
+		checks = append(checks, func(value, path string) {
+			p.P(`if err = `, runtimePkg.Use(), `.ValidateMaxFutureSkew(`, value, `, `, path, `, `, skew, `); `, p.ruleGuard(o, f, "max_future_skew"), `err != nil {`)
+			p.renderFieldError(`err`)
+			p.P(`}`)
+		})
+	}
+
+	if len(checks) == 0 {
+		return
+	}
+
+	if !f.IsRepeated() {
+		for _, check := range checks {
+			check(`v[k]`, p.joinPath()+`(path, k)`)
+		}
+		return
+	}
+
+	p.P(`for i, vv := range vArr {`)
+	for _, check := range checks {
+		check(`vv`, p.joinIndex()+`(vArrPath, i)`)
+	}
+	p.P(`}`)
+}
@@ -0,0 +1,40 @@
+package runtime
+
+import (
+	"mime"
+	"strconv"
+	"strings"
+)
+
+// AcceptsJSON function reports whether a value of Accept header allows a JSON
+// response: it is empty or one of its media ranges with non-zero quality is
+// "*/*", "application/*", "application/json" or "application/<type>+json".
+// Generated AtlasValidateResponse functions use it to gate validation of responses
+// so that binary or streaming responses sharing a route are not validated.
+func AcceptsJSON(accept string) bool {
+	if strings.TrimSpace(accept) == "" {
+		return true
+	}
+
+	for _, r := range strings.Split(accept, ",") {
+		mt, params, err := mime.ParseMediaType(r)
+		if err != nil {
+			continue
+		}
+
+		if q, ok := params["q"]; ok {
+			if v, err := strconv.ParseFloat(q, 64); err != nil || v <= 0 {
+				continue
+			}
+		}
+
+		switch {
+		case mt == "*/*", mt == "application/*", mt == "application/json":
+			return true
+		case strings.HasPrefix(mt, "application/") && strings.HasSuffix(mt, "+json"):
+			return true
+		}
+	}
+
+	return false
+}
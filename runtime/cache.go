@@ -0,0 +1,146 @@
+package runtime
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// validationCache is an LRU set of keys of requests that passed validation.
+type validationCache struct {
+	mu    sync.Mutex
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+var (
+	validationCacheMu sync.RWMutex
+	validCache        *validationCache
+
+	// registryGeneration is incremented by registrations of value sets and formats,
+	// keys of requests validated against replaced ones no longer match.
+	registryGeneration uint64
+)
+
+// registryChanged function records a registration that may change validation
+// outcome of cached requests.
+func registryChanged() {
+	atomic.AddUint64(&registryGeneration, 1)
+}
+
+// EnableValidationCache function enables a cache of up to size requests that passed
+// validation, AtlasValidateAnnotator skips validation of a request that is found in
+// the cache. Size less or equal to zero disables the cache.
+func EnableValidationCache(size int) {
+	var c *validationCache
+	if size > 0 {
+		c = &validationCache{size: size, ll: list.New(), items: make(map[string]*list.Element)}
+	}
+
+	validationCacheMu.Lock()
+	validCache = c
+	validationCacheMu.Unlock()
+}
+
+func getValidationCache() *validationCache {
+	validationCacheMu.RLock()
+	defer validationCacheMu.RUnlock()
+	return validCache
+}
+
+// ValidationCacheKey function returns a key of a request that includes everything
+// that affects validation outcome: route with the request path (path variables may be
+// validated), HTTP method, allowUnknown flag, query, body, the maximum nesting depth
+// (MaxDepth or MaxDepth option of ctx) and a generation of value sets and formats
+// registered at runtime. Other Options only change how errors are reported and
+// are left out. Empty key is returned if the cache is disabled or the outcome may
+// depend on ctx, that is a coverage collector is enabled or a rule policy, a tenant
+// policy or an unknown field handler is registered. A request whose validation calls
+// an AtlasJSONValidate hook is not cached either, see TrackHooks.
+func ValidationCacheKey(ctx context.Context, route string, method string, allowUnknown bool, query string, body []byte) string {
+	if getValidationCache() == nil || CoverageFromContext(ctx) != nil || hasRulePolicies() || GetUnknownFieldHandler() != nil {
+		return ""
+	}
+
+	h := sha256.New()
+	generation := strconv.FormatUint(atomic.LoadUint64(&registryGeneration), 10)
+	for _, s := range []string{route, method, strconv.FormatBool(allowUnknown), query, strconv.Itoa(maxDepth(ctx)), generation} {
+		h.Write([]byte(strconv.Itoa(len(s)) + ":" + s))
+	}
+	h.Write(body)
+
+	return string(h.Sum(nil))
+}
+
+// hookTracker records whether validation of a request called an AtlasJSONValidate hook.
+type hookTracker struct {
+	called int32
+}
+
+// TrackHooks function returns a context that records calls of AtlasJSONValidate
+// hooks by validators, see HooksCalled. Hooks get the context of a request and may
+// depend on anything it carries, so their outcome cannot be cached.
+func TrackHooks(ctx context.Context) context.Context {
+	return context.WithValue(ctx, HooksContextKey, &hookTracker{})
+}
+
+// MarkHookCalled function records a call of an AtlasJSONValidate hook, it does
+// nothing unless ctx is created with TrackHooks.
+func MarkHookCalled(ctx context.Context) {
+	if t, ok := ctx.Value(HooksContextKey).(*hookTracker); ok {
+		atomic.StoreInt32(&t.called, 1)
+	}
+}
+
+// HooksCalled function reports whether an AtlasJSONValidate hook was called with
+// ctx created with TrackHooks.
+func HooksCalled(ctx context.Context) bool {
+	t, ok := ctx.Value(HooksContextKey).(*hookTracker)
+	return ok && atomic.LoadInt32(&t.called) != 0
+}
+
+// ValidationCached function reports whether a request with key passed validation.
+func ValidationCached(key string) bool {
+	c := getValidationCache()
+	if c == nil || key == "" {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.items[key]
+	if ok {
+		c.ll.MoveToFront(e)
+	}
+
+	return ok
+}
+
+// CacheValidation function records that a request with key passed validation,
+// the least recently used key is evicted if the cache is full.
+func CacheValidation(key string) {
+	c := getValidationCache()
+	if c == nil || key == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.items[key]; ok {
+		c.ll.MoveToFront(e)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(key)
+	if c.ll.Len() > c.size {
+		e := c.ll.Back()
+		c.ll.Remove(e)
+		delete(c.items, e.Value.(string))
+	}
+}
@@ -0,0 +1,69 @@
+// Package cel provides runtime support for CEL expressions of generated validators.
+// The same environment is used by the plugin to type-check expressions at
+// generation time.
+package cel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	gocel "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types"
+)
+
+// ThisVar is a name of variable that holds the decoded JSON object.
+const ThisVar = "this"
+
+// Compile function parses and type-checks a CEL expression evaluated against
+// a decoded JSON object bound to ThisVar, the expression must result in bool.
+func Compile(expr string) (gocel.Program, error) {
+	env, err := gocel.NewEnv(gocel.Declarations(decls.NewIdent(ThisVar, decls.Dyn, nil)))
+	if err != nil {
+		return nil, err
+	}
+
+	ast, iss := env.Parse(expr)
+	if iss != nil && iss.Err() != nil {
+		return nil, iss.Err()
+	}
+
+	if ast, iss = env.Check(ast); iss != nil && iss.Err() != nil {
+		return nil, iss.Err()
+	}
+
+	if t := ast.ResultType(); !proto.Equal(t, decls.Bool) && !proto.Equal(t, decls.Dyn) {
+		return nil, fmt.Errorf("expression %q must result in bool", expr)
+	}
+
+	return env.Program(ast)
+}
+
+// MustCompile function is like Compile but panics if the expression cannot be compiled,
+// it is used to initialize package-level programs of generated code.
+func MustCompile(expr string) gocel.Program {
+	prg, err := Compile(expr)
+	if err != nil {
+		panic(err)
+	}
+
+	return prg
+}
+
+// Validate function evaluates a program against JSON r and returns an error with
+// message if the result is not true, an evaluation error (e.g. access to an absent
+// field) is reported the same way.
+func Validate(prg gocel.Program, r json.RawMessage, path string, message string) error {
+	var v interface{}
+	if err := json.Unmarshal(r, &v); err != nil {
+		return fmt.Errorf("invalid value for %q: expected object.", path)
+	}
+
+	out, _, err := prg.Eval(gocel.Vars(map[string]interface{}{ThisVar: v}))
+	if err != nil || out != types.True {
+		return fmt.Errorf("invalid value for %q: %s", path, message)
+	}
+
+	return nil
+}
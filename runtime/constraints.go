@@ -0,0 +1,40 @@
+package runtime
+
+// FieldConstraints describes validation rules declared with options of a field,
+// generated with constraints=true parameter into AtlasValidateConstraints variable
+// of a package, e.g. to document an API. Operations are given as HTTP methods:
+// POST (create), PUT (replace) and PATCH (update). Zero values mean no rule.
+type FieldConstraints struct {
+	// Required lists HTTP methods a field is required for, RequiredIf names a
+	// field of the same message that makes it required.
+	Required   []string
+	RequiredIf string
+
+	// Denied lists HTTP methods a field may not be set for, all of them for a
+	// ReadOnly field.
+	Denied   []string
+	ReadOnly bool
+
+	// Default is a JSON literal injected into a body without the field.
+	Default string
+
+	Format     string
+	Pattern    string
+	KeyPattern string
+	InSet      string
+
+	MaxLength     int
+	MaxFieldBytes int
+	Min           *float64
+	Max           *float64
+	MinItems      int
+	MaxItems      int
+
+	MaxFutureSkew string
+	NonNullable   bool
+}
+
+// Float64 function returns a pointer to v, e.g. for Min and Max of FieldConstraints.
+func Float64(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,113 @@
+package runtime
+
+import (
+	"strconv"
+	"strings"
+)
+
+// cronField describes bounds and names of values of a cron expression field.
+type cronField struct {
+	min, max int
+	names    []string
+	anyDay   bool
+}
+
+var (
+	cronSeconds = cronField{min: 0, max: 59}
+	cronMinutes = cronField{min: 0, max: 59}
+	cronHours   = cronField{min: 0, max: 23}
+	cronDom     = cronField{min: 1, max: 31, anyDay: true}
+	cronMonths  = cronField{min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	cronDow     = cronField{min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}, anyDay: true}
+
+	cronMacros = map[string]bool{
+		"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+		"@daily": true, "@midnight": true, "@hourly": true,
+	}
+)
+
+// isCron5 function reports whether s is a standard cron expression with minute,
+// hour, day of month, month and day of week fields or one of @yearly, @annually,
+// @monthly, @weekly, @daily, @midnight and @hourly.
+func isCron5(s string) bool {
+	return isCron(s, cronMinutes, cronHours, cronDom, cronMonths, cronDow)
+}
+
+// isCron6 function reports whether s is a cron expression that starts with a
+// seconds field followed by the standard ones or one of the macros.
+func isCron6(s string) bool {
+	return isCron(s, cronSeconds, cronMinutes, cronHours, cronDom, cronMonths, cronDow)
+}
+
+func isCron(s string, fields ...cronField) bool {
+	if cronMacros[s] {
+		return true
+	}
+
+	values := strings.Fields(s)
+	if len(values) != len(fields) {
+		return false
+	}
+
+	for i, v := range values {
+		if !fields[i].valid(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// valid method reports whether v is a comma separated list of "*", values or
+// ranges, each optionally followed by "/step". Days fields also accept "?".
+func (f cronField) valid(v string) bool {
+	if v == "?" {
+		return f.anyDay
+	}
+
+	for _, item := range strings.Split(v, ",") {
+		if i := strings.Index(item, "/"); i >= 0 {
+			step, err := strconv.Atoi(item[i+1:])
+			if err != nil || step <= 0 {
+				return false
+			}
+			item = item[:i]
+		}
+
+		if item == "*" {
+			continue
+		}
+
+		lo, hi := item, item
+		if i := strings.Index(item, "-"); i >= 0 {
+			lo, hi = item[:i], item[i+1:]
+		}
+
+		l, ok := f.value(lo)
+		if !ok {
+			return false
+		}
+		h, ok := f.value(hi)
+		if !ok || l > h {
+			return false
+		}
+	}
+
+	return true
+}
+
+// value method parses a number or a case-insensitive name of a field value.
+func (f cronField) value(s string) (int, bool) {
+	for i, n := range f.names {
+		if strings.EqualFold(s, n) {
+			return f.min + i, true
+		}
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, false
+	}
+
+	return n, true
+}
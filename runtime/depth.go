@@ -0,0 +1,41 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+)
+
+// MaxDepth is the maximum nesting depth of objects validated by generated
+// validators, it protects recursive messages (e.g. a tree) from payloads that
+// would exhaust the stack.
+var MaxDepth = 100
+
+// DepthFromContext function returns nesting depth of an object being validated,
+// zero outside of validators.
+func DepthFromContext(ctx context.Context) (depth int) {
+	depth, _ = ctx.Value(DepthContextKey).(int)
+	return
+}
+
+// maxDepth function returns the maximum nesting depth of objects validated with
+// ctx: MaxDepth option of ctx if it is set, MaxDepth otherwise.
+func maxDepth(ctx context.Context) int {
+	if opts := OptionsFromContext(ctx); opts.MaxDepth > 0 {
+		return opts.MaxDepth
+	}
+
+	return MaxDepth
+}
+
+// EnterObject function returns a context for validation of an object at path
+// nested one level deeper than ctx, or an error if MaxDepth (or MaxDepth option
+// of ctx) is exceeded.
+func EnterObject(ctx context.Context, path string) (context.Context, error) {
+	max := maxDepth(ctx)
+	depth := DepthFromContext(ctx) + 1
+	if depth > max {
+		return ctx, fmt.Errorf("maximum nesting depth %d exceeded at %q", max, path)
+	}
+
+	return context.WithValue(ctx, DepthContextKey, depth), nil
+}
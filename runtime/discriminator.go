@@ -0,0 +1,17 @@
+package runtime
+
+import (
+	"encoding/json"
+)
+
+// DiscriminatorValue function returns a value of a discriminator field, i.e. a
+// JSON string such as an enum name, or empty string if the field is absent or is
+// not a string.
+func DiscriminatorValue(r json.RawMessage) string {
+	var s string
+	if r == nil || json.Unmarshal(r, &s) != nil {
+		return ""
+	}
+
+	return s
+}
@@ -0,0 +1,38 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ValidateUniqueKeys function validates that a JSON object has no duplicate keys,
+// which json.Unmarshal silently resolves to the last value. Keys of nested objects
+// are not checked, join builds a path of a duplicate key (e.g. JoinPath).
+func ValidateUniqueKeys(r json.RawMessage, path string, join func(string, string) string) error {
+	dec := json.NewDecoder(bytes.NewReader(r))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil
+		}
+
+		key, _ := t.(string)
+		if seen[key] {
+			return fmt.Errorf("duplicate field %q", join(path, key))
+		}
+		seen[key] = true
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil
+		}
+	}
+
+	return nil
+}
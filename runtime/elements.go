@@ -0,0 +1,46 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// elementCounter counts array elements decoded during validation of a request.
+type elementCounter struct {
+	mu    sync.Mutex
+	count int
+	max   int
+}
+
+// WithMaxTotalElements function returns a context that limits the number of
+// array elements decoded during validation of a request to max, elements of
+// nested arrays are counted as well. A context that already has a limit is
+// returned unchanged, so a request is counted once.
+func WithMaxTotalElements(ctx context.Context, max int) context.Context {
+	if _, ok := ctx.Value(ElementsContextKey).(*elementCounter); ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, ElementsContextKey, &elementCounter{max: max})
+}
+
+// CountElements function adds n decoded array elements to the counter of a
+// request and returns an error if its limit is exceeded, it does nothing
+// unless the limit is set with WithMaxTotalElements.
+func CountElements(ctx context.Context, n int) error {
+	c, ok := ctx.Value(ElementsContextKey).(*elementCounter)
+	if !ok {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.count += n
+	if c.count > c.max {
+		return fmt.Errorf("request contains too many elements")
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Enum describes values of a protobuf enum accepted in JSON.
+//
+// A value is accepted if it is one of declared Names or their numbers. If Variants
+// is set, a string is also accepted if it matches a declared name case-insensitively
+// with or without Prefix, e.g. "active" and "STATUS_ACTIVE" for a declared name
+// "ACTIVE" or "active" and "ACTIVE" for a declared name "STATUS_ACTIVE".
+type Enum struct {
+	Name     string
+	Names    map[string]int32
+	Prefix   string
+	Variants bool
+}
+
+// Canonical function returns a declared name for a JSON string s.
+func (e *Enum) Canonical(s string) (string, bool) {
+	if _, ok := e.Names[s]; ok {
+		return s, true
+	}
+
+	if !e.Variants {
+		return "", false
+	}
+
+	for n := range e.Names {
+		if strings.EqualFold(s, n) || strings.EqualFold(e.Prefix+s, n) || strings.EqualFold(s, e.Prefix+n) {
+			return n, true
+		}
+	}
+
+	return "", false
+}
+
+// ValidateEnum function validates that a JSON value is a valid value of enum e,
+// JSON null is accepted.
+func ValidateEnum(r json.RawMessage, path string, e *Enum) error {
+	if string(r) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(r, &s); err == nil {
+		if _, ok := e.Canonical(s); ok {
+			return nil
+		}
+	}
+
+	var n int32
+	if err := json.Unmarshal(r, &n); err == nil {
+		for _, v := range e.Names {
+			if v == n {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("invalid value for %q: %s is not a valid %s", path, r, e.Name)
+}
+
+// ValidateRegisteredEnum function validates that a JSON value is a valid value of
+// an enum registered with github.com/golang/protobuf/proto under a given name
+// (e.g. "external.ExternalUser_Role"), it is used for enums of other packages.
+// JSON null and values of enums that are not registered are accepted.
+func ValidateRegisteredEnum(r json.RawMessage, path string, name string) error {
+	names := proto.EnumValueMap(name)
+	if names == nil {
+		return nil
+	}
+
+	return ValidateEnum(r, path, &Enum{Name: name[strings.LastIndex(name, ".")+1:], Names: names})
+}
+
+// CanonicalEnum function replaces variants of enum e names with declared names in
+// a JSON string, array or object (map values), it reports whether r was changed.
+func CanonicalEnum(r json.RawMessage, e *Enum) (json.RawMessage, bool) {
+	var s string
+	if err := json.Unmarshal(r, &s); err == nil {
+		if n, ok := e.Canonical(s); ok && n != s {
+			b, _ := json.Marshal(n)
+			return b, true
+		}
+		return r, false
+	}
+
+	var vArr []json.RawMessage
+	if err := json.Unmarshal(r, &vArr); err == nil {
+		changed := false
+		for i, vv := range vArr {
+			var ok bool
+			if vArr[i], ok = CanonicalEnum(vv, e); ok {
+				changed = true
+			}
+		}
+		if !changed {
+			return r, false
+		}
+		b, _ := json.Marshal(vArr)
+		return b, true
+	}
+
+	var vMap map[string]json.RawMessage
+	if err := json.Unmarshal(r, &vMap); err == nil {
+		changed := false
+		for k, vv := range vMap {
+			if nv, ok := CanonicalEnum(vv, e); ok {
+				vMap[k], changed = nv, true
+			}
+		}
+		if !changed {
+			return r, false
+		}
+		b, _ := json.Marshal(vMap)
+		return b, true
+	}
+
+	return r, false
+}
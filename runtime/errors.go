@@ -0,0 +1,142 @@
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// ErrSkipValidation is returned by an AtlasJSONValidate hook of a message that has
+// fully validated an object, generated checks of the object are skipped then.
+var ErrSkipValidation = errors.New("skip validation")
+
+// Errors combines validation errors of a request collected by validators that
+// are generated with error_mode=collect parameter.
+type Errors []error
+
+// Error method returns messages of the errors separated by newlines.
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// Errors method returns the combined errors.
+func (e Errors) Errors() []error {
+	return e
+}
+
+// AppendError function appends err to errs, errors combined by an error that
+// implements Errors() []error (e.g. of a nested object) are appended one by one.
+func AppendError(errs []error, err error) []error {
+	if me, ok := err.(interface{ Errors() []error }); ok {
+		return append(errs, me.Errors()...)
+	}
+
+	return append(errs, err)
+}
+
+// JoinErrors function returns nil for no errors, the only error of errs or
+// Errors that combines them.
+func JoinErrors(errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+
+	return Errors(errs)
+}
+
+// ErrorMessage function returns a value of Atlas-Validation-Error metadata for
+// err: a message of a single error or a JSON array of messages of combined ones,
+// e.g. ["unknown field \"a\".","field \"name\" is required for \"POST\" operation."].
+func ErrorMessage(err error) string {
+	me, ok := err.(interface{ Errors() []error })
+	if !ok || len(me.Errors()) < 2 {
+		return err.Error()
+	}
+
+	msgs := make([]string, 0, len(me.Errors()))
+	for _, e := range me.Errors() {
+		msgs = append(msgs, e.Error())
+	}
+
+	b, _ := json.Marshal(msgs)
+	return string(b)
+}
+
+// Codes of ValidationError.
+const (
+	CodeUnknownField = "UNKNOWN_FIELD"
+	CodeRequired     = "REQUIRED"
+	CodeDenied       = "DENIED"
+	CodeTypeMismatch = "TYPE_MISMATCH"
+	CodeInvalid      = "INVALID"
+)
+
+// ValidationError is an error of a field returned by validators that are generated
+// with error_codes=true parameter, Message is the message the error would have
+// without the parameter.
+type ValidationError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error method returns the message of the error.
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// WithCode function returns err of a field at path as ValidationError with code,
+// nil, ValidationError and errors combining others (e.g. of a nested object) are
+// returned as is.
+func WithCode(err error, code string, field string) error {
+	switch err.(type) {
+	case nil, *ValidationError, interface{ Errors() []error }:
+		return err
+	}
+
+	return &ValidationError{Field: field, Code: code, Message: err.Error()}
+}
+
+// ErrorsJSON function returns a value of Atlas-Validation-Error metadata for err
+// with error_codes=true parameter: a JSON array of errors combined by err, errors
+// other than ValidationError are reported with CodeInvalid and no field, e.g.
+// [{"field":"name","code":"REQUIRED","message":"field \"name\" is required for \"POST\" operation."}].
+func ErrorsJSON(err error) string {
+	errs := []error{err}
+	if me, ok := err.(interface{ Errors() []error }); ok {
+		errs = me.Errors()
+	}
+
+	verrs := make([]*ValidationError, 0, len(errs))
+	for _, e := range errs {
+		ve, ok := e.(*ValidationError)
+		if !ok {
+			ve = &ValidationError{Code: CodeInvalid, Message: e.Error()}
+		}
+		verrs = append(verrs, ve)
+	}
+
+	b, _ := json.Marshal(verrs)
+	return string(b)
+}
+
+// SortedKeys function returns sorted keys of a JSON object, collecting validators
+// iterate fields in this order so that errors are reported deterministically.
+func SortedKeys(v map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
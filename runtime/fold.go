@@ -0,0 +1,105 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// FoldKeys function renames keys of a JSON object that match one of names only
+// case-insensitively (e.g. "ID" for "id") to the matching name, the same way
+// encoding/json matches fields. Keys that match no name are left for validation
+// to report, a key that matches a name set by another key is an error.
+func FoldKeys(v map[string]json.RawMessage, path string, names []string, join func(string, string) string) error {
+	declared := make(map[string]bool, len(names))
+	for _, name := range names {
+		declared[name] = true
+	}
+
+	for k, vv := range v {
+		if declared[k] {
+			continue
+		}
+		for _, name := range names {
+			if !strings.EqualFold(k, name) {
+				continue
+			}
+			if _, ok := v[name]; ok {
+				return fmt.Errorf("field %q is set twice.", join(path, name))
+			}
+			delete(v, k)
+			v[name] = vv
+			break
+		}
+	}
+
+	return nil
+}
+
+// NormalizeKeys function renames keys of a JSON object that match one of names only
+// case-insensitively to the matching name, as FoldKeys does, in a body that passed
+// validation, and reports whether a key was renamed.
+func NormalizeKeys(v map[string]json.RawMessage, names []string) bool {
+	declared := make(map[string]bool, len(names))
+	for _, name := range names {
+		declared[name] = true
+	}
+
+	renamed := false
+	for k, vv := range v {
+		if declared[k] {
+			continue
+		}
+		for _, name := range names {
+			if _, ok := v[name]; ok || !strings.EqualFold(k, name) {
+				continue
+			}
+			delete(v, k)
+			v[name], renamed = vv, true
+			break
+		}
+	}
+
+	return renamed
+}
+
+// WithFoldPackage function returns a context of a request validated by an annotator
+// of proto package pkg. Normalization of the annotator renames keys that match fields
+// only case-insensitively in the body passed to grpc-gateway for messages of its own
+// package only, so validators of other packages match keys of the request as they are.
+// Empty pkg makes every validator match keys as they are.
+func WithFoldPackage(ctx context.Context, pkg string) context.Context {
+	return context.WithValue(ctx, FoldPackageContextKey, pkg)
+}
+
+// FoldsKeys function reports whether validators of proto package pkg generated with
+// case_insensitive=true parameter rename keys that match fields only case-insensitively,
+// that is unless ctx is created with WithFoldPackage for another package.
+func FoldsKeys(ctx context.Context, pkg string) bool {
+	fp, ok := ctx.Value(FoldPackageContextKey).(string)
+	return !ok || fp == pkg
+}
+
+// HasKey function reports whether a JSON object has a key of one of names, with
+// fold a key that matches a name only case-insensitively is counted as well.
+func HasKey(v map[string]json.RawMessage, fold bool, names ...string) bool {
+	for _, name := range names {
+		if _, ok := v[name]; ok {
+			return true
+		}
+	}
+	if !fold {
+		return false
+	}
+
+	for k := range v {
+		for _, name := range names {
+			if strings.EqualFold(k, name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"sync"
+)
+
+// format describes a named string format: a check function and a description
+// used in error messages, e.g. "cron expression".
+type format struct {
+	check       func(s string) bool
+	description string
+}
+
+var (
+	formatsMu sync.RWMutex
+	formats   = map[string]format{
+		"cron":         {check: isCron5, description: "cron expression"},
+		"cron_seconds": {check: isCron6, description: "cron expression"},
+		"mac":          {check: isMAC, description: "MAC address"},
+	}
+)
+
+// isMAC function reports whether s is an EUI-48 or EUI-64 address in colon
+// (01:23:45:67:89:ab), hyphen (01-23-45-67-89-ab) or dot (0123.4567.89ab) notation.
+func isMAC(s string) bool {
+	hw, err := net.ParseMAC(s)
+	return err == nil && (len(hw) == 6 || len(hw) == 8)
+}
+
+// RegisterFormat function registers a string format that can be referenced by
+// format field option, a field value in the format is the one check accepts.
+// Description is used in errors: field "schedule" must be a valid <description>.
+// Registering a format with the name of an existing one replaces it, requests cached
+// by EnableValidationCache before are validated again.
+func RegisterFormat(name string, description string, check func(s string) bool) {
+	formatsMu.Lock()
+	formats[name] = format{check: check, description: description}
+	formatsMu.Unlock()
+	registryChanged()
+}
+
+// HasFormat function reports whether a format with a given name is registered, the
+// generator uses it to tell built-in formats from ones registered at run time.
+func HasFormat(name string) bool {
+	formatsMu.RLock()
+	_, ok := formats[name]
+	formatsMu.RUnlock()
+
+	return ok
+}
+
+// ValidatePattern function validates that a JSON value is a string that matches
+// a regular expression of pattern option, JSON null is accepted.
+func ValidatePattern(r json.RawMessage, path string, re *regexp.Regexp) error {
+	if string(r) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(r, &s); err != nil || !re.MatchString(s) {
+		return fmt.Errorf("field %q does not match required pattern", path)
+	}
+
+	return nil
+}
+
+// ValidateMapKeyPattern function validates that a key of JSON object that
+// represents a map matches a regular expression of key_pattern option, path is
+// a path of the map.
+func ValidateMapKeyPattern(key string, path string, re *regexp.Regexp) error {
+	if !re.MatchString(key) {
+		return fmt.Errorf("invalid map key %q at %q", key, path)
+	}
+
+	return nil
+}
+
+// ValidateFormat function validates that a JSON value is a string in a registered
+// format with a given name, JSON null is accepted.
+func ValidateFormat(r json.RawMessage, path string, name string) error {
+	if string(r) == "null" {
+		return nil
+	}
+
+	formatsMu.RLock()
+	f, ok := formats[name]
+	formatsMu.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("field %q has unknown format %q", path, name)
+	}
+
+	var s string
+	if err := json.Unmarshal(r, &s); err != nil || !f.check(s) {
+		return fmt.Errorf("field %q must be a valid %s", path, f.description)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// MarshalRequestBody function marshals a request message to JSON with proto field
+// names and returns the part of it that an HTTP binding with a given body would
+// carry: the whole message for "*", a value of the field named by body (an empty
+// object if the field is not set) or nil for a binding without body. A request that
+// is not a proto message is an error.
+func MarshalRequestBody(req interface{}, body string) (json.RawMessage, error) {
+	if body == "" {
+		return nil, nil
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("request of type %T is not a proto message", req)
+	}
+
+	s, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(msg)
+	if err != nil {
+		return nil, err
+	}
+	if body == "*" {
+		return json.RawMessage(s), nil
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+	if b, ok := v[body]; ok {
+		return b, nil
+	}
+
+	return json.RawMessage("{}"), nil
+}
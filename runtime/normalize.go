@@ -0,0 +1,83 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// Normalizer rewrites a valid JSON body of a request, e.g. trims strings or
+// applies Unicode normalization, and returns the rewritten body.
+type Normalizer func(ctx context.Context, body json.RawMessage) (json.RawMessage, error)
+
+// normalizer is a registered Normalizer with its name.
+type normalizer struct {
+	name      string
+	normalize Normalizer
+}
+
+var (
+	normalizersMu sync.RWMutex
+	normalizers   []normalizer
+)
+
+// RegisterNormalizer function registers a transform of the normalization stage,
+// normalizers run in the order of registration after generated defaults are
+// injected. Registering a normalizer with the name of an existing one replaces it
+// and keeps its position, nil normalizer removes it.
+func RegisterNormalizer(name string, n Normalizer) {
+	normalizersMu.Lock()
+	defer normalizersMu.Unlock()
+
+	for i := range normalizers {
+		if normalizers[i].name != name {
+			continue
+		}
+		if n == nil {
+			normalizers = append(normalizers[:i:i], normalizers[i+1:]...)
+		} else {
+			normalizers[i].normalize = n
+		}
+		return
+	}
+
+	if n == nil {
+		return
+	}
+
+	normalizers = append(normalizers, normalizer{name: name, normalize: n})
+}
+
+// Normalize function runs the normalization stage over a validated body: the
+// defaulter of a method, if any, that injects default values and replaces enum
+// variants with declared names (reported as "defaults"), followed by registered
+// normalizers. It returns the normalized body and names of the transforms that
+// changed it, in the order they ran. An empty body is returned unchanged.
+func Normalize(ctx context.Context, body json.RawMessage, defaulter func(context.Context, json.RawMessage) (json.RawMessage, error)) (json.RawMessage, []string, error) {
+	if len(body) == 0 {
+		return body, nil, nil
+	}
+
+	normalizersMu.RLock()
+	steps := make([]normalizer, 0, len(normalizers)+1)
+	if defaulter != nil {
+		steps = append(steps, normalizer{name: "defaults", normalize: defaulter})
+	}
+	steps = append(steps, normalizers...)
+	normalizersMu.RUnlock()
+
+	var ran []string
+	for _, s := range steps {
+		b, err := s.normalize(ctx, body)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !bytes.Equal(b, body) {
+			ran = append(ran, s.name)
+		}
+		body = b
+	}
+
+	return body, ran, nil
+}
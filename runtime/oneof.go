@@ -0,0 +1,18 @@
+package runtime
+
+import (
+	"encoding/json"
+)
+
+// CountSet function returns the number of fields with given names that are set
+// in a JSON object, a field with JSON null value is not set.
+func CountSet(v map[string]json.RawMessage, names ...string) int {
+	n := 0
+	for _, name := range names {
+		if r, ok := v[name]; ok && string(r) != "null" {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,56 @@
+package runtime
+
+import "context"
+
+// Options tune validation of a request without regenerating code, they are passed
+// to the annotator or the interceptor with a context returned by NewContext. Zero
+// values keep behavior selected by parameters of the plugin and package variables.
+// Path style and error mode change generated code and are not options.
+type Options struct {
+	// MaxDepth overrides MaxDepth package variable if positive.
+	MaxDepth int
+
+	// ErrorHeader overrides a name of metadata the annotator reports errors with
+	// (error_header parameter, Atlas-Validation-Error by default).
+	ErrorHeader string
+
+	// FirstError makes the annotator and the interceptor report only the first of
+	// errors collected by validators generated with error_mode=collect parameter.
+	FirstError bool
+}
+
+// NewContext function returns a context that carries options of validation.
+func NewContext(ctx context.Context, opts Options) context.Context {
+	return context.WithValue(ctx, OptionsContextKey, opts)
+}
+
+// OptionsFromContext function returns options of validation carried by ctx, zero
+// Options if there are none.
+func OptionsFromContext(ctx context.Context) (opts Options) {
+	opts, _ = ctx.Value(OptionsContextKey).(Options)
+	return
+}
+
+// ErrorHeader function returns a name of metadata the annotator reports errors
+// with: ErrorHeader option of ctx or header generated with the annotator.
+func ErrorHeader(ctx context.Context, header string) string {
+	if h := OptionsFromContext(ctx).ErrorHeader; h != "" {
+		return h
+	}
+
+	return header
+}
+
+// ReportedErrors function returns err limited to its first error if FirstError
+// option of ctx is set and err combines several ones, err otherwise.
+func ReportedErrors(ctx context.Context, err error) error {
+	if !OptionsFromContext(ctx).FirstError {
+		return err
+	}
+
+	if me, ok := err.(interface{ Errors() []error }); ok && len(me.Errors()) != 0 {
+		return me.Errors()[0]
+	}
+
+	return err
+}
@@ -0,0 +1,65 @@
+// Package protobufgo contains runtime functions of code generated with
+// runtime=protobuf-go parameter for messages of google.golang.org/protobuf.
+package protobufgo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/infobloxopen/protoc-gen-atlas-validate/runtime"
+)
+
+// MarshalRequestBody function is runtime.MarshalRequestBody for requests that are
+// google.golang.org/protobuf messages, they are marshaled with protojson.
+func MarshalRequestBody(req interface{}, body string) (json.RawMessage, error) {
+	if body == "" {
+		return nil, nil
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("request of type %T is not a proto message", req)
+	}
+
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	if body == "*" {
+		return json.RawMessage(b), nil
+	}
+
+	var v map[string]json.RawMessage
+	if err = json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	if b, ok := v[body]; ok {
+		return b, nil
+	}
+
+	return json.RawMessage("{}"), nil
+}
+
+// ValidateRegisteredEnum function is runtime.ValidateRegisteredEnum for enums of
+// other packages generated on google.golang.org/protobuf, an enum is resolved with
+// protoregistry by its full name (e.g. "external.ExternalUser.Role"). JSON null and
+// values of enums that are not registered are accepted.
+func ValidateRegisteredEnum(r json.RawMessage, path string, name string) error {
+	et, err := protoregistry.GlobalTypes.FindEnumByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil
+	}
+
+	values := et.Descriptor().Values()
+	names := make(map[string]int32, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		names[string(values.Get(i).Name())] = int32(values.Get(i).Number())
+	}
+
+	return runtime.ValidateEnum(r, path, &runtime.Enum{Name: string(et.Descriptor().Name()), Names: names})
+}
@@ -0,0 +1,218 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// queryMapKey matches "key[value]" syntax that grpc-gateway uses for map fields.
+var queryMapKey = regexp.MustCompile(`^(.*)\[(.*)\]$`)
+
+// ValidateQuery function validates query parameters with validator in order of keys.
+// A key is split into a field path by "." the same way grpc-gateway does it.
+func ValidateQuery(ctx context.Context, q url.Values, validator func(ctx context.Context, fieldPath []string, values []string, key string) error) error {
+	var keys []string
+	for k := range q {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		key, values := k, q[k]
+		if match := queryMapKey.FindStringSubmatch(k); len(match) == 3 {
+			key, values = match[1], append([]string{match[2]}, values...)
+		}
+
+		if err := validator(ctx, strings.Split(key, "."), values, k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateForm function validates a form-urlencoded body with validator the same
+// way query parameters are validated, except that keys that do not match any field
+// are rejected unless unknown fields are allowed and errors refer to form fields.
+func ValidateForm(ctx context.Context, body []byte, validator func(context.Context, url.Values) error) error {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return fmt.Errorf("invalid value: unable to parse form body")
+	}
+
+	return validator(context.WithValue(ctx, FormContextKey, true), form)
+}
+
+// FormObject function returns top-level fields of a form-urlencoded body as a JSON
+// object for validators of required fields: a key without nesting is a string of
+// its last value, a nested key (e.g. "address.city" or "tags[env]") marks its
+// first name present with an empty object.
+func FormObject(form url.Values) map[string]json.RawMessage {
+	v := make(map[string]json.RawMessage, len(form))
+	for k, values := range form {
+		if i := strings.IndexAny(k, ".["); i >= 0 {
+			if _, ok := v[k[:i]]; !ok {
+				v[k[:i]] = json.RawMessage("{}")
+			}
+			continue
+		}
+		if len(values) != 0 {
+			v[k], _ = json.Marshal(values[len(values)-1])
+		}
+	}
+
+	return v
+}
+
+// IsFormContentType function reports whether a value of Content-Type header is
+// application/x-www-form-urlencoded.
+func IsFormContentType(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mt == "application/x-www-form-urlencoded"
+}
+
+// QueryParameterError function returns an error about query parameter key (or
+// form field key if a form body is validated), e.g. `query parameter "ids": expected int64.`
+// for a message ": expected int64.".
+func QueryParameterError(ctx context.Context, key string, message string) error {
+	if isForm, _ := ctx.Value(FormContextKey).(bool); isForm {
+		return fmt.Errorf("form field %q%s", key, message)
+	}
+
+	return fmt.Errorf("query parameter %q%s", key, message)
+}
+
+// ValidateUnknownQueryParameter function validates query parameter (or form field)
+// key that does not match any field: it is rejected unless unknown fields are allowed.
+func ValidateUnknownQueryParameter(ctx context.Context, key string) error {
+	if !AllowUnknownFromContext(ctx) {
+		return fmt.Errorf("unknown field %q.", key)
+	}
+
+	return nil
+}
+
+// ValidateQueryValue function validates that values of query parameter key conform
+// to a protobuf scalar type kind (e.g. "int32", "bool", "timestamp").
+func ValidateQueryValue(ctx context.Context, key string, fieldPath []string, values []string, kind string, repeated bool) error {
+	if len(fieldPath) != 1 {
+		return QueryParameterError(ctx, key, ": unexpected nested field.")
+	}
+
+	if !repeated && len(values) > 1 {
+		return QueryParameterError(ctx, key, " must have a single value.")
+	}
+
+	for _, v := range values {
+		if err := parseScalar(v, kind); err != nil {
+			return QueryParameterError(ctx, key, ": expected "+kind+".")
+		}
+	}
+
+	return nil
+}
+
+// queryJSON function returns a JSON value of a query parameter value for checks of
+// JSON values: a number for an integer, a string otherwise.
+func queryJSON(v string) json.RawMessage {
+	if _, err := strconv.ParseInt(v, 10, 32); err == nil {
+		return json.RawMessage(v)
+	}
+
+	b, _ := json.Marshal(v)
+	return b
+}
+
+// ValidateQueryEnum function validates that values of query parameter key of an
+// enum field are names or numbers check accepts, check is called with JSON values
+// (see queryJSON).
+func ValidateQueryEnum(ctx context.Context, key string, fieldPath []string, values []string, repeated bool, check func(r json.RawMessage) error) error {
+	if len(fieldPath) != 1 {
+		return QueryParameterError(ctx, key, ": unexpected nested field.")
+	}
+
+	if !repeated && len(values) > 1 {
+		return QueryParameterError(ctx, key, " must have a single value.")
+	}
+
+	for _, v := range values {
+		if err := check(queryJSON(v)); err != nil {
+			return QueryParameterError(ctx, key, fmt.Sprintf(": %q is not a valid value.", v))
+		}
+	}
+
+	return nil
+}
+
+// ValidateQueryMapEntry function validates query parameter key of a map field that
+// must have "name[key]" syntax: the map key, the first of values (see ValidateQuery),
+// must conform to keyKind and the single value to valueKind, empty kinds are not
+// checked.
+func ValidateQueryMapEntry(ctx context.Context, key string, fieldPath []string, values []string, keyKind string, valueKind string) error {
+	if len(fieldPath) != 1 {
+		return QueryParameterError(ctx, key, ": unexpected nested field.")
+	}
+
+	if !queryMapKey.MatchString(key) || len(values) == 0 {
+		return QueryParameterError(ctx, key, ": expected a map entry.")
+	}
+
+	if keyKind != "" && parseScalar(values[0], keyKind) != nil {
+		return QueryParameterError(ctx, key, ": expected "+keyKind+" key.")
+	}
+
+	if len(values) > 2 {
+		return QueryParameterError(ctx, key, " must have a single value.")
+	}
+
+	for _, v := range values[1:] {
+		if valueKind != "" && parseScalar(v, valueKind) != nil {
+			return QueryParameterError(ctx, key, ": expected "+valueKind+".")
+		}
+	}
+
+	return nil
+}
+
+// ValidateQueryConstraints function validates values of query parameter key with
+// check of options of a field that are applied to field values of a JSON body (e.g.
+// ValidateMin or ValidatePattern), check is called with each value as a JSON string
+// and key as a path, an error it returns is reported for the parameter.
+func ValidateQueryConstraints(ctx context.Context, key string, values []string, check func(r json.RawMessage, path string) error) error {
+	for _, v := range values {
+		b, _ := json.Marshal(v)
+		if err := check(b, key); err != nil {
+			// errors of checks of JSON values start with `field "<path>"`
+			msg := err.Error()
+			if prefix := fmt.Sprintf("field %q", key); strings.HasPrefix(msg, prefix) {
+				msg = strings.TrimPrefix(msg, prefix)
+			} else {
+				msg = ": " + msg
+			}
+			return QueryParameterError(ctx, key, msg)
+		}
+	}
+
+	return nil
+}
+
+// ValidateQueryMaxFutureSkew function validates that timestamps of query parameter
+// key are not ahead of Now by more than skew.
+func ValidateQueryMaxFutureSkew(ctx context.Context, key string, values []string, skew time.Duration) error {
+	for _, v := range values {
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil && t.After(Now().Add(skew)) {
+			return QueryParameterError(ctx, key, " is too far in the future")
+		}
+	}
+
+	return nil
+}
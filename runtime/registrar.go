@@ -0,0 +1,20 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// Validator validates a JSON body of a request with URL path, the path must match
+// a pattern the validator is registered for.
+type Validator func(ctx context.Context, path string, body json.RawMessage) error
+
+// Registrar is implemented by routers validators of HTTP bindings are registered
+// with by generated RegisterAtlasValidators functions, e.g. routers other than
+// grpc-gateway. Bindings are registered in order AtlasValidateAnnotator matches
+// them, the first one registered for a method and a path should be used.
+type Registrar interface {
+	RegisterValidator(httpMethod string, pattern runtime.Pattern, validator Validator)
+}
@@ -0,0 +1,92 @@
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// RelaxJSON function converts relaxed JSON to standard JSON, the following
+// relaxations are supported:
+//   - line comments from "//" to the end of a line,
+//   - block comments between "/*" and "*/", replaced with a space,
+//   - trailing commas before "]" and "}".
+//
+// Strings are copied as is and any other deviation from standard JSON is left
+// for validation to report. A body without relaxations is returned unchanged.
+func RelaxJSON(b []byte) ([]byte, error) {
+	var (
+		out      = make([]byte, 0, len(b))
+		comma    = -1
+		prev     byte
+		inString = false
+		relaxed  = false
+	)
+
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+
+		if inString {
+			out = append(out, c)
+			switch c {
+			case '\\':
+				if i+1 < len(b) {
+					i++
+					out = append(out, b[i])
+				}
+			case '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch {
+		case c == '/' && i+1 < len(b) && b[i+1] == '/':
+			relaxed = true
+			for i < len(b) && b[i] != '\n' {
+				i++
+			}
+			if i < len(b) {
+				out = append(out, '\n')
+			}
+			continue
+		case c == '/' && i+1 < len(b) && b[i+1] == '*':
+			end := bytes.Index(b[i+2:], []byte("*/"))
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment")
+			}
+			relaxed = true
+			i += end + 3
+			out = append(out, ' ')
+			continue
+		case c == ']' || c == '}':
+			if comma >= 0 {
+				relaxed = true
+				out = append(out[:comma], out[comma+1:]...)
+			}
+		case c == '"':
+			inString = true
+		}
+
+		switch c {
+		case ' ', '\t', '\n', '\r':
+		case ',':
+			// a comma that follows "[", "{" or another comma is not trailing
+			comma = -1
+			if prev != '[' && prev != '{' && prev != ',' {
+				comma = len(out)
+			}
+			prev = c
+		default:
+			comma = -1
+			prev = c
+		}
+
+		out = append(out, c)
+	}
+
+	if !relaxed {
+		return b, nil
+	}
+
+	return out, nil
+}
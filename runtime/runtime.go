@@ -2,21 +2,55 @@ package runtime
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
 
 const (
-	HTTPMethodContextKey   = "http-method"
-	AllowUnknownContextKey = "allow-unknown"
+	HTTPMethodContextKey    = "http-method"
+	AllowUnknownContextKey  = "allow-unknown"
+	CoverageContextKey      = "coverage"
+	FormContextKey          = "form"
+	PathVariablesContextKey = "path-variables"
+	ElementsContextKey      = "elements"
+	TextContextKey          = "text"
+	DepthContextKey         = "depth"
+	HTTPPathContextKey      = "http-path"
+	OptionsContextKey       = "options"
+	MarshaledContextKey     = "marshaled"
+	HooksContextKey         = "hooks"
+	FoldPackageContextKey   = "fold-package"
 )
 
+// Now is a clock used by time-dependent validation rules, it can be replaced in tests.
+var Now = time.Now
+
 func PatternMatch(pattern runtime.Pattern, path string) bool {
+	_, ok := PatternVariables(pattern, path)
+	return ok
+}
+
+// PatternVariables function matches a path against a pattern and returns values of
+// variables of the path template keyed by their names, e.g. {"payload.id": "5"}
+// for "/users/5" and "/users/{payload.id}". A path that is empty or does not
+// start with "/" matches no pattern.
+func PatternVariables(pattern runtime.Pattern, path string) (map[string]string, bool) {
 	var components []string
 	var idx, l int
 	var c, verb string
 
+	if !strings.HasPrefix(path, "/") {
+		return nil, false
+	}
+
 	components = strings.Split(path[1:], "/")
 	l = len(components)
 	if idx = strings.LastIndex(components[l-1], ":"); idx > 0 {
@@ -24,8 +58,8 @@ func PatternMatch(pattern runtime.Pattern, path string) bool {
 		components[l-1], verb = c[:idx], c[idx+1:]
 	}
 
-	_, matchErr := pattern.Match(components, verb)
-	return matchErr == nil
+	vars, matchErr := pattern.Match(components, verb)
+	return vars, matchErr == nil
 }
 
 func JoinPath(path string, element string) string {
@@ -36,12 +70,268 @@ func JoinPath(path string, element string) string {
 	return path + "." + element
 }
 
+// JoinIndex function appends an array index to a dotted path, an index of a
+// top-level array (e.g. a body bound to a repeated field) is "[0]".
+func JoinIndex(path string, index int) string {
+	if path == "" {
+		return fmt.Sprintf("[%d]", index)
+	}
+
+	return fmt.Sprintf("%s.[%d]", path, index)
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// JoinPointer function appends a field name to an RFC 6901 JSON Pointer, "~" and
+// "/" in the name are escaped as "~0" and "~1".
+func JoinPointer(path string, element string) string {
+	return path + "/" + pointerEscaper.Replace(element)
+}
+
+// JoinPointerIndex function appends an array index to an RFC 6901 JSON Pointer.
+func JoinPointerIndex(path string, index int) string {
+	return path + "/" + strconv.Itoa(index)
+}
+
+var jsonPathEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// JoinJSONPath function appends a field name to a JSONPath, e.g. "$.address.city"
+// or "$['first name']" for names that are not identifiers. An empty path is the
+// root "$".
+func JoinJSONPath(path string, element string) string {
+	if path == "" {
+		path = "$"
+	}
+
+	identifier := element != ""
+	for i, c := range element {
+		if !(c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || i > 0 && '0' <= c && c <= '9') {
+			identifier = false
+			break
+		}
+	}
+	if identifier {
+		return path + "." + element
+	}
+
+	return path + "['" + jsonPathEscaper.Replace(element) + "']"
+}
+
+// JoinJSONPathIndex function appends an array index to a JSONPath, e.g. "$.groups[0]".
+func JoinJSONPathIndex(path string, index int) string {
+	if path == "" {
+		path = "$"
+	}
+
+	return path + "[" + strconv.Itoa(index) + "]"
+}
+
 func HTTPMethodFromContext(ctx context.Context) (method string) {
 	method, _ = ctx.Value(HTTPMethodContextKey).(string)
 	return method
 }
 
+// MarshaledFromContext function reports whether a body being validated is marshaled
+// from a proto message, e.g. by AtlasValidateInterceptor, rather than sent by a client.
+// Proto3 fields without presence are absent from such a body when they hold zero
+// values, so presence-based rules (required, required_for_type, at_least_one_of) are
+// not applied to them.
+func MarshaledFromContext(ctx context.Context) bool {
+	marshaled, _ := ctx.Value(MarshaledContextKey).(bool)
+	return marshaled
+}
+
+// HTTPPathFromContext function returns a path of a request being validated, e.g.
+// "/users/5", so that AtlasJSONValidate hooks may depend on an endpoint.
+func HTTPPathFromContext(ctx context.Context) (path string) {
+	path, _ = ctx.Value(HTTPPathContextKey).(string)
+	return path
+}
+
+// PathVariablesFromContext function returns variables of a path template captured
+// from a request path.
+func PathVariablesFromContext(ctx context.Context) (vars map[string]string) {
+	vars, _ = ctx.Value(PathVariablesContextKey).(map[string]string)
+	return vars
+}
+
+// ValidatePathVariable function validates that a JSON value of a field equals to
+// a value of path template variable name if the request path has the variable,
+// e.g. "id": 5 for "/users/5" and "/users/{payload.id}". JSON null is accepted.
+func ValidatePathVariable(ctx context.Context, r json.RawMessage, path string, name string) error {
+	pv, ok := PathVariablesFromContext(ctx)[name]
+	if !ok || string(r) == "null" {
+		return nil
+	}
+
+	var s string
+	if json.Unmarshal(r, &s) != nil {
+		s = string(r)
+	}
+
+	if s != pv {
+		return fmt.Errorf("field %q must match path variable %q", path, name)
+	}
+
+	return nil
+}
+
 func AllowUnknownFromContext(ctx context.Context) (allowUnknown bool) {
 	allowUnknown, _ = ctx.Value(AllowUnknownContextKey).(bool)
 	return allowUnknown
 }
+
+// IsEmptyObject function reports whether a JSON value is an object without fields,
+// e.g. a body "{}" some clients send with requests that have none.
+func IsEmptyObject(r json.RawMessage) bool {
+	var v map[string]json.RawMessage
+	return json.Unmarshal(r, &v) == nil && v != nil && len(v) == 0
+}
+
+// ReadJSON function reads a single JSON value from r with json.Decoder, reading
+// stops at the end of the value and anything but white space after it is an
+// error. An empty input returns nil. The value is not streamed but buffered as a
+// whole, validators of an object need all of its fields.
+func ReadJSON(r io.Reader) (json.RawMessage, error) {
+	dec := json.NewDecoder(r)
+
+	var b json.RawMessage
+	if err := dec.Decode(&b); err == io.EOF {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("invalid value: unable to parse body")
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid value: unable to parse body")
+	}
+
+	return b, nil
+}
+
+// MatchSchemas function aggregates results of validating a body against several
+// candidate schemas, errs[i] holds the result for names[i]. If oneOf is true the
+// body must match exactly one schema, otherwise at least one schema.
+func MatchSchemas(oneOf bool, names []string, errs []error) error {
+	var matched, failed []string
+
+	for i, err := range errs {
+		if err == nil {
+			matched = append(matched, names[i])
+		} else {
+			failed = append(failed, fmt.Sprintf("%s: %s", names[i], err))
+		}
+	}
+
+	if len(matched) == 0 {
+		return fmt.Errorf("body does not match any of %v: %s", names, strings.Join(failed, "; "))
+	}
+
+	if oneOf && len(matched) > 1 {
+		return fmt.Errorf("body matches more than one of %v: %v", names, matched)
+	}
+
+	return nil
+}
+
+// Coverage collects paths of fields that had validation rules applied.
+type Coverage struct {
+	mu     sync.Mutex
+	fields map[string]struct{}
+}
+
+// WithCoverage function returns a context that enables collection of validated
+// fields, collected fields are available through CoverageFromContext.
+func WithCoverage(ctx context.Context) context.Context {
+	return context.WithValue(ctx, CoverageContextKey, &Coverage{fields: make(map[string]struct{})})
+}
+
+// CoverageFromContext function returns a coverage collector or nil if collection
+// is not enabled.
+func CoverageFromContext(ctx context.Context) (c *Coverage) {
+	c, _ = ctx.Value(CoverageContextKey).(*Coverage)
+	return c
+}
+
+// MarkCovered function records that a field at path had validation rules applied,
+// it does nothing unless collection is enabled with WithCoverage.
+func MarkCovered(ctx context.Context, path string) {
+	if c := CoverageFromContext(ctx); c != nil {
+		c.mu.Lock()
+		c.fields[path] = struct{}{}
+		c.mu.Unlock()
+	}
+}
+
+// Fields function returns sorted paths of fields that had validation rules applied.
+func (c *Coverage) Fields() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fields := make([]string, 0, len(c.fields))
+	for f := range c.fields {
+		fields = append(fields, f)
+	}
+
+	sort.Strings(fields)
+	return fields
+}
+
+// RulePolicy decides whether a validation rule identified by ruleID is enforced
+// for a request.
+type RulePolicy func(ctx context.Context, ruleID string) bool
+
+var (
+	rulePolicyMu sync.RWMutex
+	rulePolicy   RulePolicy
+)
+
+// SetRulePolicy function registers a policy consulted by RuleEnabled, nil
+// policy enables all rules.
+func SetRulePolicy(policy RulePolicy) {
+	rulePolicyMu.Lock()
+	rulePolicy = policy
+	rulePolicyMu.Unlock()
+}
+
+// RuleEnabled function reports whether a validation rule identified by ruleID
+// should be enforced, rules are enabled unless a registered policy disables them.
+// An override of a tenant policy of a request takes precedence over RulePolicy.
+func RuleEnabled(ctx context.Context, ruleID string) bool {
+	if enabled, ok := tenantRuleOverride(ctx, ruleID); ok {
+		return enabled
+	}
+
+	rulePolicyMu.RLock()
+	policy := rulePolicy
+	rulePolicyMu.RUnlock()
+
+	if policy == nil {
+		return true
+	}
+
+	return policy(ctx, ruleID)
+}
+
+// ValidateMaxFutureSkew function validates that a JSON timestamp is not ahead of
+// Now by more than skew, JSON null is accepted.
+func ValidateMaxFutureSkew(r json.RawMessage, path string, skew time.Duration) error {
+	if string(r) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(r, &s); err != nil {
+		return fmt.Errorf("invalid value for %q: expected timestamp.", path)
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return fmt.Errorf("invalid value for %q: expected timestamp.", path)
+	}
+
+	if t.After(Now().Add(skew)) {
+		return fmt.Errorf("timestamp for %q is too far in the future", path)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// parseScalar function validates that a string representation of a value conforms
+// to a protobuf scalar type kind (e.g. "int32", "bool", "timestamp").
+func parseScalar(v string, kind string) (err error) {
+	switch kind {
+	case "bool":
+		_, err = strconv.ParseBool(v)
+	case "int32":
+		_, err = strconv.ParseInt(v, 10, 32)
+	case "int64":
+		_, err = strconv.ParseInt(v, 10, 64)
+	case "uint32":
+		_, err = strconv.ParseUint(v, 10, 32)
+	case "uint64":
+		_, err = strconv.ParseUint(v, 10, 64)
+	case "float":
+		_, err = strconv.ParseFloat(v, 32)
+	case "double":
+		_, err = strconv.ParseFloat(v, 64)
+	case "bytes":
+		_, err = decodeBase64(v)
+	case "timestamp":
+		if v != "null" {
+			_, err = time.Parse(time.RFC3339Nano, v)
+		}
+	}
+
+	return err
+}
+
+// ValidateScalar function validates that a JSON value conforms to a protobuf scalar
+// type kind according to proto3 JSON mapping, e.g. integers and floats may be either
+// JSON numbers or strings. JSON null is accepted.
+func ValidateScalar(r json.RawMessage, path string, kind string) error {
+	if string(r) == "null" {
+		return nil
+	}
+
+	var (
+		s   string
+		err error
+	)
+
+	switch kind {
+	case "bool":
+		var b bool
+		err = json.Unmarshal(r, &b)
+	case "string", "bytes":
+		// base64 of bytes is checked by ValidateBase64
+		err = json.Unmarshal(r, &s)
+	default:
+		if json.Unmarshal(r, &s) != nil {
+			s = string(r)
+		}
+		err = parseScalar(s, kind)
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid value for %q: expected %s.", path, kind)
+	}
+
+	return nil
+}
+
+// decodeBase64 function decodes a string the way proto3 JSON mapping does for bytes
+// values: standard or URL-safe alphabet, with or without padding.
+func decodeBase64(s string) ([]byte, error) {
+	enc := base64.StdEncoding
+	if strings.ContainsAny(s, "-_") {
+		enc = base64.URLEncoding
+	}
+	if len(s)%4 != 0 {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+
+	return enc.DecodeString(s)
+}
+
+// ValidateBase64 function validates that a JSON string value of a bytes field is
+// valid base64, see decodeBase64. JSON null and values of other types are accepted,
+// ValidateScalar reports the latter.
+func ValidateBase64(r json.RawMessage, path string) error {
+	var s string
+	if err := json.Unmarshal(r, &s); err != nil {
+		return nil
+	}
+
+	if _, err := decodeBase64(s); err != nil {
+		return fmt.Errorf("field %q is not valid base64", path)
+	}
+
+	return nil
+}
+
+// ValidateNotBoolean function validates that a JSON value of an integer field is
+// not a JSON boolean, proto3 JSON mapping does not allow it.
+func ValidateNotBoolean(r json.RawMessage, path string) error {
+	if b := bytes.TrimSpace(r); string(b) == "true" || string(b) == "false" {
+		return fmt.Errorf("field %q: expected integer", path)
+	}
+
+	return nil
+}
+
+// ValidateMapKey function validates that a key of JSON object that represents
+// a map conforms to a protobuf scalar type kind of the map key.
+func ValidateMapKey(key string, path string, kind string) error {
+	if err := parseScalar(key, kind); err != nil {
+		return fmt.Errorf("invalid value for %q: expected %s key.", path, kind)
+	}
+
+	return nil
+}
+
+// ValidateMaxLength function validates that a JSON string value of a string field
+// has at most max characters or, for kind "bytes", decodes to at most max bytes.
+// JSON null and values of other types are accepted.
+func ValidateMaxLength(r json.RawMessage, path string, max int, kind string) error {
+	var s string
+	if err := json.Unmarshal(r, &s); err != nil {
+		return nil
+	}
+
+	n := utf8.RuneCountInString(s)
+	if kind == "bytes" {
+		b, err := decodeBase64(s)
+		if err != nil {
+			return nil
+		}
+		n = len(b)
+	}
+
+	if n > max {
+		return fmt.Errorf("field %q exceeds max length %d", path, max)
+	}
+
+	return nil
+}
+
+// parseNumber function parses a JSON value of a numeric field of a protobuf scalar
+// type kind, a number or a string that proto3 JSON mapping allows (e.g. quoted
+// int64 or "NaN"). It returns nil for JSON null and values that are not numbers.
+func parseNumber(r json.RawMessage, kind string) *big.Float {
+	var s string
+	if json.Unmarshal(r, &s) != nil {
+		s = string(bytes.TrimSpace(r))
+	}
+
+	switch kind {
+	case "int32", "int64":
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return new(big.Float).SetInt64(n)
+		}
+	case "uint32", "uint64":
+		if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return new(big.Float).SetUint64(n)
+		}
+	case "float", "double":
+		if n, err := strconv.ParseFloat(s, 64); err == nil && !math.IsNaN(n) {
+			return new(big.Float).SetFloat64(n)
+		}
+	}
+
+	return nil
+}
+
+// isNaN function reports whether a JSON value of a floating point field is NaN,
+// which is outside of any range.
+func isNaN(r json.RawMessage) bool {
+	var s string
+	return json.Unmarshal(r, &s) == nil && s == "NaN"
+}
+
+// ValidateMin function validates that a JSON value of a numeric field of a protobuf
+// scalar type kind is greater than or equal to min. Integers are compared exactly,
+// including int64 and uint64 values sent as strings. JSON null and values that are
+// not numbers are accepted.
+func ValidateMin(r json.RawMessage, path string, kind string, min float64) error {
+	if n := parseNumber(r, kind); (n != nil && n.Cmp(big.NewFloat(min)) < 0) || isNaN(r) {
+		return fmt.Errorf("field %q must be >= %v", path, min)
+	}
+
+	return nil
+}
+
+// ValidateMax function validates that a JSON value of a numeric field of a protobuf
+// scalar type kind is less than or equal to max, see ValidateMin.
+func ValidateMax(r json.RawMessage, path string, kind string, max float64) error {
+	if n := parseNumber(r, kind); (n != nil && n.Cmp(big.NewFloat(max)) > 0) || isNaN(r) {
+		return fmt.Errorf("field %q must be <= %v", path, max)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// SelfTest function verifies an entry of generated validate_Patterns: the pattern
+// is initialized, HTTP method and validator are set and none of the validator,
+// defaulter, queryValidator and formValidator panics on an empty request.
+// Validation errors of the empty request are expected (e.g. required fields) and
+// are not reported.
+func SelfTest(pattern runtime.Pattern, httpMethod string, allowUnknown bool,
+	validator func(context.Context, json.RawMessage) error,
+	defaulter func(context.Context, json.RawMessage) (json.RawMessage, error),
+	queryValidator func(context.Context, url.Values) error,
+	formValidator func(context.Context, url.Values) error) (err error) {
+
+	if reflect.DeepEqual(pattern, runtime.Pattern{}) {
+		return fmt.Errorf("self-test: pattern for %q method is not initialized", httpMethod)
+	}
+
+	if httpMethod == "" {
+		return fmt.Errorf("self-test: %s: HTTP method is not set", pattern)
+	}
+
+	if validator == nil {
+		return fmt.Errorf("self-test: %s %s: validator is not set", httpMethod, pattern)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("self-test: %s %s: panic on empty request: %v", httpMethod, pattern, r)
+		}
+	}()
+
+	ctx := context.WithValue(context.WithValue(context.Background(), HTTPMethodContextKey, httpMethod), AllowUnknownContextKey, allowUnknown)
+
+	validator(ctx, json.RawMessage(`{}`))
+	if defaulter != nil {
+		defaulter(ctx, json.RawMessage(`{}`))
+	}
+	if queryValidator != nil {
+		queryValidator(ctx, url.Values{})
+	}
+	if formValidator != nil {
+		ValidateForm(ctx, nil, formValidator)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+)
+
+// TenantIDContextKey is a context key of a tenant ID of a request, it is expected
+// to be set (e.g. by authentication middleware) in a context passed to the annotator.
+const TenantIDContextKey = "tenant-id"
+
+// TenantPolicy holds per-tenant overrides of validation rules keyed by rule ID
+// (e.g. "examplepb.User.name.required": false), rules that are not listed keep
+// compile-time behavior.
+type TenantPolicy map[string]bool
+
+// TenantPolicyResolver returns a policy of a tenant or nil if the tenant has no
+// overrides.
+type TenantPolicyResolver func(tenantID string) TenantPolicy
+
+var (
+	tenantPolicyMu       sync.RWMutex
+	tenantPolicyResolver TenantPolicyResolver
+)
+
+// SetTenantPolicyResolver function registers a resolver consulted by RuleEnabled
+// for requests with a tenant ID, nil resolver disables per-tenant overrides.
+func SetTenantPolicyResolver(resolver TenantPolicyResolver) {
+	tenantPolicyMu.Lock()
+	tenantPolicyResolver = resolver
+	tenantPolicyMu.Unlock()
+}
+
+// TenantIDFromContext function returns a tenant ID of a request or empty string.
+func TenantIDFromContext(ctx context.Context) (tenantID string) {
+	tenantID, _ = ctx.Value(TenantIDContextKey).(string)
+	return tenantID
+}
+
+// tenantRuleOverride function returns an override of a rule for a tenant of a
+// request, ok is false if there is no override.
+func tenantRuleOverride(ctx context.Context, ruleID string) (enabled bool, ok bool) {
+	tenantPolicyMu.RLock()
+	resolver := tenantPolicyResolver
+	tenantPolicyMu.RUnlock()
+
+	if resolver == nil {
+		return false, false
+	}
+
+	tenantID := TenantIDFromContext(ctx)
+	if tenantID == "" {
+		return false, false
+	}
+
+	enabled, ok = resolver(tenantID)[ruleID]
+	return enabled, ok
+}
+
+// hasRulePolicies function reports whether outcome of RuleEnabled may depend on
+// a request, that is a rule policy or a tenant policy resolver is registered.
+func hasRulePolicies() bool {
+	rulePolicyMu.RLock()
+	policy := rulePolicy
+	rulePolicyMu.RUnlock()
+
+	tenantPolicyMu.RLock()
+	resolver := tenantPolicyResolver
+	tenantPolicyMu.RUnlock()
+
+	return policy != nil || resolver != nil
+}
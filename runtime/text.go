@@ -0,0 +1,47 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// textCounter accumulates sizes of string values decoded during validation of
+// a request.
+type textCounter struct {
+	mu   sync.Mutex
+	size int
+	max  int
+}
+
+// WithMaxTotalTextBytes function returns a context that limits the aggregate
+// size of raw JSON values of string fields of a request to max bytes. A context
+// that already has a limit is returned unchanged, so a request is counted once.
+func WithMaxTotalTextBytes(ctx context.Context, max int) context.Context {
+	if _, ok := ctx.Value(TextContextKey).(*textCounter); ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, TextContextKey, &textCounter{max: max})
+}
+
+// CountText function adds size of a raw JSON value of a string field to the
+// counter of a request and returns an error if its limit is exceeded, JSON null
+// is not counted. It does nothing unless the limit is set with WithMaxTotalTextBytes.
+func CountText(ctx context.Context, r json.RawMessage) error {
+	c, ok := ctx.Value(TextContextKey).(*textCounter)
+	if !ok || string(r) == "null" {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.size += len(r)
+	if c.size > c.max {
+		return fmt.Errorf("total text size exceeds limit")
+	}
+
+	return nil
+}
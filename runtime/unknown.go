@@ -0,0 +1,33 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// UnknownFieldHandler decides on a field of a JSON object that does not match any
+// field of the message, path is a path of the object and raw is the field value.
+// Returning nil accepts the field, an error rejects the request with that error.
+type UnknownFieldHandler func(ctx context.Context, path string, field string, raw json.RawMessage) error
+
+var (
+	unknownFieldHandlerMu sync.RWMutex
+	unknownFieldHandler   UnknownFieldHandler
+)
+
+// SetUnknownFieldHandler function registers a handler consulted by generated
+// validators for unknown fields instead of allow_unknown_fields option, nil
+// handler restores the option behavior.
+func SetUnknownFieldHandler(handler UnknownFieldHandler) {
+	unknownFieldHandlerMu.Lock()
+	unknownFieldHandler = handler
+	unknownFieldHandlerMu.Unlock()
+}
+
+// GetUnknownFieldHandler function returns a registered unknown field handler or nil.
+func GetUnknownFieldHandler() UnknownFieldHandler {
+	unknownFieldHandlerMu.RLock()
+	defer unknownFieldHandlerMu.RUnlock()
+	return unknownFieldHandler
+}
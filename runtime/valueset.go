@@ -0,0 +1,53 @@
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+var (
+	valueSetsMu sync.RWMutex
+	valueSets   = make(map[string]map[string]struct{})
+)
+
+// RegisterValueSet function registers a set of values that can be referenced by
+// in_set field option, registering a set with the name of an existing one replaces
+// it, so allowlists loaded from reference data can be refreshed at runtime. Requests
+// cached by EnableValidationCache before are validated again.
+func RegisterValueSet(name string, values []string) {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+
+	valueSetsMu.Lock()
+	valueSets[name] = set
+	valueSetsMu.Unlock()
+	registryChanged()
+}
+
+// ValidateInSet function validates that a JSON value is a string that belongs to
+// a registered value set with a given name, JSON null is accepted.
+func ValidateInSet(r json.RawMessage, path string, name string) error {
+	if string(r) == "null" {
+		return nil
+	}
+
+	valueSetsMu.RLock()
+	set, ok := valueSets[name]
+	valueSetsMu.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("field %q references unknown value set %q", path, name)
+	}
+
+	var s string
+	if err := json.Unmarshal(r, &s); err == nil {
+		if _, ok := set[s]; ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("field %q must be one of the allowed values", path)
+}
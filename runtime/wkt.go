@@ -0,0 +1,101 @@
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	// durationPattern matches proto3 JSON representation of google.protobuf.Duration.
+	durationPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]{1,9})?s$`)
+	// fieldMaskPattern matches proto3 JSON representation of google.protobuf.FieldMask,
+	// comma-separated paths of lowerCamelCase names.
+	fieldMaskPattern = regexp.MustCompile(`^([a-z][a-zA-Z0-9]*(\.[a-z][a-zA-Z0-9]*)*(,[a-z][a-zA-Z0-9]*(\.[a-z][a-zA-Z0-9]*)*)*)?$`)
+)
+
+// ValidateWKT function validates that a JSON value conforms to proto3 JSON mapping
+// of a well-known type kind: "timestamp" (RFC 3339 string), "duration" (e.g. "3.5s"),
+// "field_mask" (e.g. "user.displayName,photo"), "struct" (object), "list_value" (array),
+// "any" (object with "@type") or "empty" (empty object). JSON null is accepted.
+func ValidateWKT(r json.RawMessage, path string, kind string) error {
+	if string(r) == "null" {
+		return nil
+	}
+
+	var (
+		s     string
+		valid bool
+	)
+
+	switch kind {
+	case "timestamp":
+		if json.Unmarshal(r, &s) == nil {
+			_, err := time.Parse(time.RFC3339Nano, s)
+			valid = err == nil
+		}
+	case "duration":
+		valid = json.Unmarshal(r, &s) == nil && durationPattern.MatchString(s)
+	case "field_mask":
+		valid = json.Unmarshal(r, &s) == nil && fieldMaskPattern.MatchString(s)
+	case "struct":
+		var v map[string]json.RawMessage
+		valid = json.Unmarshal(r, &v) == nil && v != nil
+	case "list_value":
+		var v []json.RawMessage
+		valid = json.Unmarshal(r, &v) == nil && v != nil
+	case "any":
+		var v map[string]json.RawMessage
+		if json.Unmarshal(r, &v) == nil {
+			valid = json.Unmarshal(v["@type"], &s) == nil && s != ""
+		}
+	case "empty":
+		var v map[string]json.RawMessage
+		valid = json.Unmarshal(r, &v) == nil && v != nil && len(v) == 0
+	default:
+		valid = true
+	}
+
+	if !valid {
+		return fmt.Errorf("invalid value for %q: expected %s.", path, kind)
+	}
+
+	return nil
+}
+
+// UpdateMask is a set of top-level field names of google.protobuf.FieldMask paths,
+// see ParseUpdateMask.
+type UpdateMask map[string]bool
+
+// ParseUpdateMask function returns first names of paths of a JSON value of
+// google.protobuf.FieldMask (e.g. "displayName,address.city"), nil if the value is
+// absent, null, empty or invalid: such a mask does not limit an update.
+func ParseUpdateMask(r json.RawMessage) UpdateMask {
+	var s string
+	if r == nil || json.Unmarshal(r, &s) != nil || s == "" || !fieldMaskPattern.MatchString(s) {
+		return nil
+	}
+
+	m := make(UpdateMask)
+	for _, p := range strings.Split(s, ",") {
+		m[strings.SplitN(p, ".", 2)[0]] = true
+	}
+	return m
+}
+
+// Excludes method reports whether a mask is set and none of names (a name of a
+// field and its JSON name) is in it.
+func (m UpdateMask) Excludes(names ...string) bool {
+	if m == nil {
+		return false
+	}
+
+	for _, n := range names {
+		if m[n] {
+			return false
+		}
+	}
+	return true
+}